@@ -0,0 +1,121 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/tbe-team/raybot/internal/config"
+	"github.com/tbe-team/raybot/internal/hardware/simulator"
+	"github.com/tbe-team/raybot/pkg/cmdutil"
+)
+
+func main() {
+	cfg := simulator.DefaultConfig()
+
+	var (
+		tags    string
+		framing string
+		codec   string
+		debug   bool
+	)
+
+	flag.StringVar(&tags, "tags", "A:0,B:100,C:200", "RFID tags along the track as location:position_cm pairs")
+	flag.Float64Var(&cfg.StartPosition, "start", cfg.StartPosition, "start position on the track in cm")
+	flag.Float64Var(&cfg.DriveSpeed, "drive-speed", cfg.DriveSpeed, "drive speed in cm/s at the motor speed 100")
+	flag.Float64Var(&cfg.LiftSpeed, "lift-speed", cfg.LiftSpeed, "lift speed in cm/s at the max output 100")
+	flag.Float64Var(&cfg.BatteryPercent, "battery", cfg.BatteryPercent, "initial battery percent")
+	flag.StringVar(&cfg.QRCode, "qr", cfg.QRCode, "QR code read by the cargo QR scanner")
+	flag.StringVar(&framing, "framing", string(cfg.Framing), "frame format of the PIC and ESP links, LEGACY or CRC16")
+	flag.StringVar(&codec, "codec", string(cfg.Codec), "encoding of the PIC and ESP messages, JSON or MSGPACK")
+	flag.Float64Var(&cfg.ACKDropRate, "ack-drop", cfg.ACKDropRate, "fraction of the command ACKs dropped, to exercise the command resends")
+	flag.BoolVar(&debug, "debug", false, "log the handled commands and the passed tags")
+	flag.Parse()
+
+	cfg.Framing = config.SerialFraming(strings.ToUpper(framing))
+	cfg.Codec = config.SerialCodec(strings.ToUpper(codec))
+
+	var err error
+	cfg.Tags, err = parseTags(tags)
+	if err != nil {
+		log.Fatalf("invalid tags: %v", err)
+	}
+
+	level := slog.LevelInfo
+	if debug {
+		level = slog.LevelDebug
+	}
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: level}))
+
+	sim, err := simulator.New(cfg, logger)
+	if err != nil {
+		log.Fatalf("error creating simulator: %v", err)
+	}
+
+	picPTY := mustOpenPTY("PIC")
+	defer picPTY.Close()
+	espPTY := mustOpenPTY("ESP")
+	defer espPTY.Close()
+	rfidPTY := mustOpenPTY("RFID")
+	defer rfidPTY.Close()
+
+	fmt.Printf(`Simulator ports, set them in the raybot config:
+
+hardware:
+  pic:
+    serial:
+      port: %s
+      framing: %s
+      codec: %s
+  esp:
+    serial:
+      port: %s
+      framing: %s
+      codec: %s
+  rfid:
+    serial_port: %s
+
+`, picPTY.SlavePath, cfg.Framing, cfg.Codec, espPTY.SlavePath, cfg.Framing, cfg.Codec, rfidPTY.SlavePath)
+
+	ctx, cancel := cmdutil.NewInterruptContext()
+	defer cancel()
+
+	if err := sim.Run(ctx, simulator.Ports{
+		PIC:  picPTY.Master,
+		ESP:  espPTY.Master,
+		RFID: rfidPTY.Master,
+	}); err != nil {
+		log.Printf("error running simulator: %v", err)
+	}
+}
+
+func mustOpenPTY(name string) *simulator.PTY {
+	pty, err := simulator.OpenPTY()
+	if err != nil {
+		log.Fatalf("error opening %s pseudo-terminal: %v", name, err)
+	}
+	return pty
+}
+
+// parseTags parses tags in the form "A:0,B:100".
+func parseTags(s string) ([]simulator.Tag, error) {
+	var tags []simulator.Tag
+	for _, pair := range strings.Split(s, ",") {
+		location, position, ok := strings.Cut(strings.TrimSpace(pair), ":")
+		if !ok {
+			return nil, fmt.Errorf("missing position in %q", pair)
+		}
+
+		p, err := strconv.ParseFloat(position, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid position in %q: %w", pair, err)
+		}
+
+		tags = append(tags, simulator.Tag{Location: location, Position: p})
+	}
+	return tags, nil
+}
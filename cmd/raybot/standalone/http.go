@@ -19,6 +19,8 @@ func startHTTPService(app *application.Application, interruptChan <-chan any) er
 		app.CommandService,
 		app.ApperrorcodeService,
 		app.LimitSwitchService,
+		app.ScheduleService,
+		app.TrackMapService,
 	)
 
 	cleanup, err := service.Run()
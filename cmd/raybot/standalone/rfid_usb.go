@@ -10,6 +10,7 @@ import (
 
 func startRFIDUSB(app *application.Application, interruptChan <-chan any, readyWg *sync.WaitGroup) error {
 	service := rfidusb.New(
+		app.Cfg.Hardware.RFID,
 		app.Log,
 		app.EventBus,
 		app.LocationService,
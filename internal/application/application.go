@@ -17,6 +17,8 @@ import (
 	"github.com/tbe-team/raybot/internal/services/appstate/appstateimpl"
 	"github.com/tbe-team/raybot/internal/services/battery"
 	"github.com/tbe-team/raybot/internal/services/battery/batteryimpl"
+	"github.com/tbe-team/raybot/internal/services/batterypolicy"
+	"github.com/tbe-team/raybot/internal/services/batterypolicy/batterypolicyimpl"
 	"github.com/tbe-team/raybot/internal/services/cargo"
 	"github.com/tbe-team/raybot/internal/services/cargo/cargoimpl"
 	"github.com/tbe-team/raybot/internal/services/command"
@@ -39,9 +41,13 @@ import (
 	"github.com/tbe-team/raybot/internal/services/location/locationimpl"
 	"github.com/tbe-team/raybot/internal/services/peripheral"
 	"github.com/tbe-team/raybot/internal/services/peripheral/peripheralimpl"
+	"github.com/tbe-team/raybot/internal/services/schedule"
+	"github.com/tbe-team/raybot/internal/services/schedule/scheduleimpl"
 	"github.com/tbe-team/raybot/internal/services/system"
 	"github.com/tbe-team/raybot/internal/services/system/systemimpl"
 	"github.com/tbe-team/raybot/internal/services/system/systeminfocollector"
+	"github.com/tbe-team/raybot/internal/services/trackmap"
+	"github.com/tbe-team/raybot/internal/services/trackmap/trackmapimpl"
 	"github.com/tbe-team/raybot/internal/services/wifi/wifiimpl"
 	"github.com/tbe-team/raybot/internal/storage/db"
 	"github.com/tbe-team/raybot/internal/storage/db/sqlc"
@@ -68,13 +74,16 @@ type Application struct {
 	CargoService          cargo.Service
 	LimitSwitchService    limitswitch.Service
 	LocationService       location.Service
+	TrackMapService       trackmap.Service
 	ConfigService         configsvc.Service
 	SystemService         system.Service
 	DashboardDataService  dashboarddata.Service
 	AppStateService       appstate.Service
 	PeripheralService     peripheral.Service
 	CommandService        command.Service
+	ScheduleService       schedule.Service
 	ApperrorcodeService   apperrorcode.Service
+	BatteryPolicyService  batterypolicy.Service
 }
 
 type CleanupFunc func() error
@@ -118,14 +127,18 @@ func New(configFilePath, dbPath string) (*Application, CleanupFunc, error) {
 	validator := validator.New()
 	batteryStateRepository := batteryimpl.NewBatteryStateRepository()
 	batterySettingRepository := batteryimpl.NewBatterySettingRepository(db, queries)
+	batteryPolicyRepository := batterypolicyimpl.NewRepository()
 	driveMotorStateRepository := drivemotorimpl.NewDriveMotorStateRepository()
 	liftMotorStateRepository := liftmotorimpl.NewLiftMotorStateRepository()
+	liftMotorCalibrationRepository := liftmotorimpl.NewCalibrationRepository(db, queries)
 	cargoRepository := cargoimpl.NewCargoRepository(db, queries)
 	locationRepository := locationimpl.NewLocationRepository(db, queries)
+	trackMapRepository := trackmapimpl.NewTrackMapRepository(db, queries)
 	limitSwitchStateRepository := limitswitchimpl.NewRepository()
 	distanceSensorStateRepository := distancesensorimpl.NewDistanceSensorStateRepository()
 	appStateRepository := appstateimpl.NewAppStateRepository()
 	commandRepository := commandimpl.NewCommandRepository(db, queries)
+	scheduleRepository := scheduleimpl.NewScheduleRepository(db, queries)
 	systemInfoRepository := systemimpl.NewRepository()
 
 	// Initialize hardware components
@@ -183,23 +196,27 @@ func New(configFilePath, dbPath string) (*Application, CleanupFunc, error) {
 	hardwareController := controller.New(cfg.Hardware, log, eventBus, picSerialClient, espSerialClient)
 
 	// Initialize services
-	batteryService := batteryimpl.NewService(validator, batteryStateRepository, batterySettingRepository)
+	configService := configimpl.NewService(cfg, fileClient, trackMapRepository)
+	batteryService := batteryimpl.NewService(log, validator, eventBus, configService, batteryStateRepository, batterySettingRepository, hardwareController)
 	distanceSensorService := distancesensorimpl.NewService(validator, eventBus, distanceSensorStateRepository)
 	driveMotorService := drivemotorimpl.NewService(validator, eventBus, driveMotorStateRepository, hardwareController)
-	liftMotorService := liftmotorimpl.NewService(validator, liftMotorStateRepository, hardwareController)
+	liftMotorService := liftmotorimpl.NewService(validator, liftMotorStateRepository, liftMotorCalibrationRepository, hardwareController)
 	cargoService := cargoimpl.NewService(validator, eventBus, cargoRepository, hardwareController)
 	locationService := locationimpl.NewService(validator, eventBus, locationRepository)
+	trackMapService := trackmapimpl.NewService(validator, configService, trackMapRepository, commandRepository)
 	limitSwitchService := limitswitchimpl.NewService(log, validator, eventBus, limitSwitchStateRepository)
-	configService := configimpl.NewService(cfg, fileClient)
 	dashboardDataService := dashboarddataimpl.NewService(
 		batteryStateRepository,
 		batterySettingRepository,
 		distanceSensorStateRepository,
 		liftMotorStateRepository,
+		liftMotorCalibrationRepository,
 		driveMotorStateRepository,
 		locationRepository,
 		cargoRepository,
 		appStateRepository,
+		commandRepository,
+		batteryPolicyRepository,
 	)
 	appStateService := appstateimpl.NewService(appStateRepository)
 	peripheralService := peripheralimpl.NewService()
@@ -210,8 +227,10 @@ func New(configFilePath, dbPath string) (*Application, CleanupFunc, error) {
 		log,
 		validator,
 		eventBus,
+		configService,
 		runningCmdRepository,
 		commandRepository,
+		batteryPolicyRepository,
 		processinglockimpl.New(),
 		executor.NewService(
 			log,
@@ -219,19 +238,37 @@ func New(configFilePath, dbPath string) (*Application, CleanupFunc, error) {
 			configService,
 			driveMotorService,
 			liftMotorService,
+			limitSwitchService,
 			cargoService,
+			batteryService,
 			distanceSensorService,
+			locationService,
+			trackMapService,
 			runningCmdRepository,
 			commandRepository,
 		),
 	)
+	batteryPolicyService := batterypolicyimpl.NewService(
+		log,
+		validator,
+		eventBus,
+		configService,
+		commandService,
+		trackMapService,
+		batteryPolicyRepository,
+	)
+	// Warn now rather than on a critical battery, the track map or the config can still be fixed from the API.
+	if err := batteryPolicyService.CheckChargerLocation(ctx); err != nil {
+		log.Warn("robot can not return to the charger on a critical battery", slog.Any("error", err))
+	}
+	scheduleService := scheduleimpl.NewService(log, validator, scheduleRepository, commandService)
 	wifiService := wifiimpl.NewService(cfg.Wifi, log)
 	if err := wifiService.Run(ctx); err != nil {
 		return nil, nil, fmt.Errorf("failed to run wifi service: %w", err)
 	}
 
 	apperrorcodeService := apperrorcodeimpl.NewService()
-	systemService := systemimpl.NewService(log, commandService, driveMotorService, liftMotorService, systemInfoRepository)
+	systemService := systemimpl.NewService(log, commandService, driveMotorService, liftMotorService, hardwareController, systemInfoRepository)
 	systemInfoCollectorService := systeminfocollector.NewService(log, systemInfoRepository)
 	systemInfoCollectorService.Run(ctx)
 
@@ -277,12 +314,15 @@ func New(configFilePath, dbPath string) (*Application, CleanupFunc, error) {
 		CargoService:          cargoService,
 		LimitSwitchService:    limitSwitchService,
 		LocationService:       locationService,
+		TrackMapService:       trackMapService,
 		ConfigService:         configService,
 		SystemService:         systemService,
 		DashboardDataService:  dashboardDataService,
 		AppStateService:       appStateService,
 		PeripheralService:     peripheralService,
 		CommandService:        commandService,
+		ScheduleService:       scheduleService,
 		ApperrorcodeService:   apperrorcodeService,
+		BatteryPolicyService:  batteryPolicyService,
 	}, cleanup, nil
 }
@@ -1,10 +1,23 @@
 package config
 
-import "fmt"
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
 
 type Command struct {
 	CargoLift  CargoLift  `yaml:"cargo_lift"`
 	CargoLower CargoLower `yaml:"cargo_lower"`
+	Preemption Preemption `yaml:"preemption"`
+	Timeout    Timeout    `yaml:"timeout"`
+	Retry      Retry      `yaml:"retry"`
+	Recovery   Recovery   `yaml:"recovery"`
+	Drive      Drive      `yaml:"drive"`
+	MoveTo     MoveTo     `yaml:"move_to"`
+	Battery    Battery    `yaml:"battery"`
+	LowBattery LowBattery `yaml:"low_battery"`
 }
 
 func (c *Command) Validate() error {
@@ -16,6 +29,38 @@ func (c *Command) Validate() error {
 		return fmt.Errorf("cargo_lower: %w", err)
 	}
 
+	if err := c.Preemption.Validate(); err != nil {
+		return fmt.Errorf("preemption: %w", err)
+	}
+
+	if err := c.Timeout.Validate(); err != nil {
+		return fmt.Errorf("timeout: %w", err)
+	}
+
+	if err := c.Retry.Validate(); err != nil {
+		return fmt.Errorf("retry: %w", err)
+	}
+
+	if err := c.Recovery.Validate(); err != nil {
+		return fmt.Errorf("recovery: %w", err)
+	}
+
+	if err := c.Drive.Validate(); err != nil {
+		return fmt.Errorf("drive: %w", err)
+	}
+
+	if err := c.MoveTo.Validate(); err != nil {
+		return fmt.Errorf("move_to: %w", err)
+	}
+
+	if err := c.Battery.Validate(); err != nil {
+		return fmt.Errorf("battery: %w", err)
+	}
+
+	if err := c.LowBattery.Validate(); err != nil {
+		return fmt.Errorf("low_battery: %w", err)
+	}
+
 	return nil
 }
 
@@ -59,3 +104,405 @@ func (c ObstacleTracking) Validate() error {
 	}
 	return nil
 }
+
+type Drive struct {
+	// ObstacleTracking pauses the drive motor while an obstacle is in the direction of travel,
+	// using the front distance when moving forward and the back distance when moving backward.
+	// Tracking is disabled when both distances are 0.
+	ObstacleTracking ObstacleTracking `yaml:"obstacle_tracking"`
+	// TrackManualDrive keeps MOVE_FORWARD and MOVE_BACKWARD running until they are canceled,
+	// so that they track the obstacles. It holds the command queue, so it is off by default.
+	TrackManualDrive bool `yaml:"track_manual_drive"`
+}
+
+func (c Drive) Validate() error {
+	if !c.ObstacleTrackingEnabled() {
+		return nil
+	}
+
+	if err := c.ObstacleTracking.Validate(); err != nil {
+		return fmt.Errorf("obstacle_tracking: %w", err)
+	}
+	return nil
+}
+
+// ObstacleTrackingEnabled reports whether the drive commands track obstacles.
+func (c Drive) ObstacleTrackingEnabled() bool {
+	return c.ObstacleTracking != ObstacleTracking{}
+}
+
+// MoveTo is the speed control of the MOVE_TO command.
+type MoveTo struct {
+	// CreepSpeed is the speed (0-100) used after passing the tag before the target, 0 disables slowing down
+	CreepSpeed uint8 `yaml:"creep_speed"`
+	// PreTargetTags are the tags to slow down at by target tag. They are used instead of
+	// the tag before the target in the track map.
+	PreTargetTags map[string][]string `yaml:"pre_target_tags"`
+	// SegmentSpeedLimits are the maximum speeds between two tags
+	SegmentSpeedLimits []SegmentSpeedLimit `yaml:"segment_speed_limits"`
+	// OvershootWindow is how long locations are watched after stopping at the target.
+	// Reading another location in that time means the target was overshot. 0 disables the correction
+	OvershootWindow time.Duration `yaml:"overshoot_window"`
+	// MaxCorrections is the maximum number of moves back to an overshot target
+	MaxCorrections uint8 `yaml:"max_corrections"`
+}
+
+func (c MoveTo) Validate() error {
+	if c.CreepSpeed > 100 {
+		return fmt.Errorf("creep speed must be less than or equal to 100")
+	}
+
+	if c.OvershootWindow < 0 {
+		return fmt.Errorf("overshoot window must be greater than or equal to 0")
+	}
+
+	for i, l := range c.SegmentSpeedLimits {
+		if err := l.Validate(); err != nil {
+			return fmt.Errorf("segment_speed_limits[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// SegmentSpeedLimit limits the speed between two tags, in both directions.
+// The limit applies from passing one of the tags until passing the other one.
+type SegmentSpeedLimit struct {
+	From string `yaml:"from"`
+	To   string `yaml:"to"`
+	// MaxSpeed is the maximum speed (1-100) in the segment
+	MaxSpeed uint8 `yaml:"max_speed"`
+}
+
+func (l SegmentSpeedLimit) Validate() error {
+	if l.From == "" || l.To == "" {
+		return fmt.Errorf("from and to are required")
+	}
+
+	if l.From == l.To {
+		return fmt.Errorf("from and to must be different")
+	}
+
+	if l.MaxSpeed == 0 || l.MaxSpeed > 100 {
+		return fmt.Errorf("max speed must be between 1 and 100")
+	}
+
+	return nil
+}
+
+// PreemptionPolicy decides what happens to the running command
+// when a command with a higher priority is created.
+type PreemptionPolicy string
+
+const (
+	// PreemptionPolicyNone lets the running command finish.
+	PreemptionPolicyNone PreemptionPolicy = "NONE"
+	// PreemptionPolicyCancel cancels the running command.
+	PreemptionPolicyCancel PreemptionPolicy = "CANCEL"
+	// PreemptionPolicySuspend stops the running command and puts it back into the queue.
+	PreemptionPolicySuspend PreemptionPolicy = "SUSPEND"
+)
+
+type Preemption struct {
+	// Policy is the preemption policy, defaults to NONE
+	Policy PreemptionPolicy `yaml:"policy"`
+}
+
+func (c *Preemption) Validate() error {
+	if c.Policy == "" {
+		c.Policy = PreemptionPolicyNone
+	}
+
+	p := PreemptionPolicy(strings.ToUpper(string(c.Policy)))
+	switch p {
+	case PreemptionPolicyNone, PreemptionPolicyCancel, PreemptionPolicySuspend:
+	default:
+		return fmt.Errorf("invalid policy: %s", c.Policy)
+	}
+	c.Policy = p
+
+	return nil
+}
+
+// RecoveryPolicy decides what happens on startup to the commands
+// that were QUEUED or PROCESSING when the application stopped.
+type RecoveryPolicy string
+
+const (
+	// RecoveryPolicyCancelAll cancels all queued and interrupted commands.
+	RecoveryPolicyCancelAll RecoveryPolicy = "CANCEL_ALL"
+	// RecoveryPolicyKeepQueued keeps the queued commands and cancels the interrupted command.
+	RecoveryPolicyKeepQueued RecoveryPolicy = "KEEP_QUEUED"
+	// RecoveryPolicyResume keeps the queued commands and puts the interrupted command
+	// back into the queue if its type is resumable.
+	RecoveryPolicyResume RecoveryPolicy = "RESUME"
+)
+
+type Recovery struct {
+	// Policy is the startup recovery policy, defaults to CANCEL_ALL
+	Policy RecoveryPolicy `yaml:"policy"`
+	// ResumableTypes are the command types (e.g. MOVE_TO) that are safe to execute again
+	// from the start after they were interrupted, used by the RESUME policy
+	ResumableTypes []string `yaml:"resumable_types"`
+}
+
+func (c *Recovery) Validate() error {
+	if c.Policy == "" {
+		c.Policy = RecoveryPolicyCancelAll
+	}
+
+	p := RecoveryPolicy(strings.ToUpper(string(c.Policy)))
+	switch p {
+	case RecoveryPolicyCancelAll, RecoveryPolicyKeepQueued, RecoveryPolicyResume:
+	default:
+		return fmt.Errorf("invalid policy: %s", c.Policy)
+	}
+	c.Policy = p
+
+	resumableTypes := make([]string, 0, len(c.ResumableTypes))
+	for _, t := range c.ResumableTypes {
+		resumableTypes = append(resumableTypes, strings.ToUpper(t))
+	}
+	c.ResumableTypes = resumableTypes
+
+	return nil
+}
+
+// IsResumable returns true if the command type is safe to resume.
+func (c Recovery) IsResumable(cmdType string) bool {
+	return slices.Contains(c.ResumableTypes, cmdType)
+}
+
+// Timeout is the default execution timeout of commands.
+// A command fails if it is still running when its timeout elapses.
+// The steps of MISSION and DELIVER are bounded by the timeout of their own type.
+type Timeout struct {
+	// Default is the timeout of command types without a timeout in PerType, 0 means no timeout
+	Default time.Duration `yaml:"default"`
+	// PerType is the timeout by command type (e.g. MOVE_TO), 0 means no timeout
+	PerType map[string]time.Duration `yaml:"per_type"`
+}
+
+func (c *Timeout) Validate() error {
+	if c.Default < 0 {
+		return fmt.Errorf("default must be greater than or equal to 0")
+	}
+
+	perType := make(map[string]time.Duration, len(c.PerType))
+	for t, d := range c.PerType {
+		if d < 0 {
+			return fmt.Errorf("timeout of %s must be greater than or equal to 0", t)
+		}
+		perType[strings.ToUpper(t)] = d
+	}
+	c.PerType = perType
+
+	return nil
+}
+
+// ForType returns the timeout of the given command type.
+func (c Timeout) ForType(commandType string) time.Duration {
+	if d, ok := c.PerType[commandType]; ok {
+		return d
+	}
+	return c.Default
+}
+
+// RetryableError is a class of transient errors a failed command can be retried on.
+type RetryableError string
+
+const (
+	// RetryableErrorACKTimeout is the error of a hardware command that was not acknowledged in time.
+	RetryableErrorACKTimeout RetryableError = "ACK_TIMEOUT"
+	// RetryableErrorSerialNotConnected is the error of a hardware command sent while the serial port is disconnected.
+	RetryableErrorSerialNotConnected RetryableError = "SERIAL_NOT_CONNECTED"
+)
+
+func (e RetryableError) Validate() error {
+	switch e {
+	case RetryableErrorACKTimeout, RetryableErrorSerialNotConnected:
+		return nil
+	}
+	return fmt.Errorf("invalid retryable error: %s", e)
+}
+
+// IsCompositeCommandType reports whether the command type runs its steps through the other executors.
+// A composite command can not be retried, a retry would run its succeeded steps again.
+// It is the source of command.CommandType.IsComposite, which can not be imported here.
+func IsCompositeCommandType(commandType string) bool {
+	switch strings.ToUpper(commandType) {
+	case "MISSION", "DELIVER":
+		return true
+	default:
+		return false
+	}
+}
+
+// Retry is the retry policy of failed commands.
+type Retry struct {
+	// Default is the retry policy of command types without a retry policy in PerType
+	Default RetryPolicy `yaml:"default"`
+	// PerType is the retry policy by command type (e.g. MOVE_TO)
+	PerType map[string]RetryPolicy `yaml:"per_type"`
+}
+
+func (c *Retry) Validate() error {
+	if err := c.Default.Validate(); err != nil {
+		return fmt.Errorf("default: %w", err)
+	}
+
+	perType := make(map[string]RetryPolicy, len(c.PerType))
+	for t, p := range c.PerType {
+		if err := p.Validate(); err != nil {
+			return fmt.Errorf("%s: %w", t, err)
+		}
+		t = strings.ToUpper(t)
+		if IsCompositeCommandType(t) && p.MaxAttempts > 1 {
+			return fmt.Errorf("%s can not be retried", t)
+		}
+		perType[t] = p
+	}
+	c.PerType = perType
+
+	return nil
+}
+
+// ForType returns the retry policy of the given command type.
+func (c Retry) ForType(commandType string) RetryPolicy {
+	if p, ok := c.PerType[commandType]; ok {
+		return p
+	}
+	return c.Default
+}
+
+// RetryPolicy decides whether a failed command is executed again.
+// The delay between attempts starts at Backoff and doubles after each retry, up to MaxBackoff.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of executions including the first one, defaults to 1 (no retry)
+	MaxAttempts uint8 `yaml:"max_attempts"`
+	// Backoff is the delay before the first retry
+	Backoff time.Duration `yaml:"backoff"`
+	// MaxBackoff is the maximum delay between retries, 0 means no limit
+	MaxBackoff time.Duration `yaml:"max_backoff"`
+	// RetryableErrors are the error classes a failed command is retried on
+	RetryableErrors []RetryableError `yaml:"retryable_errors"`
+}
+
+func (p *RetryPolicy) Validate() error {
+	if p.MaxAttempts == 0 {
+		p.MaxAttempts = 1
+	}
+
+	if p.Backoff < 0 {
+		return fmt.Errorf("backoff must be greater than or equal to 0")
+	}
+
+	if p.MaxBackoff < 0 {
+		return fmt.Errorf("max backoff must be greater than or equal to 0")
+	}
+
+	for i, e := range p.RetryableErrors {
+		e = RetryableError(strings.ToUpper(string(e)))
+		if err := e.Validate(); err != nil {
+			return err
+		}
+		p.RetryableErrors[i] = e
+	}
+
+	return nil
+}
+
+// IsRetryable returns true if a command failed with the given error class can be retried.
+func (p RetryPolicy) IsRetryable(e RetryableError) bool {
+	return slices.Contains(p.RetryableErrors, e)
+}
+
+// BackoffFor returns the delay before the given retry, starting at 1.
+func (p RetryPolicy) BackoffFor(retry uint8) time.Duration {
+	backoff := p.Backoff
+	for i := uint8(1); i < retry; i++ {
+		backoff *= 2
+		if p.MaxBackoff > 0 && backoff >= p.MaxBackoff {
+			return p.MaxBackoff
+		}
+	}
+
+	if p.MaxBackoff > 0 && backoff > p.MaxBackoff {
+		return p.MaxBackoff
+	}
+
+	return backoff
+}
+
+const defaultBatteryConfirmTimeout = 5 * time.Second
+
+// Battery is the config of the BATTERY_SET_CHARGE and BATTERY_SET_DISCHARGE commands.
+type Battery struct {
+	// MaxChargeCurrentLimit is the maximum charge current limit that can be set, 0 means no maximum
+	MaxChargeCurrentLimit uint16 `yaml:"max_charge_current_limit"`
+	// MaxDischargeCurrentLimit is the maximum discharge current limit that can be set, 0 means no maximum
+	MaxDischargeCurrentLimit uint16 `yaml:"max_discharge_current_limit"`
+	// ConfirmTimeout is how long to wait for the PIC to report the new setting in its sync state
+	ConfirmTimeout time.Duration `yaml:"confirm_timeout"`
+}
+
+func (c *Battery) Validate() error {
+	if c.ConfirmTimeout < 0 {
+		return fmt.Errorf("confirm timeout must be greater than or equal to 0")
+	}
+
+	if c.ConfirmTimeout == 0 {
+		c.ConfirmTimeout = defaultBatteryConfirmTimeout
+	}
+
+	return nil
+}
+
+const defaultLowBatteryMoveSpeed = 50
+
+// LowBattery is the configuration of the low-battery policy.
+// Both percents at 0 disable the policy.
+type LowBattery struct {
+	// WarningPercent is the battery percent at or below which an alert is raised
+	WarningPercent uint8 `yaml:"warning_percent"`
+	// CriticalPercent is the battery percent at or below which new work is rejected
+	// and the robot returns to the charger, 0 disables the return to the charger
+	CriticalPercent uint8 `yaml:"critical_percent"`
+	// ChargerLocation is the location of the charger station, it must be in the track map
+	// when CriticalPercent is set, which is checked on config and track map updates
+	ChargerLocation string `yaml:"charger_location"`
+	// ChargeCurrentLimit is the charge current limit set once the robot reaches the charger
+	ChargeCurrentLimit uint16 `yaml:"charge_current_limit"`
+	// MoveSpeed is the speed (1-100) used to move to the charger
+	MoveSpeed uint8 `yaml:"move_speed"`
+}
+
+func (c *LowBattery) Validate() error {
+	if c.WarningPercent > 100 {
+		return fmt.Errorf("warning percent must be less than or equal to 100")
+	}
+
+	if c.CriticalPercent > c.WarningPercent {
+		return fmt.Errorf("critical percent must be less than or equal to warning percent")
+	}
+
+	if c.CriticalPercent > 0 {
+		if c.ChargerLocation == "" {
+			return fmt.Errorf("charger location is required when critical percent is set")
+		}
+
+		if c.ChargeCurrentLimit == 0 {
+			return fmt.Errorf("charge current limit is required when critical percent is set")
+		}
+	}
+
+	if c.MoveSpeed > 100 {
+		return fmt.Errorf("move speed must be less than or equal to 100")
+	}
+
+	if c.MoveSpeed == 0 {
+		c.MoveSpeed = defaultLowBatteryMoveSpeed
+	}
+
+	return nil
+}
@@ -6,11 +6,18 @@ import (
 	"time"
 )
 
-const defaultCommandACKTimeout = 1 * time.Second
+const (
+	defaultCommandACKTimeout   = 1 * time.Second
+	maxCommandACKRetries       = 10
+	defaultReconnectMinBackoff = 500 * time.Millisecond
+	defaultReconnectMaxBackoff = 30 * time.Second
+)
 
 type Hardware struct {
-	ESP ESP `yaml:"esp"`
-	PIC PIC `yaml:"pic"`
+	ESP          ESP          `yaml:"esp"`
+	PIC          PIC          `yaml:"pic"`
+	BatteryCells BatteryCells `yaml:"battery_cells"`
+	RFID         RFID         `yaml:"rfid"`
 }
 
 func (h *Hardware) Validate() error {
@@ -22,6 +29,10 @@ func (h *Hardware) Validate() error {
 		return fmt.Errorf("validate pic: %w", err)
 	}
 
+	if err := h.BatteryCells.Validate(); err != nil {
+		return fmt.Errorf("validate battery cells: %w", err)
+	}
+
 	if h.ESP.Serial.Port == h.PIC.Serial.Port {
 		return fmt.Errorf("esp and pic serial ports cannot be the same")
 	}
@@ -33,6 +44,11 @@ type ESP struct {
 	Serial            Serial        `yaml:"serial"`
 	EnableACK         bool          `yaml:"enable_ack"`
 	CommandACKTimeout time.Duration `yaml:"command_ack_timeout"`
+	// CommandACKRetries is the number of times a command is sent again
+	// with the same ID when its ACK times out. It is experimental: it is only safe
+	// if the firmware ignores an ID it already ran, which the board firmware does
+	// not do yet, so a resend may run the command twice. Only the simulator dedupes.
+	CommandACKRetries uint8 `yaml:"command_ack_retries"`
 }
 
 func (e *ESP) Validate() error {
@@ -44,6 +60,10 @@ func (e *ESP) Validate() error {
 		e.CommandACKTimeout = defaultCommandACKTimeout
 	}
 
+	if e.CommandACKRetries > maxCommandACKRetries {
+		return fmt.Errorf("command ack retries must be at most %d", maxCommandACKRetries)
+	}
+
 	return nil
 }
 
@@ -51,6 +71,11 @@ type PIC struct {
 	Serial            Serial        `yaml:"serial"`
 	EnableACK         bool          `yaml:"enable_ack"`
 	CommandACKTimeout time.Duration `yaml:"command_ack_timeout"`
+	// CommandACKRetries is the number of times a command is sent again
+	// with the same ID when its ACK times out. It is experimental: it is only safe
+	// if the firmware ignores an ID it already ran, which the board firmware does
+	// not do yet, so a resend may run the command twice. Only the simulator dedupes.
+	CommandACKRetries uint8 `yaml:"command_ack_retries"`
 }
 
 func (p *PIC) Validate() error {
@@ -62,9 +87,59 @@ func (p *PIC) Validate() error {
 		p.CommandACKTimeout = defaultCommandACKTimeout
 	}
 
+	if p.CommandACKRetries > maxCommandACKRetries {
+		return fmt.Errorf("command ack retries must be at most %d", maxCommandACKRetries)
+	}
+
 	return nil
 }
 
+// RFID is the configuration of the RFID reader.
+type RFID struct {
+	// SerialPort is the port of a reader sending each tag as a line ending with CR LF,
+	// such as the one of raybot-sim. The USB HID reader is used if empty.
+	SerialPort string `yaml:"serial_port"`
+}
+
+// BatteryCells are the bounds the cell voltages reported by the PIC are checked against.
+type BatteryCells struct {
+	// MinVoltage is the minimum cell voltage in mV, 0 disables the check
+	MinVoltage uint16 `yaml:"min_voltage"`
+	// MaxVoltage is the maximum cell voltage in mV, 0 disables the check
+	MaxVoltage uint16 `yaml:"max_voltage"`
+	// MaxImbalance is the maximum difference between the highest and the lowest cell voltage in mV, 0 disables the check
+	MaxImbalance uint16 `yaml:"max_imbalance"`
+}
+
+func (b BatteryCells) Validate() error {
+	if b.MaxVoltage != 0 && b.MinVoltage > b.MaxVoltage {
+		return fmt.Errorf("min voltage must be less than or equal to max voltage")
+	}
+
+	return nil
+}
+
+// SerialFraming is the frame format of a serial link.
+type SerialFraming string
+
+const (
+	// SerialFramingLegacy frames a message with '>' and CR LF, without integrity check.
+	SerialFramingLegacy SerialFraming = "LEGACY"
+	// SerialFramingCRC16 frames a message with a protocol version, a sequence number and a CRC16.
+	SerialFramingCRC16 SerialFraming = "CRC16"
+)
+
+// SerialCodec is the encoding of the messages of a serial link.
+type SerialCodec string
+
+const (
+	// SerialCodecJSON sends the messages as JSON.
+	SerialCodecJSON SerialCodec = "JSON"
+	// SerialCodecMsgpack sends the messages as MessagePack. It needs the CRC16 framing,
+	// as the binary messages may contain the end marker of the legacy framing.
+	SerialCodecMsgpack SerialCodec = "MSGPACK"
+)
+
 type Serial struct {
 	Port        string        `yaml:"port"`
 	BaudRate    int           `yaml:"baud_rate"`
@@ -72,6 +147,14 @@ type Serial struct {
 	StopBits    float32       `yaml:"stop_bits"`
 	Parity      string        `yaml:"parity"`
 	ReadTimeout time.Duration `yaml:"read_timeout"`
+	// ReconnectMinBackoff is the wait before reopening a lost port. It doubles
+	// after each failed attempt, up to ReconnectMaxBackoff.
+	ReconnectMinBackoff time.Duration `yaml:"reconnect_min_backoff"`
+	ReconnectMaxBackoff time.Duration `yaml:"reconnect_max_backoff"`
+	// Framing is the frame format of the board firmware, defaults to LEGACY.
+	Framing SerialFraming `yaml:"framing"`
+	// Codec is the encoding of the messages of the board firmware, defaults to JSON.
+	Codec SerialCodec `yaml:"codec"`
 }
 
 func (s *Serial) Validate() error {
@@ -93,5 +176,37 @@ func (s *Serial) Validate() error {
 	}
 	s.Parity = p
 
+	if s.Framing == "" {
+		s.Framing = SerialFramingLegacy
+	}
+	f := SerialFraming(strings.ToUpper(string(s.Framing)))
+	if f != SerialFramingLegacy && f != SerialFramingCRC16 {
+		return fmt.Errorf("invalid framing: %s", s.Framing)
+	}
+	s.Framing = f
+
+	if s.Codec == "" {
+		s.Codec = SerialCodecJSON
+	}
+	c := SerialCodec(strings.ToUpper(string(s.Codec)))
+	if c != SerialCodecJSON && c != SerialCodecMsgpack {
+		return fmt.Errorf("invalid codec: %s", s.Codec)
+	}
+	if c == SerialCodecMsgpack && s.Framing != SerialFramingCRC16 {
+		return fmt.Errorf("codec %s requires the %s framing", c, SerialFramingCRC16)
+	}
+	s.Codec = c
+
+	if s.ReconnectMinBackoff == 0 {
+		s.ReconnectMinBackoff = defaultReconnectMinBackoff
+	}
+	if s.ReconnectMaxBackoff == 0 {
+		s.ReconnectMaxBackoff = defaultReconnectMaxBackoff
+	}
+	if s.ReconnectMinBackoff > s.ReconnectMaxBackoff {
+		return fmt.Errorf("reconnect min backoff %s is greater than reconnect max backoff %s",
+			s.ReconnectMinBackoff, s.ReconnectMaxBackoff)
+	}
+
 	return nil
 }
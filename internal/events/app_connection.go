@@ -20,13 +20,19 @@ type CloudDisconnectedEvent struct {
 	Error error
 }
 
-type ESPSerialConnectedEvent struct{}
+type ESPSerialConnectedEvent struct {
+	// ReconnectCount is the number of times the lost link was opened again.
+	ReconnectCount uint32
+}
 
 type ESPSerialDisconnectedEvent struct {
 	Error error
 }
 
-type PICSerialConnectedEvent struct{}
+type PICSerialConnectedEvent struct {
+	// ReconnectCount is the number of times the lost link was opened again.
+	ReconnectCount uint32
+}
 
 type PICSerialDisconnectedEvent struct {
 	Error error
@@ -0,0 +1,41 @@
+package events
+
+const (
+	BatteryStateUpdatedTopic            = "battery:state:updated"
+	BatteryAlertTopic                   = "battery:alert"
+	BatteryChargeSettingUpdatedTopic    = "battery:charge_setting:updated"
+	BatteryDischargeSettingUpdatedTopic = "battery:discharge_setting:updated"
+)
+
+// BatteryStateUpdatedEvent is published each time the PIC reports the battery state.
+type BatteryStateUpdatedEvent struct {
+	Percent uint8
+	Voltage uint16
+	Current uint16
+}
+
+const (
+	BatteryAlertLevelWarning  = "WARNING"
+	BatteryAlertLevelCritical = "CRITICAL"
+)
+
+// BatteryAlertEvent is published when the battery policy level gets worse,
+// on a new fault and on cells outside the configured bounds.
+type BatteryAlertEvent struct {
+	// Level is BatteryAlertLevelWarning or BatteryAlertLevelCritical.
+	Level   string
+	Percent uint8
+	Message string
+}
+
+// BatteryChargeSettingUpdatedEvent is published each time the PIC reports the charge setting.
+type BatteryChargeSettingUpdatedEvent struct {
+	CurrentLimit uint16
+	Enabled      bool
+}
+
+// BatteryDischargeSettingUpdatedEvent is published each time the PIC reports the discharge setting.
+type BatteryDischargeSettingUpdatedEvent struct {
+	CurrentLimit uint16
+	Enabled      bool
+}
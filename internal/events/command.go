@@ -1,9 +1,21 @@
 package events
 
+import "time"
+
 const (
-	CommandCreatedTopic = "command:created"
+	CommandCreatedTopic         = "command:created"
+	CommandProgressUpdatedTopic = "command:progress:updated"
 )
 
 type CommandCreatedEvent struct {
 	CommandID int64
 }
+
+type CommandProgressUpdatedEvent struct {
+	CommandID int64
+	Phase     string
+	// Percentage is nil if it can not be computed.
+	Percentage *uint8
+	Message    string
+	UpdatedAt  time.Time
+}
@@ -26,26 +26,54 @@ func (h commandHandler) CreateCommand(ctx context.Context, req *commandv1.Create
 	if err != nil {
 		return nil, fmt.Errorf("convert req inputs to command inputs: %v", err)
 	}
+	md, err := getCommandRequestMetadata(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get command request metadata: %v", err)
+	}
 	cmd, err := h.commandService.CreateCommand(ctx, command.CreateCommandParams{
-		Source:    command.SourceCloud,
-		Inputs:    inputs,
-		RequestID: GetRequestIDFromContext(ctx),
+		Source:      command.SourceCloud,
+		Inputs:      inputs,
+		RequestID:   GetRequestIDFromContext(ctx),
+		Priority:    md.Priority,
+		Timeout:     md.Timeout,
+		Deadline:    md.Deadline,
+		RetryPolicy: md.RetryPolicy,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("create command: %v", err)
 	}
+	if err := setCommandResponseMetadata(ctx, cmd); err != nil {
+		return nil, fmt.Errorf("set command response metadata: %v", err)
+	}
 	return &commandv1.CreateCommandResponse{
 		Command: h.convertCommandToResponse(cmd),
 	}, nil
 }
 
+// GetCommand applies the queue action of the request metadata to the command first, if any.
 func (h commandHandler) GetCommand(ctx context.Context, req *commandv1.GetCommandRequest) (*commandv1.GetCommandResponse, error) {
-	cmd, err := h.commandService.GetCommandByID(ctx, command.GetCommandByIDParams{
-		CommandID: req.Id,
-	})
+	action, err := getQueueActionMetadata(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get queue action metadata: %v", err)
+	}
+	cmd, err := h.applyQueueAction(ctx, req.Id, action)
 	if err != nil {
 		return nil, fmt.Errorf("get command: %v", err)
 	}
+	if err := setCommandResponseMetadata(ctx, cmd); err != nil {
+		return nil, fmt.Errorf("set command response metadata: %v", err)
+	}
+	if cmd.Status == command.StatusQueued {
+		position, err := h.commandService.GetQueuePosition(ctx, command.GetQueuePositionParams{
+			CommandID: cmd.ID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("get queue position: %v", err)
+		}
+		if err := setQueuePositionMetadata(ctx, position); err != nil {
+			return nil, fmt.Errorf("set queue position metadata: %v", err)
+		}
+	}
 	return &commandv1.GetCommandResponse{
 		Command: h.convertCommandToResponse(cmd),
 	}, nil
@@ -58,6 +86,31 @@ func (h commandHandler) CancelCurrentProcessingCommand(ctx context.Context, _ *c
 	return &commandv1.CancelCurrentProcessingCommandResponse{}, nil
 }
 
+// applyQueueAction applies the queue action to the command and returns the command.
+func (h commandHandler) applyQueueAction(ctx context.Context, commandID int64, action queueAction) (command.Command, error) {
+	switch action {
+	case queueActionCancel:
+		return h.commandService.CancelQueuedCommandByID(ctx, command.CancelQueuedCommandByIDParams{
+			CommandID: commandID,
+		})
+
+	case queueActionMoveFront, queueActionMoveBack:
+		move := command.QueueMoveFront
+		if action == queueActionMoveBack {
+			move = command.QueueMoveBack
+		}
+		return h.commandService.MoveQueuedCommand(ctx, command.MoveQueuedCommandParams{
+			CommandID: commandID,
+			Move:      move,
+		})
+
+	default:
+		return h.commandService.GetCommandByID(ctx, command.GetCommandByIDParams{
+			CommandID: commandID,
+		})
+	}
+}
+
 //nolint:gosec
 func (commandHandler) convertReqInputsToCommandInputs(req *commandv1.CreateCommandRequest) (command.Inputs, error) {
 	switch req.Type {
@@ -76,6 +129,9 @@ func (commandHandler) convertReqInputsToCommandInputs(req *commandv1.CreateComma
 			direction = command.MoveDirectionForward
 		case commandv1.MoveToInputs_DIRECTION_BACKWARD:
 			direction = command.MoveDirectionBackward
+		case commandv1.MoveToInputs_DIRECTION_UNSPECIFIED:
+			// The direction is picked from the track map.
+			direction = command.MoveDirectionAuto
 		default:
 			return nil, fmt.Errorf("invalid move direction: %v", i.Direction)
 		}
@@ -395,6 +451,7 @@ func (commandHandler) convertCommandOutputsToResponse(outputs command.Outputs) *
 			},
 		}
 
+	// The motion outputs messages have no fields, the outputs are sent by setCommandResponseMetadata.
 	case *command.MoveForwardOutputs:
 		return &commandv1.CommandOutputs{
 			Outputs: &commandv1.CommandOutputs_MoveForward{
@@ -0,0 +1,188 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/tbe-team/raybot/internal/config"
+	"github.com/tbe-team/raybot/internal/services/command"
+)
+
+// The commandv1 messages of raybot-api have no fields yet for the priority, timeout,
+// deadline and retry policy of a new command, nor for the attempts, attempt errors,
+// startup recovery and motion outputs of a command, and the command service has no
+// queue operations. Until they are added to raybot-api, the cloud sends the request
+// fields and the queue action of GetCommand as request metadata, and receives the
+// command fields and the queue position as response header metadata. This file is
+// the only place carrying them, it is removed once the raybot-api dependency has them.
+const (
+	priorityKey      = "priority"
+	timeoutKey       = "timeout"  // seconds
+	deadlineKey      = "deadline" // RFC3339
+	retryPolicyKey   = "retry-policy"
+	attemptsKey      = "attempts"
+	attemptErrorsKey = "attempt-errors"
+	recoveryKey      = "recovery"
+	outputsKey       = "outputs"
+	queueActionKey   = "queue-action"
+	queuePositionKey = "queue-position"
+	queueLengthKey   = "queue-length"
+)
+
+// queueAction is an operation on a queued command sent as GetCommand request metadata.
+type queueAction string
+
+const (
+	queueActionNone      queueAction = ""
+	queueActionCancel    queueAction = "CANCEL"
+	queueActionMoveFront queueAction = "MOVE_FRONT"
+	queueActionMoveBack  queueAction = "MOVE_BACK"
+)
+
+// commandRequestMetadata are the fields of a new command sent as request metadata.
+type commandRequestMetadata struct {
+	Priority    int64
+	Timeout     *time.Duration
+	Deadline    *time.Time
+	RetryPolicy *config.RetryPolicy
+}
+
+// retryPolicyMetadata is the JSON representation of a retry policy.
+type retryPolicyMetadata struct {
+	MaxAttempts     uint8    `json:"max_attempts"`
+	BackoffMs       int64    `json:"backoff_ms"`
+	MaxBackoffMs    int64    `json:"max_backoff_ms"`
+	RetryableErrors []string `json:"retryable_errors"`
+}
+
+// getCommandRequestMetadata reads the fields of a new command from the request metadata.
+// A missing field keeps its zero value.
+func getCommandRequestMetadata(ctx context.Context) (commandRequestMetadata, error) {
+	var res commandRequestMetadata
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return res, nil
+	}
+
+	get := func(key string) (string, bool) {
+		values := md.Get(key)
+		if len(values) == 0 {
+			return "", false
+		}
+		return values[0], true
+	}
+
+	if value, ok := get(priorityKey); ok {
+		priority, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return res, fmt.Errorf("invalid priority %q: %w", value, err)
+		}
+		res.Priority = priority
+	}
+
+	if value, ok := get(timeoutKey); ok {
+		seconds, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return res, fmt.Errorf("invalid timeout %q: %w", value, err)
+		}
+		timeout := time.Duration(seconds) * time.Second
+		res.Timeout = &timeout
+	}
+
+	if value, ok := get(deadlineKey); ok {
+		deadline, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return res, fmt.Errorf("invalid deadline %q: %w", value, err)
+		}
+		res.Deadline = &deadline
+	}
+
+	if value, ok := get(retryPolicyKey); ok {
+		var policy retryPolicyMetadata
+		if err := json.Unmarshal([]byte(value), &policy); err != nil {
+			return res, fmt.Errorf("invalid retry policy %q: %w", value, err)
+		}
+
+		retryableErrors := make([]config.RetryableError, len(policy.RetryableErrors))
+		for i, e := range policy.RetryableErrors {
+			retryableErrors[i] = config.RetryableError(e)
+		}
+		res.RetryPolicy = &config.RetryPolicy{
+			MaxAttempts:     policy.MaxAttempts,
+			Backoff:         time.Duration(policy.BackoffMs) * time.Millisecond,
+			MaxBackoff:      time.Duration(policy.MaxBackoffMs) * time.Millisecond,
+			RetryableErrors: retryableErrors,
+		}
+	}
+
+	return res, nil
+}
+
+// getQueueActionMetadata reads the queue action from the request metadata.
+// It returns queueActionNone if there is no queue action.
+func getQueueActionMetadata(ctx context.Context) (queueAction, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return queueActionNone, nil
+	}
+
+	values := md.Get(queueActionKey)
+	if len(values) == 0 {
+		return queueActionNone, nil
+	}
+
+	action := queueAction(values[0])
+	switch action {
+	case queueActionCancel, queueActionMoveFront, queueActionMoveBack:
+		return action, nil
+	default:
+		return queueActionNone, fmt.Errorf("invalid queue action %q", values[0])
+	}
+}
+
+// setQueuePositionMetadata sends the position of a queued command as response header metadata.
+func setQueuePositionMetadata(ctx context.Context, position command.QueuePosition) error {
+	return grpc.SetHeader(ctx, metadata.Pairs(
+		queuePositionKey, strconv.FormatUint(uint64(position.Position), 10),
+		queueLengthKey, strconv.FormatUint(uint64(position.QueueLength), 10),
+	))
+}
+
+// setCommandResponseMetadata sends the fields of the command as response header metadata.
+// The structured fields are JSON encoded.
+func setCommandResponseMetadata(ctx context.Context, cmd command.Command) error {
+	attemptErrors, err := json.Marshal(cmd.AttemptErrors)
+	if err != nil {
+		return fmt.Errorf("marshal attempt errors: %w", err)
+	}
+
+	md := metadata.Pairs(
+		attemptsKey, strconv.FormatUint(uint64(cmd.Attempts), 10),
+		attemptErrorsKey, string(attemptErrors),
+	)
+
+	if cmd.Recovery != nil {
+		recovery, err := json.Marshal(cmd.Recovery)
+		if err != nil {
+			return fmt.Errorf("marshal recovery: %w", err)
+		}
+		md.Set(recoveryKey, string(recovery))
+	}
+
+	switch cmd.Outputs.(type) {
+	case *command.MoveForwardOutputs, *command.MoveBackwardOutputs, *command.MoveToOutputs:
+		outputs, err := json.Marshal(cmd.Outputs)
+		if err != nil {
+			return fmt.Errorf("marshal outputs: %w", err)
+		}
+		md.Set(outputsKey, string(outputs))
+	}
+
+	return grpc.SetHeader(ctx, md)
+}
@@ -192,6 +192,11 @@ func (s *Service) registerHandlers(sr grpc.ServiceRegistrar) {
 	commandHandler := newCommandHandler(s.commandService)
 	commandv1.RegisterCommandServiceServer(sr, commandHandler)
 
+	// The command service of raybot-api has no queue operations yet, the cancel, move
+	// and position of a queued command are carried as GetCommand metadata, see
+	// command_metadata.go. Pausing and resuming the queue is only served over HTTP until
+	// raybot-api has PauseQueue and ResumeQueue RPCs.
+
 	systemHandler := newSystemHandler(s.systemService)
 	sysv1.RegisterSysServiceServer(sr, systemHandler)
 }
@@ -14,9 +14,11 @@ import (
 	"github.com/tbe-team/raybot/internal/handlers/cloud"
 	"github.com/tbe-team/raybot/internal/handlers/cloud/cloudtest"
 	"github.com/tbe-team/raybot/internal/logging"
+	"github.com/tbe-team/raybot/internal/services/batterypolicy/batterypolicyimpl"
 	"github.com/tbe-team/raybot/internal/services/command"
 	"github.com/tbe-team/raybot/internal/services/command/commandimpl"
 	"github.com/tbe-team/raybot/internal/services/command/processinglockimpl"
+	"github.com/tbe-team/raybot/internal/services/config/configimpl"
 	"github.com/tbe-team/raybot/internal/services/drivemotor"
 	"github.com/tbe-team/raybot/internal/services/liftmotor"
 	"github.com/tbe-team/raybot/internal/services/system/systemimpl"
@@ -71,8 +73,10 @@ func SetupTunnelTestEnv(t *testing.T) TunnelTestEnv {
 		log,
 		validator,
 		bus,
+		configimpl.NewService(&config.Config{}, nil, nil),
 		commandimpl.NewRunningCmdRepository(),
 		commandimpl.NewCommandRepository(db, queries),
+		batterypolicyimpl.NewRepository(),
 		processinglockimpl.New(),
 		noopExecutorService{},
 	)
@@ -153,3 +157,11 @@ func (noopLiftMotorService) SetCargoPosition(_ context.Context, _ liftmotor.SetC
 func (noopLiftMotorService) Stop(_ context.Context) error {
 	return nil
 }
+
+func (noopLiftMotorService) GetCalibration(_ context.Context) (liftmotor.Calibration, error) {
+	return liftmotor.Calibration{}, nil
+}
+
+func (noopLiftMotorService) UpdateCalibration(_ context.Context, _ liftmotor.UpdateCalibrationParams) (liftmotor.Calibration, error) {
+	return liftmotor.Calibration{}, nil
+}
@@ -0,0 +1,17 @@
+package event
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/tbe-team/raybot/internal/events"
+	"github.com/tbe-team/raybot/internal/services/batterypolicy"
+)
+
+func (s *Service) HandleBatteryStateUpdatedEvent(ctx context.Context, event events.BatteryStateUpdatedEvent) {
+	if err := s.batteryPolicyService.HandleBatteryUpdate(ctx, batterypolicy.HandleBatteryUpdateParams{
+		Percent: event.Percent,
+	}); err != nil {
+		s.log.Error("failed to handle battery update", slog.Any("error", err))
+	}
+}
@@ -10,12 +10,16 @@ import (
 	"github.com/tbe-team/raybot/pkg/ptr"
 )
 
-func (s *Service) HandleESPSerialConnectedEvent(ctx context.Context, _ events.ESPSerialConnectedEvent) {
+func (s *Service) HandleESPSerialConnectedEvent(ctx context.Context, event events.ESPSerialConnectedEvent) {
 	if err := s.appStateService.UpdateESPSerialConnection(ctx, appstate.UpdateESPSerialConnectionParams{
 		Connected:          true,
 		SetConnected:       true,
 		LastConnectedAt:    ptr.New(time.Now()),
 		SetLastConnectedAt: true,
+		Error:              nil,
+		SetError:           true,
+		ReconnectCount:     event.ReconnectCount,
+		SetReconnectCount:  true,
 	}); err != nil {
 		s.log.Error("failed to update ESP serial connection", slog.Any("error", err))
 	}
@@ -5,9 +5,16 @@ import (
 	"log/slog"
 
 	"github.com/tbe-team/raybot/internal/events"
+	"github.com/tbe-team/raybot/internal/services/command"
 )
 
 func (s *Service) HandleLimitSwitch1PressedEvent(ctx context.Context, _ events.LimitSwitch1PressedEvent) {
+	// CARGO_HOME raises the lift until the limit switch is pressed, so it is not canceled.
+	cmd, err := s.commandService.GetCurrentProcessingCommand(ctx)
+	if err == nil && cmd.Type == command.CommandTypeCargoHome {
+		return
+	}
+
 	if err := s.commandService.CancelCurrentProcessingCommand(ctx); err != nil {
 		s.log.Error("failed to cancel current processing command", slog.Any("error", err))
 	}
@@ -10,12 +10,16 @@ import (
 	"github.com/tbe-team/raybot/pkg/ptr"
 )
 
-func (s *Service) HandlePICSerialConnectedEvent(ctx context.Context, _ events.PICSerialConnectedEvent) {
+func (s *Service) HandlePICSerialConnectedEvent(ctx context.Context, event events.PICSerialConnectedEvent) {
 	if err := s.appStateService.UpdatePICSerialConnection(ctx, appstate.UpdatePICSerialConnectionParams{
 		Connected:          true,
 		SetConnected:       true,
 		LastConnectedAt:    ptr.New(time.Now()),
 		SetLastConnectedAt: true,
+		Error:              nil,
+		SetError:           true,
+		ReconnectCount:     event.ReconnectCount,
+		SetReconnectCount:  true,
 	}); err != nil {
 		s.log.Error("failed to update PIC serial connection", slog.Any("error", err))
 	}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/tbe-team/raybot/internal/events"
 	"github.com/tbe-team/raybot/internal/services/appstate"
+	"github.com/tbe-team/raybot/internal/services/batterypolicy"
 	"github.com/tbe-team/raybot/internal/services/command"
 	"github.com/tbe-team/raybot/pkg/eventbus"
 )
@@ -15,8 +16,9 @@ type Service struct {
 
 	subscriber eventbus.Subscriber
 
-	appStateService appstate.Service
-	commandService  command.Service
+	appStateService      appstate.Service
+	commandService       command.Service
+	batteryPolicyService batterypolicy.Service
 }
 
 type CleanupFunc func(context.Context) error
@@ -26,12 +28,14 @@ func New(
 	subscriber eventbus.Subscriber,
 	appStateService appstate.Service,
 	commandService command.Service,
+	batteryPolicyService batterypolicy.Service,
 ) *Service {
 	return &Service{
-		log:             log.With("service", "event"),
-		subscriber:      subscriber,
-		appStateService: appStateService,
-		commandService:  commandService,
+		log:                  log.With("service", "event"),
+		subscriber:           subscriber,
+		appStateService:      appStateService,
+		commandService:       commandService,
+		batteryPolicyService: batteryPolicyService,
 	}
 }
 
@@ -173,4 +177,18 @@ func (s *Service) registerHandlers(ctx context.Context) {
 			s.HandleLimitSwitch1PressedEvent(ctx, ev)
 		},
 	)
+
+	s.subscriber.Subscribe(
+		ctx,
+		events.BatteryStateUpdatedTopic,
+		func(msg *eventbus.Message) {
+			ev, ok := msg.Payload.(events.BatteryStateUpdatedEvent)
+			if !ok {
+				s.log.Error("received invalid event", slog.Any("event", msg.Payload))
+				return
+			}
+
+			s.HandleBatteryStateUpdatedEvent(ctx, ev)
+		},
+	)
 }
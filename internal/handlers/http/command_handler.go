@@ -4,10 +4,13 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/tbe-team/raybot/internal/config"
 	"github.com/tbe-team/raybot/internal/handlers/http/gen"
 	"github.com/tbe-team/raybot/internal/services/command"
 	"github.com/tbe-team/raybot/pkg/paging"
+	"github.com/tbe-team/raybot/pkg/ptr"
 	"github.com/tbe-team/raybot/pkg/sort"
 	"github.com/tbe-team/raybot/pkg/xerror"
 )
@@ -53,6 +56,27 @@ func (h commandHandler) GetCurrentProcessingCommand(ctx context.Context, _ gen.G
 	return gen.GetCurrentProcessingCommand200JSONResponse(res), nil
 }
 
+func (h commandHandler) GetCurrentProcessingCommandProgress(ctx context.Context, _ gen.GetCurrentProcessingCommandProgressRequestObject) (gen.GetCurrentProcessingCommandProgressResponseObject, error) {
+	progress, err := h.commandService.GetCurrentProcessingCommandProgress(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get current processing command progress: %w", err)
+	}
+
+	var updatedAt *time.Time
+	if !progress.Progress.UpdatedAt.IsZero() {
+		updatedAt = &progress.Progress.UpdatedAt
+	}
+
+	return gen.GetCurrentProcessingCommandProgress200JSONResponse{
+		CommandId:   int(progress.CommandID),
+		CommandType: gen.CommandType(progress.CommandType),
+		Phase:       progress.Progress.Phase.String(),
+		Percentage:  progress.Progress.Percentage,
+		Message:     progress.Progress.Message,
+		UpdatedAt:   updatedAt,
+	}, nil
+}
+
 func (h commandHandler) ListCommands(ctx context.Context, req gen.ListCommandsRequestObject) (gen.ListCommandsResponseObject, error) {
 	page := uint(1)
 	pageSize := uint(10)
@@ -114,9 +138,28 @@ func (h commandHandler) CreateCommand(ctx context.Context, req gen.CreateCommand
 		return nil, xerror.ValidationFailed(err, "invalid inputs")
 	}
 
+	var priority int64
+	if req.Body.Priority != nil {
+		priority = *req.Body.Priority
+	}
+
+	var timeout *time.Duration
+	if req.Body.Timeout != nil {
+		timeout = ptr.New(time.Duration(*req.Body.Timeout) * time.Second)
+	}
+
+	var retryPolicy *config.RetryPolicy
+	if req.Body.RetryPolicy != nil {
+		retryPolicy = ptr.New(convertReqRetryPolicyToConfig(*req.Body.RetryPolicy))
+	}
+
 	cmd, err := h.commandService.CreateCommand(ctx, command.CreateCommandParams{
-		Source: command.SourceApp,
-		Inputs: inputs,
+		Source:      command.SourceApp,
+		Inputs:      inputs,
+		Priority:    priority,
+		Timeout:     timeout,
+		Deadline:    req.Body.Deadline,
+		RetryPolicy: retryPolicy,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("create command: %w", err)
@@ -151,6 +194,84 @@ func (h commandHandler) CancelCurrentProcessingCommand(ctx context.Context, _ ge
 	return gen.CancelCurrentProcessingCommand204Response{}, nil
 }
 
+func (h commandHandler) CancelQueuedCommand(ctx context.Context, req gen.CancelQueuedCommandRequestObject) (gen.CancelQueuedCommandResponseObject, error) {
+	cmd, err := h.commandService.CancelQueuedCommandByID(ctx, command.CancelQueuedCommandByIDParams{
+		CommandID: int64(req.CommandId),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cancel queued command by id: %w", err)
+	}
+
+	res, err := h.convertCommandToResponse(cmd)
+	if err != nil {
+		return nil, fmt.Errorf("convert command to response: %w", err)
+	}
+
+	return gen.CancelQueuedCommand200JSONResponse(res), nil
+}
+
+func (h commandHandler) MoveQueuedCommand(ctx context.Context, req gen.MoveQueuedCommandRequestObject) (gen.MoveQueuedCommandResponseObject, error) {
+	cmd, err := h.commandService.MoveQueuedCommand(ctx, command.MoveQueuedCommandParams{
+		CommandID: int64(req.CommandId),
+		Move:      command.QueueMove(req.Body.Move),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("move queued command: %w", err)
+	}
+
+	res, err := h.convertCommandToResponse(cmd)
+	if err != nil {
+		return nil, fmt.Errorf("convert command to response: %w", err)
+	}
+
+	return gen.MoveQueuedCommand200JSONResponse(res), nil
+}
+
+func (h commandHandler) GetCommandQueuePosition(ctx context.Context, req gen.GetCommandQueuePositionRequestObject) (gen.GetCommandQueuePositionResponseObject, error) {
+	pos, err := h.commandService.GetQueuePosition(ctx, command.GetQueuePositionParams{
+		CommandID: int64(req.CommandId),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get queue position: %w", err)
+	}
+
+	return gen.GetCommandQueuePosition200JSONResponse{
+		CommandId:   int(pos.CommandID),
+		Position:    int(pos.Position),    //nolint:gosec
+		QueueLength: int(pos.QueueLength), //nolint:gosec
+	}, nil
+}
+
+func (h commandHandler) GetCommandQueueState(ctx context.Context, _ gen.GetCommandQueueStateRequestObject) (gen.GetCommandQueueStateResponseObject, error) {
+	state, err := h.commandService.GetQueueState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get queue state: %w", err)
+	}
+
+	return gen.GetCommandQueueState200JSONResponse(convertCommandQueueStateToResponse(state)), nil
+}
+
+func (h commandHandler) PauseCommandQueue(ctx context.Context, req gen.PauseCommandQueueRequestObject) (gen.PauseCommandQueueResponseObject, error) {
+	state, err := h.commandService.PauseQueue(ctx, command.PauseQueueParams{
+		Source: command.SourceApp,
+		Reason: req.Body.Reason,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("pause queue: %w", err)
+	}
+
+	return gen.PauseCommandQueue200JSONResponse(convertCommandQueueStateToResponse(state)), nil
+}
+
+func (h commandHandler) ResumeCommandQueue(ctx context.Context, _ gen.ResumeCommandQueueRequestObject) (gen.ResumeCommandQueueResponseObject, error) {
+	state, err := h.commandService.ResumeQueue(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("resume queue: %w", err)
+	}
+
+	return gen.ResumeCommandQueue200JSONResponse(convertCommandQueueStateToResponse(state)), nil
+}
+
 func (h commandHandler) convertCommandToResponse(cmd command.Command) (gen.CommandResponse, error) {
 	inputs, err := h.convertInputsToResponse(cmd.Inputs)
 	if err != nil {
@@ -162,22 +283,58 @@ func (h commandHandler) convertCommandToResponse(cmd command.Command) (gen.Comma
 		return gen.CommandResponse{}, fmt.Errorf("convert outputs to response: %w", err)
 	}
 
+	var timeout *int
+	if cmd.Timeout != nil {
+		timeout = ptr.New(int(cmd.Timeout.Seconds()))
+	}
+
+	var retryPolicy *gen.RetryPolicy
+	if cmd.RetryPolicy != nil {
+		retryPolicy = ptr.New(convertRetryPolicyToResponse(*cmd.RetryPolicy))
+	}
+
+	attemptErrors := make([]gen.AttemptError, len(cmd.AttemptErrors))
+	for i, e := range cmd.AttemptErrors {
+		attemptErrors[i] = gen.AttemptError{
+			Attempt:  e.Attempt,
+			Error:    e.Error,
+			FailedAt: e.FailedAt,
+		}
+	}
+
+	var recovery *gen.CommandRecovery
+	if cmd.Recovery != nil {
+		recovery = &gen.CommandRecovery{
+			Action:         cmd.Recovery.Action.String(),
+			Policy:         string(cmd.Recovery.Policy),
+			PreviousStatus: cmd.Recovery.PreviousStatus.String(),
+			RecoveredAt:    cmd.Recovery.RecoveredAt,
+		}
+	}
+
 	return gen.CommandResponse{
-		Id:          int(cmd.ID),
-		Type:        cmd.Type.String(),
-		Status:      cmd.Status.String(),
-		Source:      cmd.Source.String(),
-		Inputs:      inputs,
-		Outputs:     outputs,
-		Error:       cmd.Error,
-		StartedAt:   cmd.StartedAt,
-		CompletedAt: cmd.CompletedAt,
-		CreatedAt:   cmd.CreatedAt,
-		UpdatedAt:   cmd.UpdatedAt,
+		Id:            int(cmd.ID),
+		Type:          cmd.Type.String(),
+		Status:        cmd.Status.String(),
+		Source:        cmd.Source.String(),
+		Inputs:        inputs,
+		Outputs:       outputs,
+		Error:         cmd.Error,
+		StartedAt:     cmd.StartedAt,
+		CompletedAt:   cmd.CompletedAt,
+		CreatedAt:     cmd.CreatedAt,
+		UpdatedAt:     cmd.UpdatedAt,
+		Priority:      cmd.Priority,
+		Timeout:       timeout,
+		Deadline:      cmd.Deadline,
+		RetryPolicy:   retryPolicy,
+		Attempts:      cmd.Attempts,
+		AttemptErrors: attemptErrors,
+		Recovery:      recovery,
 	}, nil
 }
 
-func (commandHandler) convertInputsToResponse(inputs command.Inputs) (gen.CommandInputs, error) {
+func (h commandHandler) convertInputsToResponse(inputs command.Inputs) (gen.CommandInputs, error) {
 	var res gen.CommandInputs
 	switch v := inputs.(type) {
 	case *command.StopMovementInputs:
@@ -186,9 +343,13 @@ func (commandHandler) convertInputsToResponse(inputs command.Inputs) (gen.Comman
 		}
 
 	case *command.MoveToInputs:
+		var direction *gen.MoveDirection
+		if v.Direction != "" {
+			direction = ptr.New(v.Direction.String())
+		}
 		if err := res.FromMoveToInputs(gen.MoveToInputs{
 			Location:   v.Location,
-			Direction:  v.Direction.String(),
+			Direction:  direction,
 			MotorSpeed: v.MotorSpeed,
 		}); err != nil {
 			return gen.CommandInputs{}, fmt.Errorf("from move to inputs: %w", err)
@@ -245,6 +406,13 @@ func (commandHandler) convertInputsToResponse(inputs command.Inputs) (gen.Comman
 			return gen.CommandInputs{}, fmt.Errorf("from cargo check qr inputs: %w", err)
 		}
 
+	case *command.CargoHomeInputs:
+		if err := res.FromCargoHomeInputs(gen.CargoHomeInputs{
+			MotorSpeed: v.MotorSpeed,
+		}); err != nil {
+			return gen.CommandInputs{}, fmt.Errorf("from cargo home inputs: %w", err)
+		}
+
 	case *command.ScanLocationInputs:
 		if err := res.FromScanLocationInputs(gen.ScanLocationInputs{}); err != nil {
 			return gen.CommandInputs{}, fmt.Errorf("from scan location inputs: %w", err)
@@ -257,6 +425,68 @@ func (commandHandler) convertInputsToResponse(inputs command.Inputs) (gen.Comman
 			return gen.CommandInputs{}, fmt.Errorf("from wait inputs: %w", err)
 		}
 
+	case *command.MissionInputs:
+		steps := make([]gen.MissionStep, 0, len(v.Steps))
+		for _, step := range v.Steps {
+			stepInputs, err := h.convertInputsToResponse(step.Inputs)
+			if err != nil {
+				return gen.CommandInputs{}, fmt.Errorf("convert mission step inputs: %w", err)
+			}
+			steps = append(steps, gen.MissionStep{
+				Type:   step.Inputs.CommandType().String(),
+				Inputs: stepInputs,
+			})
+		}
+
+		if err := res.FromMissionInputs(gen.MissionInputs{
+			Steps: steps,
+		}); err != nil {
+			return gen.CommandInputs{}, fmt.Errorf("from mission inputs: %w", err)
+		}
+
+	case *command.DeliverInputs:
+		var qrCode *string
+		if v.QRCode != "" {
+			qrCode = &v.QRCode
+		}
+		var waitDurationMs *int64
+		if v.WaitDurationMs != 0 {
+			waitDurationMs = &v.WaitDurationMs
+		}
+		var direction *gen.MoveDirection
+		if v.Direction != "" {
+			direction = ptr.New(v.Direction.String())
+		}
+
+		if err := res.FromDeliverInputs(gen.DeliverInputs{
+			Station:        v.Station,
+			Direction:      direction,
+			LowerPosition:  v.LowerPosition,
+			QrCode:         qrCode,
+			WaitDurationMs: waitDurationMs,
+			MoveSpeed:      v.MoveSpeed,
+			LiftSpeed:      v.LiftSpeed,
+			DoorSpeed:      v.DoorSpeed,
+		}); err != nil {
+			return gen.CommandInputs{}, fmt.Errorf("from deliver inputs: %w", err)
+		}
+
+	case *command.BatterySetChargeInputs:
+		if err := res.FromBatterySetChargeInputs(gen.BatterySetChargeInputs{
+			CurrentLimit: v.CurrentLimit,
+			Enabled:      v.Enabled,
+		}); err != nil {
+			return gen.CommandInputs{}, fmt.Errorf("from battery set charge inputs: %w", err)
+		}
+
+	case *command.BatterySetDischargeInputs:
+		if err := res.FromBatterySetDischargeInputs(gen.BatterySetDischargeInputs{
+			CurrentLimit: v.CurrentLimit,
+			Enabled:      v.Enabled,
+		}); err != nil {
+			return gen.CommandInputs{}, fmt.Errorf("from battery set discharge inputs: %w", err)
+		}
+
 	default:
 		return gen.CommandInputs{}, fmt.Errorf("unknown inputs type: %T", v)
 	}
@@ -264,7 +494,7 @@ func (commandHandler) convertInputsToResponse(inputs command.Inputs) (gen.Comman
 	return res, nil
 }
 
-func (commandHandler) convertOutputsToResponse(outputs command.Outputs) (gen.CommandOutputs, error) {
+func (h commandHandler) convertOutputsToResponse(outputs command.Outputs) (gen.CommandOutputs, error) {
 	var res gen.CommandOutputs
 	switch v := outputs.(type) {
 	case *command.StopMovementOutputs:
@@ -273,17 +503,26 @@ func (commandHandler) convertOutputsToResponse(outputs command.Outputs) (gen.Com
 		}
 
 	case *command.MoveForwardOutputs:
-		if err := res.FromMoveForwardOutputs(gen.MoveForwardOutputs{}); err != nil {
+		if err := res.FromMoveForwardOutputs(h.convertMotionOutputsToResponse(v.MotionOutputs)); err != nil {
 			return gen.CommandOutputs{}, fmt.Errorf("from move forward outputs: %w", err)
 		}
 
 	case *command.MoveBackwardOutputs:
-		if err := res.FromMoveBackwardOutputs(gen.MoveBackwardOutputs{}); err != nil {
+		if err := res.FromMoveBackwardOutputs(h.convertMotionOutputsToResponse(v.MotionOutputs)); err != nil {
 			return gen.CommandOutputs{}, fmt.Errorf("from move backward outputs: %w", err)
 		}
 
 	case *command.MoveToOutputs:
-		if err := res.FromMoveToOutputs(gen.MoveToOutputs{}); err != nil {
+		motion := h.convertMotionOutputsToResponse(v.MotionOutputs)
+		if err := res.FromMoveToOutputs(gen.MoveToOutputs{
+			StartLocation:     motion.StartLocation,
+			FinalLocation:     motion.FinalLocation,
+			TagsPassed:        motion.TagsPassed,
+			TravelDurationMs:  motion.TravelDurationMs,
+			AverageMotorSpeed: motion.AverageMotorSpeed,
+			ObstaclePauses:    motion.ObstaclePauses,
+			Corrections:       v.Corrections,
+		}); err != nil {
 			return gen.CommandOutputs{}, fmt.Errorf("from move to outputs: %w", err)
 		}
 
@@ -312,6 +551,14 @@ func (commandHandler) convertOutputsToResponse(outputs command.Outputs) (gen.Com
 			return gen.CommandOutputs{}, fmt.Errorf("from cargo check qr outputs: %w", err)
 		}
 
+	case *command.CargoHomeOutputs:
+		if err := res.FromCargoHomeOutputs(gen.CargoHomeOutputs{
+			ZeroOffset:   v.ZeroOffset,
+			CalibratedAt: v.CalibratedAt,
+		}); err != nil {
+			return gen.CommandOutputs{}, fmt.Errorf("from cargo home outputs: %w", err)
+		}
+
 	case *command.ScanLocationOutputs:
 		locs := []gen.Location{}
 		if v.Locations != nil {
@@ -334,6 +581,50 @@ func (commandHandler) convertOutputsToResponse(outputs command.Outputs) (gen.Com
 			return gen.CommandOutputs{}, fmt.Errorf("from wait outputs: %w", err)
 		}
 
+	case *command.MissionOutputs:
+		steps, err := h.convertMissionStepOutputsToResponse(v.Steps)
+		if err != nil {
+			return gen.CommandOutputs{}, fmt.Errorf("convert mission step outputs: %w", err)
+		}
+
+		if err := res.FromMissionOutputs(gen.MissionOutputs{
+			Steps: steps,
+		}); err != nil {
+			return gen.CommandOutputs{}, fmt.Errorf("from mission outputs: %w", err)
+		}
+
+	case *command.DeliverOutputs:
+		phases, err := h.convertMissionStepOutputsToResponse(v.Phases)
+		if err != nil {
+			return gen.CommandOutputs{}, fmt.Errorf("convert deliver phase outputs: %w", err)
+		}
+		rollback, err := h.convertMissionStepOutputsToResponse(v.Rollback)
+		if err != nil {
+			return gen.CommandOutputs{}, fmt.Errorf("convert deliver rollback outputs: %w", err)
+		}
+
+		if err := res.FromDeliverOutputs(gen.DeliverOutputs{
+			Location: v.Location,
+			Phases:   phases,
+			Rollback: rollback,
+		}); err != nil {
+			return gen.CommandOutputs{}, fmt.Errorf("from deliver outputs: %w", err)
+		}
+
+	case *command.BatterySetChargeOutputs:
+		if err := res.FromBatterySetChargeOutputs(gen.BatterySetChargeOutputs{
+			ConfirmedAt: v.ConfirmedAt,
+		}); err != nil {
+			return gen.CommandOutputs{}, fmt.Errorf("from battery set charge outputs: %w", err)
+		}
+
+	case *command.BatterySetDischargeOutputs:
+		if err := res.FromBatterySetDischargeOutputs(gen.BatterySetDischargeOutputs{
+			ConfirmedAt: v.ConfirmedAt,
+		}); err != nil {
+			return gen.CommandOutputs{}, fmt.Errorf("from battery set discharge outputs: %w", err)
+		}
+
 	default:
 		return gen.CommandOutputs{}, fmt.Errorf("unknown outputs type: %T", v)
 	}
@@ -341,7 +632,7 @@ func (commandHandler) convertOutputsToResponse(outputs command.Outputs) (gen.Com
 	return res, nil
 }
 
-func (commandHandler) convertReqInputsToCommandInputs(cmdType gen.CommandType, inputs gen.CommandInputs) (command.Inputs, error) {
+func (h commandHandler) convertReqInputsToCommandInputs(cmdType gen.CommandType, inputs gen.CommandInputs) (command.Inputs, error) {
 	i, err := inputs.MarshalJSON()
 	if err != nil {
 		return nil, fmt.Errorf("marshal inputs: %w", err)
@@ -359,9 +650,13 @@ func (commandHandler) convertReqInputsToCommandInputs(cmdType gen.CommandType, i
 		if err != nil {
 			return nil, fmt.Errorf("as move to inputs: %w", err)
 		}
+		direction := command.MoveDirectionAuto
+		if i.Direction != nil {
+			direction = command.MoveDirection(*i.Direction)
+		}
 		return &command.MoveToInputs{
 			Location:   i.Location,
-			Direction:  command.MoveDirection(i.Direction),
+			Direction:  direction,
 			MotorSpeed: i.MotorSpeed,
 		}, nil
 
@@ -430,6 +725,15 @@ func (commandHandler) convertReqInputsToCommandInputs(cmdType gen.CommandType, i
 			QRCode: i.QrCode,
 		}, nil
 
+	case command.CommandTypeCargoHome:
+		i, err := inputs.AsCargoHomeInputs()
+		if err != nil {
+			return nil, fmt.Errorf("as cargo home inputs: %w", err)
+		}
+		return &command.CargoHomeInputs{
+			MotorSpeed: i.MotorSpeed,
+		}, nil
+
 	case command.CommandTypeScanLocation:
 		return &command.ScanLocationInputs{}, nil
 
@@ -442,7 +746,151 @@ func (commandHandler) convertReqInputsToCommandInputs(cmdType gen.CommandType, i
 			DurationMs: int64(i.DurationMs),
 		}, nil
 
+	case command.CommandTypeMission:
+		i, err := inputs.AsMissionInputs()
+		if err != nil {
+			return nil, fmt.Errorf("as mission inputs: %w", err)
+		}
+
+		steps := make([]command.MissionStep, 0, len(i.Steps))
+		for idx, step := range i.Steps {
+			if command.CommandType(step.Type) == command.CommandTypeMission {
+				return nil, xerror.ValidationFailed(nil, "nested mission is not allowed")
+			}
+
+			stepInputs, err := h.convertReqInputsToCommandInputs(step.Type, step.Inputs)
+			if err != nil {
+				return nil, fmt.Errorf("convert mission step %d inputs: %w", idx, err)
+			}
+			steps = append(steps, command.MissionStep{Inputs: stepInputs})
+		}
+
+		return &command.MissionInputs{
+			Steps: steps,
+		}, nil
+
+	case command.CommandTypeDeliver:
+		i, err := inputs.AsDeliverInputs()
+		if err != nil {
+			return nil, fmt.Errorf("as deliver inputs: %w", err)
+		}
+
+		var qrCode string
+		if i.QrCode != nil {
+			qrCode = *i.QrCode
+		}
+		var waitDurationMs int64
+		if i.WaitDurationMs != nil {
+			waitDurationMs = *i.WaitDurationMs
+		}
+		var direction command.MoveDirection
+		if i.Direction != nil {
+			direction = command.MoveDirection(*i.Direction)
+		}
+
+		return &command.DeliverInputs{
+			Station:        i.Station,
+			Direction:      direction,
+			LowerPosition:  i.LowerPosition,
+			QRCode:         qrCode,
+			WaitDurationMs: waitDurationMs,
+			MoveSpeed:      i.MoveSpeed,
+			LiftSpeed:      i.LiftSpeed,
+			DoorSpeed:      i.DoorSpeed,
+		}, nil
+
+	case command.CommandTypeBatterySetCharge:
+		i, err := inputs.AsBatterySetChargeInputs()
+		if err != nil {
+			return nil, fmt.Errorf("as battery set charge inputs: %w", err)
+		}
+		return &command.BatterySetChargeInputs{
+			CurrentLimit: i.CurrentLimit,
+			Enabled:      i.Enabled,
+		}, nil
+
+	case command.CommandTypeBatterySetDischarge:
+		i, err := inputs.AsBatterySetDischargeInputs()
+		if err != nil {
+			return nil, fmt.Errorf("as battery set discharge inputs: %w", err)
+		}
+		return &command.BatterySetDischargeInputs{
+			CurrentLimit: i.CurrentLimit,
+			Enabled:      i.Enabled,
+		}, nil
+
 	default:
 		return nil, xerror.ValidationFailed(nil, "unknown command type")
 	}
 }
+
+// convertCommandQueueStateToResponse is shared by the command and dashboard data handlers.
+func convertCommandQueueStateToResponse(state command.QueueState) gen.CommandQueueState {
+	var pausedBy *string
+	if state.PausedBy != nil {
+		pausedBy = ptr.New(state.PausedBy.String())
+	}
+
+	return gen.CommandQueueState{
+		Paused:    state.Paused,
+		PausedBy:  pausedBy,
+		Reason:    state.Reason,
+		PausedAt:  state.PausedAt,
+		UpdatedAt: state.UpdatedAt,
+	}
+}
+
+func (h commandHandler) convertMissionStepOutputsToResponse(steps []command.MissionStepOutputs) ([]gen.MissionStepOutputs, error) {
+	res := make([]gen.MissionStepOutputs, 0, len(steps))
+	for _, step := range steps {
+		var stepOutputs *gen.CommandOutputs
+		if step.Outputs != nil {
+			o, err := h.convertOutputsToResponse(step.Outputs)
+			if err != nil {
+				return nil, err
+			}
+			stepOutputs = &o
+		}
+
+		res = append(res, gen.MissionStepOutputs{
+			Type:        step.Type.String(),
+			Status:      step.Status.String(),
+			Outputs:     stepOutputs,
+			Error:       step.Error,
+			StartedAt:   step.StartedAt,
+			CompletedAt: step.CompletedAt,
+		})
+	}
+	return res, nil
+}
+
+func (h commandHandler) convertMotionOutputsToResponse(outputs command.MotionOutputs) gen.MotionOutputs {
+	tagsPassed := make([]gen.PassedTag, 0, len(outputs.TagsPassed))
+	for _, t := range outputs.TagsPassed {
+		tagsPassed = append(tagsPassed, gen.PassedTag{
+			Location: t.Location,
+			PassedAt: t.PassedAt,
+		})
+	}
+
+	return gen.MotionOutputs{
+		StartLocation:     outputs.StartLocation,
+		FinalLocation:     outputs.FinalLocation,
+		TagsPassed:        tagsPassed,
+		TravelDurationMs:  outputs.TravelDuration.Milliseconds(),
+		AverageMotorSpeed: float32(outputs.AverageMotorSpeed),
+		ObstaclePauses:    h.convertObstaclePausesToResponse(outputs.ObstaclePauses),
+	}
+}
+
+func (commandHandler) convertObstaclePausesToResponse(pauses []command.ObstaclePause) []gen.ObstaclePause {
+	res := make([]gen.ObstaclePause, 0, len(pauses))
+	for _, p := range pauses {
+		res = append(res, gen.ObstaclePause{
+			Distance:  p.Distance,
+			PausedAt:  p.PausedAt,
+			ResumedAt: p.ResumedAt,
+		})
+	}
+	return res
+}
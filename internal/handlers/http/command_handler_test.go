@@ -96,6 +96,54 @@ func TestCommandHandler_GetCurrentProcessingCommand(t *testing.T) {
 	})
 }
 
+func TestCommandHandler_GetCurrentProcessingCommandProgress(t *testing.T) {
+	t.Run("Should get progress successfully", func(t *testing.T) {
+		commandService := commandmocks.NewFakeService(t)
+		commandService.EXPECT().GetCurrentProcessingCommandProgress(mock.Anything).Return(command.CommandProgress{
+			CommandID:   1,
+			CommandType: command.CommandTypeMoveTo,
+			Progress: command.Progress{
+				Phase:      command.ProgressPhaseMoving,
+				Percentage: ptr.New(uint8(50)),
+				Message:    "passed B, moving to C",
+				UpdatedAt:  time.Now(),
+			},
+		}, nil)
+
+		h := SetupAPITestHandler(t, func(hs *Service) {
+			hs.commandService = commandService
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/commands/processing/progress", nil)
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		res := MustDecodeJSON[gen.CommandProgressResponse](t, rec.Body)
+		require.Equal(t, 1, res.CommandId)
+		require.Equal(t, "MOVING", res.Phase)
+		require.Equal(t, uint8(50), *res.Percentage)
+		require.NotNil(t, res.UpdatedAt)
+	})
+
+	t.Run("Should return not found if no command is running", func(t *testing.T) {
+		commandService := commandmocks.NewFakeService(t)
+		commandService.EXPECT().GetCurrentProcessingCommandProgress(mock.Anything).
+			Return(command.CommandProgress{}, command.ErrRunningCommandNotFound)
+
+		h := SetupAPITestHandler(t, func(hs *Service) {
+			hs.commandService = commandService
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/commands/processing/progress", nil)
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
 func TestCommandHandler_ListCommands(t *testing.T) {
 	t.Run("Should get successfully", func(t *testing.T) {
 		commandService := commandmocks.NewFakeService(t)
@@ -211,6 +259,89 @@ func TestCommandHandler_CreateCommand(t *testing.T) {
 		require.Equal(t, http.StatusCreated, rec.Code)
 	})
 
+	t.Run("Should create deliver command successfully", func(t *testing.T) {
+		commandService := commandmocks.NewFakeService(t)
+		commandService.EXPECT().CreateCommand(mock.Anything,
+			mock.MatchedBy(
+				func(params command.CreateCommandParams) bool {
+					i, ok := params.Inputs.(*command.DeliverInputs)
+					return ok &&
+						i.Station == "dock" &&
+						i.Direction == command.MoveDirectionBackward &&
+						i.LowerPosition == 200 &&
+						i.QRCode == "" &&
+						i.WaitDurationMs == 5000 &&
+						i.MoveSpeed == 80 &&
+						i.LiftSpeed == 50 &&
+						i.DoorSpeed == 40
+				},
+			),
+		).Return(validCommand, nil)
+
+		h := SetupAPITestHandler(t, func(hs *Service) {
+			hs.commandService = commandService
+		})
+
+		i := gen.CommandInputs{}
+		err := i.FromDeliverInputs(gen.DeliverInputs{
+			Station:        "dock",
+			Direction:      ptr.New(gen.MoveDirection("BACKWARD")),
+			LowerPosition:  200,
+			WaitDurationMs: ptr.New(int64(5000)),
+			MoveSpeed:      80,
+			LiftSpeed:      50,
+			DoorSpeed:      40,
+		})
+		require.NoError(t, err)
+
+		jsonBody, err := json.Marshal(gen.CreateCommandRequest{
+			Type:   "DELIVER",
+			Inputs: i,
+		})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/commands", bytes.NewBuffer(jsonBody))
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusCreated, rec.Code)
+	})
+
+	t.Run("Should create battery set charge command successfully", func(t *testing.T) {
+		commandService := commandmocks.NewFakeService(t)
+		commandService.EXPECT().CreateCommand(mock.Anything,
+			mock.MatchedBy(
+				func(params command.CreateCommandParams) bool {
+					i, ok := params.Inputs.(*command.BatterySetChargeInputs)
+					return ok && i.CurrentLimit == 1500 && i.Enabled
+				},
+			),
+		).Return(validCommand, nil)
+
+		h := SetupAPITestHandler(t, func(hs *Service) {
+			hs.commandService = commandService
+		})
+
+		i := gen.CommandInputs{}
+		err := i.FromBatterySetChargeInputs(gen.BatterySetChargeInputs{
+			CurrentLimit: 1500,
+			Enabled:      true,
+		})
+		require.NoError(t, err)
+
+		jsonBody, err := json.Marshal(gen.CreateCommandRequest{
+			Type:   "BATTERY_SET_CHARGE",
+			Inputs: i,
+		})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/commands", bytes.NewBuffer(jsonBody))
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusCreated, rec.Code)
+	})
+
 	t.Run("Should not able to create command if validation fail", func(t *testing.T) {
 		t.Run("Type is invalid", func(t *testing.T) {
 			h := SetupAPITestHandler(t)
@@ -348,6 +479,153 @@ func TestCommandHandler_CancelCurrentProcessingCommand(t *testing.T) {
 	})
 }
 
+func TestCommandHandler_CancelQueuedCommand(t *testing.T) {
+	t.Run("Should cancel queued command successfully", func(t *testing.T) {
+		commandService := commandmocks.NewFakeService(t)
+		commandService.EXPECT().CancelQueuedCommandByID(mock.Anything,
+			command.CancelQueuedCommandByIDParams{CommandID: 123},
+		).Return(validCommand, nil)
+
+		h := SetupAPITestHandler(t, func(hs *Service) {
+			hs.commandService = commandService
+		})
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/commands/123/cancel", nil)
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("Should not able to cancel command if it is not queued", func(t *testing.T) {
+		commandService := commandmocks.NewFakeService(t)
+		commandService.EXPECT().CancelQueuedCommandByID(mock.Anything, mock.Anything).
+			Return(command.Command{}, command.ErrCommandNotQueued)
+
+		h := SetupAPITestHandler(t, func(hs *Service) {
+			hs.commandService = commandService
+		})
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/commands/123/cancel", nil)
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestCommandHandler_MoveQueuedCommand(t *testing.T) {
+	t.Run("Should move queued command successfully", func(t *testing.T) {
+		commandService := commandmocks.NewFakeService(t)
+		commandService.EXPECT().MoveQueuedCommand(mock.Anything,
+			command.MoveQueuedCommandParams{CommandID: 123, Move: command.QueueMoveFront},
+		).Return(validCommand, nil)
+
+		h := SetupAPITestHandler(t, func(hs *Service) {
+			hs.commandService = commandService
+		})
+
+		body, err := json.Marshal(gen.MoveQueuedCommandRequest{Move: gen.FRONT})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/commands/123/move", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("Should not able to move command if it is not queued", func(t *testing.T) {
+		commandService := commandmocks.NewFakeService(t)
+		commandService.EXPECT().MoveQueuedCommand(mock.Anything, mock.Anything).
+			Return(command.Command{}, command.ErrCommandNotQueued)
+
+		h := SetupAPITestHandler(t, func(hs *Service) {
+			hs.commandService = commandService
+		})
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/commands/123/move", bytes.NewReader([]byte(`{"move":"BACK"}`)))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestCommandHandler_GetCommandQueuePosition(t *testing.T) {
+	t.Run("Should get queue position successfully", func(t *testing.T) {
+		commandService := commandmocks.NewFakeService(t)
+		commandService.EXPECT().GetQueuePosition(mock.Anything,
+			command.GetQueuePositionParams{CommandID: 123},
+		).Return(command.QueuePosition{CommandID: 123, Position: 2, QueueLength: 3}, nil)
+
+		h := SetupAPITestHandler(t, func(hs *Service) {
+			hs.commandService = commandService
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/commands/123/queue-position", nil)
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var res gen.QueuePositionResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.Equal(t, gen.QueuePositionResponse{CommandId: 123, Position: 2, QueueLength: 3}, res)
+	})
+}
+
+func TestCommandHandler_PauseCommandQueue(t *testing.T) {
+	t.Run("Should pause command queue successfully", func(t *testing.T) {
+		commandService := commandmocks.NewFakeService(t)
+		commandService.EXPECT().PauseQueue(mock.Anything, command.PauseQueueParams{
+			Source: command.SourceApp,
+			Reason: ptr.New("maintenance"),
+		}).Return(command.QueueState{
+			Paused:   true,
+			PausedBy: ptr.New(command.SourceApp),
+			Reason:   ptr.New("maintenance"),
+			PausedAt: ptr.New(time.Now()),
+		}, nil)
+
+		h := SetupAPITestHandler(t, func(hs *Service) {
+			hs.commandService = commandService
+		})
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/commands/queue/pause", bytes.NewReader([]byte(`{"reason":"maintenance"}`)))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var res gen.CommandQueueState
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.True(t, res.Paused)
+		require.Equal(t, "APP", *res.PausedBy)
+		require.Equal(t, "maintenance", *res.Reason)
+	})
+}
+
+func TestCommandHandler_ResumeCommandQueue(t *testing.T) {
+	t.Run("Should resume command queue successfully", func(t *testing.T) {
+		commandService := commandmocks.NewFakeService(t)
+		commandService.EXPECT().ResumeQueue(mock.Anything).Return(command.QueueState{}, nil)
+
+		h := SetupAPITestHandler(t, func(hs *Service) {
+			hs.commandService = commandService
+		})
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/commands/queue/resume", nil)
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+	})
+}
+
 var validCommand = command.Command{
 	ID:          1,
 	Type:        command.CommandTypeStopMovement,
@@ -57,22 +57,30 @@ func (h configHandler) GetHardwareConfig(ctx context.Context, _ gen.GetHardwareC
 func (h configHandler) UpdateHardwareConfig(ctx context.Context, request gen.UpdateHardwareConfigRequestObject) (gen.UpdateHardwareConfigResponseObject, error) {
 	//nolint:gosec
 	espSerial := config.Serial{
-		Port:        request.Body.Esp.Serial.Port,
-		BaudRate:    request.Body.Esp.Serial.BaudRate,
-		DataBits:    uint8(request.Body.Esp.Serial.DataBits),
-		Parity:      request.Body.Esp.Serial.Parity,
-		StopBits:    float32(request.Body.Esp.Serial.StopBits),
-		ReadTimeout: time.Duration(request.Body.Esp.Serial.ReadTimeout) * time.Second,
+		Port:                request.Body.Esp.Serial.Port,
+		BaudRate:            request.Body.Esp.Serial.BaudRate,
+		DataBits:            uint8(request.Body.Esp.Serial.DataBits),
+		Parity:              request.Body.Esp.Serial.Parity,
+		StopBits:            float32(request.Body.Esp.Serial.StopBits),
+		ReadTimeout:         time.Duration(request.Body.Esp.Serial.ReadTimeout) * time.Second,
+		ReconnectMinBackoff: time.Duration(request.Body.Esp.Serial.ReconnectMinBackoffMs) * time.Millisecond,
+		ReconnectMaxBackoff: time.Duration(request.Body.Esp.Serial.ReconnectMaxBackoffMs) * time.Millisecond,
+		Framing:             config.SerialFraming(request.Body.Esp.Serial.Framing),
+		Codec:               config.SerialCodec(request.Body.Esp.Serial.Codec),
 	}
 
 	//nolint:gosec
 	picSerial := config.Serial{
-		Port:        request.Body.Pic.Serial.Port,
-		BaudRate:    request.Body.Pic.Serial.BaudRate,
-		DataBits:    uint8(request.Body.Pic.Serial.DataBits),
-		Parity:      request.Body.Pic.Serial.Parity,
-		StopBits:    float32(request.Body.Pic.Serial.StopBits),
-		ReadTimeout: time.Duration(request.Body.Pic.Serial.ReadTimeout) * time.Second,
+		Port:                request.Body.Pic.Serial.Port,
+		BaudRate:            request.Body.Pic.Serial.BaudRate,
+		DataBits:            uint8(request.Body.Pic.Serial.DataBits),
+		Parity:              request.Body.Pic.Serial.Parity,
+		StopBits:            float32(request.Body.Pic.Serial.StopBits),
+		ReadTimeout:         time.Duration(request.Body.Pic.Serial.ReadTimeout) * time.Second,
+		ReconnectMinBackoff: time.Duration(request.Body.Pic.Serial.ReconnectMinBackoffMs) * time.Millisecond,
+		ReconnectMaxBackoff: time.Duration(request.Body.Pic.Serial.ReconnectMaxBackoffMs) * time.Millisecond,
+		Framing:             config.SerialFraming(request.Body.Pic.Serial.Framing),
+		Codec:               config.SerialCodec(request.Body.Pic.Serial.Codec),
 	}
 
 	cfg, err := h.configService.UpdateHardwareConfig(ctx, config.Hardware{
@@ -80,11 +88,21 @@ func (h configHandler) UpdateHardwareConfig(ctx context.Context, request gen.Upd
 			Serial:            espSerial,
 			EnableACK:         request.Body.Esp.EnableAck,
 			CommandACKTimeout: time.Duration(request.Body.Esp.CommandAckTimeout) * time.Millisecond,
+			CommandACKRetries: request.Body.Esp.CommandAckRetries,
 		},
 		PIC: config.PIC{
 			Serial:            picSerial,
 			EnableACK:         request.Body.Pic.EnableAck,
 			CommandACKTimeout: time.Duration(request.Body.Pic.CommandAckTimeout) * time.Millisecond,
+			CommandACKRetries: request.Body.Pic.CommandAckRetries,
+		},
+		BatteryCells: config.BatteryCells{
+			MinVoltage:   request.Body.BatteryCells.MinVoltage,
+			MaxVoltage:   request.Body.BatteryCells.MaxVoltage,
+			MaxImbalance: request.Body.BatteryCells.MaxImbalance,
+		},
+		RFID: config.RFID{
+			SerialPort: request.Body.Rfid.SerialPort,
 		},
 	})
 	if err != nil {
@@ -190,6 +208,35 @@ func (h configHandler) UpdateCommandConfig(ctx context.Context, req gen.UpdateCo
 				ExitDistance:  req.Body.CargoLower.BottomObstacleTracking.ExitDistance,
 			},
 		},
+		Preemption: config.Preemption{
+			Policy: config.PreemptionPolicy(req.Body.Preemption.Policy),
+		},
+		Timeout: h.convertReqCommandTimeoutToConfig(req.Body.Timeout),
+		Retry:   h.convertReqCommandRetryToConfig(req.Body.Retry),
+		Recovery: config.Recovery{
+			Policy:         config.RecoveryPolicy(req.Body.Recovery.Policy),
+			ResumableTypes: req.Body.Recovery.ResumableTypes,
+		},
+		Drive: config.Drive{
+			ObstacleTracking: config.ObstacleTracking{
+				EnterDistance: req.Body.Drive.ObstacleTracking.EnterDistance,
+				ExitDistance:  req.Body.Drive.ObstacleTracking.ExitDistance,
+			},
+			TrackManualDrive: req.Body.Drive.TrackManualDrive,
+		},
+		MoveTo: h.convertReqMoveToConfigToConfig(req.Body.MoveTo),
+		Battery: config.Battery{
+			MaxChargeCurrentLimit:    req.Body.Battery.MaxChargeCurrentLimit,
+			MaxDischargeCurrentLimit: req.Body.Battery.MaxDischargeCurrentLimit,
+			ConfirmTimeout:           time.Duration(req.Body.Battery.ConfirmTimeoutMs) * time.Millisecond,
+		},
+		LowBattery: config.LowBattery{
+			WarningPercent:     req.Body.LowBattery.WarningPercent,
+			CriticalPercent:    req.Body.LowBattery.CriticalPercent,
+			ChargerLocation:    req.Body.LowBattery.ChargerLocation,
+			ChargeCurrentLimit: req.Body.LowBattery.ChargeCurrentLimit,
+			MoveSpeed:          req.Body.LowBattery.MoveSpeed,
+		},
 	})
 	if err != nil {
 		return nil, fmt.Errorf("config service update command config: %w", err)
@@ -221,23 +268,37 @@ func (h configHandler) convertHardwareConfigToResponse(cfg config.Hardware) gen.
 			Serial:            h.convertSerialConfigToResponse(cfg.PIC.Serial),
 			EnableAck:         cfg.PIC.EnableACK,
 			CommandAckTimeout: int(cfg.PIC.CommandACKTimeout.Milliseconds()),
+			CommandAckRetries: cfg.PIC.CommandACKRetries,
 		},
 		Esp: gen.ESPConfig{
 			Serial:            h.convertSerialConfigToResponse(cfg.ESP.Serial),
 			EnableAck:         cfg.ESP.EnableACK,
 			CommandAckTimeout: int(cfg.ESP.CommandACKTimeout.Milliseconds()),
+			CommandAckRetries: cfg.ESP.CommandACKRetries,
+		},
+		BatteryCells: gen.BatteryCellsConfig{
+			MinVoltage:   cfg.BatteryCells.MinVoltage,
+			MaxVoltage:   cfg.BatteryCells.MaxVoltage,
+			MaxImbalance: cfg.BatteryCells.MaxImbalance,
+		},
+		Rfid: gen.RFIDConfig{
+			SerialPort: cfg.RFID.SerialPort,
 		},
 	}
 }
 
 func (configHandler) convertSerialConfigToResponse(cfg config.Serial) gen.SerialConfig {
 	return gen.SerialConfig{
-		Port:        cfg.Port,
-		BaudRate:    cfg.BaudRate,
-		DataBits:    int(cfg.DataBits),
-		Parity:      cfg.Parity,
-		StopBits:    float64(cfg.StopBits),
-		ReadTimeout: int(cfg.ReadTimeout.Seconds()),
+		Port:                  cfg.Port,
+		BaudRate:              cfg.BaudRate,
+		DataBits:              int(cfg.DataBits),
+		Parity:                cfg.Parity,
+		StopBits:              float64(cfg.StopBits),
+		ReadTimeout:           int(cfg.ReadTimeout.Seconds()),
+		ReconnectMinBackoffMs: int(cfg.ReconnectMinBackoff.Milliseconds()),
+		ReconnectMaxBackoffMs: int(cfg.ReconnectMaxBackoff.Milliseconds()),
+		Framing:               string(cfg.Framing),
+		Codec:                 string(cfg.Codec),
 	}
 }
 
@@ -339,7 +400,7 @@ func (configHandler) convertLogFormatReqToModel(format string) (config.LogFormat
 	}
 }
 
-func (configHandler) convertCommandConfigToResponse(cfg config.Command) gen.CommandConfig {
+func (h configHandler) convertCommandConfigToResponse(cfg config.Command) gen.CommandConfig {
 	return gen.CommandConfig{
 		CargoLift: gen.CargoLiftConfig{
 			StableReadCount: cfg.CargoLift.StableReadCount,
@@ -351,5 +412,154 @@ func (configHandler) convertCommandConfigToResponse(cfg config.Command) gen.Comm
 				ExitDistance:  cfg.CargoLower.BottomObstacleTracking.ExitDistance,
 			},
 		},
+		Preemption: gen.PreemptionConfig{
+			Policy: string(cfg.Preemption.Policy),
+		},
+		Timeout: h.convertCommandTimeoutConfigToResponse(cfg.Timeout),
+		Retry:   h.convertCommandRetryConfigToResponse(cfg.Retry),
+		Recovery: gen.CommandRecoveryConfig{
+			Policy:         string(cfg.Recovery.Policy),
+			ResumableTypes: cfg.Recovery.ResumableTypes,
+		},
+		Drive: gen.DriveConfig{
+			ObstacleTracking: gen.ObstacleTracking{
+				EnterDistance: cfg.Drive.ObstacleTracking.EnterDistance,
+				ExitDistance:  cfg.Drive.ObstacleTracking.ExitDistance,
+			},
+			TrackManualDrive: cfg.Drive.TrackManualDrive,
+		},
+		MoveTo: h.convertMoveToConfigToResponse(cfg.MoveTo),
+		Battery: gen.BatteryCommandConfig{
+			MaxChargeCurrentLimit:    cfg.Battery.MaxChargeCurrentLimit,
+			MaxDischargeCurrentLimit: cfg.Battery.MaxDischargeCurrentLimit,
+			ConfirmTimeoutMs:         int(cfg.Battery.ConfirmTimeout.Milliseconds()),
+		},
+		LowBattery: gen.LowBatteryConfig{
+			WarningPercent:     cfg.LowBattery.WarningPercent,
+			CriticalPercent:    cfg.LowBattery.CriticalPercent,
+			ChargerLocation:    cfg.LowBattery.ChargerLocation,
+			ChargeCurrentLimit: cfg.LowBattery.ChargeCurrentLimit,
+			MoveSpeed:          cfg.LowBattery.MoveSpeed,
+		},
+	}
+}
+
+func (configHandler) convertMoveToConfigToResponse(cfg config.MoveTo) gen.MoveToConfig {
+	preTargetTags := make(map[string][]string, len(cfg.PreTargetTags))
+	for target, tags := range cfg.PreTargetTags {
+		preTargetTags[target] = tags
+	}
+
+	limits := make([]gen.SegmentSpeedLimit, 0, len(cfg.SegmentSpeedLimits))
+	for _, l := range cfg.SegmentSpeedLimits {
+		limits = append(limits, gen.SegmentSpeedLimit{
+			From:     l.From,
+			To:       l.To,
+			MaxSpeed: l.MaxSpeed,
+		})
+	}
+
+	return gen.MoveToConfig{
+		CreepSpeed:         cfg.CreepSpeed,
+		PreTargetTags:      preTargetTags,
+		SegmentSpeedLimits: limits,
+		OvershootWindowMs:  int(cfg.OvershootWindow.Milliseconds()),
+		MaxCorrections:     cfg.MaxCorrections,
+	}
+}
+
+func (configHandler) convertReqMoveToConfigToConfig(req gen.MoveToConfig) config.MoveTo {
+	limits := make([]config.SegmentSpeedLimit, 0, len(req.SegmentSpeedLimits))
+	for _, l := range req.SegmentSpeedLimits {
+		limits = append(limits, config.SegmentSpeedLimit{
+			From:     l.From,
+			To:       l.To,
+			MaxSpeed: l.MaxSpeed,
+		})
+	}
+
+	return config.MoveTo{
+		CreepSpeed:         req.CreepSpeed,
+		PreTargetTags:      req.PreTargetTags,
+		SegmentSpeedLimits: limits,
+		OvershootWindow:    time.Duration(req.OvershootWindowMs) * time.Millisecond,
+		MaxCorrections:     req.MaxCorrections,
+	}
+}
+
+func (configHandler) convertCommandTimeoutConfigToResponse(cfg config.Timeout) gen.CommandTimeoutConfig {
+	perType := make(map[string]int, len(cfg.PerType))
+	for t, d := range cfg.PerType {
+		perType[t] = int(d.Seconds())
+	}
+
+	return gen.CommandTimeoutConfig{
+		Default: int(cfg.Default.Seconds()),
+		PerType: perType,
+	}
+}
+
+func (configHandler) convertReqCommandTimeoutToConfig(req gen.CommandTimeoutConfig) config.Timeout {
+	perType := make(map[string]time.Duration, len(req.PerType))
+	for t, d := range req.PerType {
+		perType[t] = time.Duration(d) * time.Second
+	}
+
+	return config.Timeout{
+		Default: time.Duration(req.Default) * time.Second,
+		PerType: perType,
+	}
+}
+
+func (configHandler) convertCommandRetryConfigToResponse(cfg config.Retry) gen.CommandRetryConfig {
+	perType := make(map[string]gen.RetryPolicy, len(cfg.PerType))
+	for t, p := range cfg.PerType {
+		perType[t] = convertRetryPolicyToResponse(p)
+	}
+
+	return gen.CommandRetryConfig{
+		Default: convertRetryPolicyToResponse(cfg.Default),
+		PerType: perType,
+	}
+}
+
+func (configHandler) convertReqCommandRetryToConfig(req gen.CommandRetryConfig) config.Retry {
+	perType := make(map[string]config.RetryPolicy, len(req.PerType))
+	for t, p := range req.PerType {
+		perType[t] = convertReqRetryPolicyToConfig(p)
+	}
+
+	return config.Retry{
+		Default: convertReqRetryPolicyToConfig(req.Default),
+		PerType: perType,
+	}
+}
+
+// convertRetryPolicyToResponse is shared by the command and config handlers.
+func convertRetryPolicyToResponse(p config.RetryPolicy) gen.RetryPolicy {
+	retryableErrors := make([]gen.RetryableError, len(p.RetryableErrors))
+	for i, e := range p.RetryableErrors {
+		retryableErrors[i] = gen.RetryableError(e)
+	}
+
+	return gen.RetryPolicy{
+		MaxAttempts:     p.MaxAttempts,
+		Backoff:         int(p.Backoff.Milliseconds()),
+		MaxBackoff:      int(p.MaxBackoff.Milliseconds()),
+		RetryableErrors: retryableErrors,
+	}
+}
+
+func convertReqRetryPolicyToConfig(req gen.RetryPolicy) config.RetryPolicy {
+	retryableErrors := make([]config.RetryableError, len(req.RetryableErrors))
+	for i, e := range req.RetryableErrors {
+		retryableErrors[i] = config.RetryableError(e)
+	}
+
+	return config.RetryPolicy{
+		MaxAttempts:     req.MaxAttempts,
+		Backoff:         time.Duration(req.Backoff) * time.Millisecond,
+		MaxBackoff:      time.Duration(req.MaxBackoff) * time.Millisecond,
+		RetryableErrors: retryableErrors,
 	}
 }
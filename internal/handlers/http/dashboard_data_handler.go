@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/tbe-team/raybot/internal/handlers/http/gen"
+	"github.com/tbe-team/raybot/internal/services/battery"
 	"github.com/tbe-team/raybot/internal/services/dashboarddata"
 )
 
@@ -39,6 +40,7 @@ func (h dashboardDataHandler) convertRobotStateToResponse(state dashboarddata.Ro
 			CellVoltages: state.Battery.CellVoltages,
 			Health:       state.Battery.Health,
 			UpdatedAt:    state.Battery.UpdatedAt,
+			Diagnostics:  h.convertBatteryDiagnosticsToResponse(state.Battery.Diagnostics),
 		},
 		Charge: gen.ChargeState{
 			CurrentLimit: state.BatteryCharge.CurrentLimit,
@@ -98,11 +100,13 @@ func (h dashboardDataHandler) convertRobotStateToResponse(state dashboarddata.Ro
 				Connected:       state.AppState.ESPSerialConnection.Connected,
 				LastConnectedAt: state.AppState.ESPSerialConnection.LastConnectedAt,
 				Error:           state.AppState.ESPSerialConnection.Error,
+				ReconnectCount:  state.AppState.ESPSerialConnection.ReconnectCount,
 			},
 			PicSerialConnection: gen.PICSerialConnection{
 				Connected:       state.AppState.PICSerialConnection.Connected,
 				LastConnectedAt: state.AppState.PICSerialConnection.LastConnectedAt,
 				Error:           state.AppState.PICSerialConnection.Error,
+				ReconnectCount:  state.AppState.PICSerialConnection.ReconnectCount,
 			},
 			RfidUsbConnection: gen.RFIDUSBConnection{
 				Connected:       state.AppState.RFIDUSBConnection.Connected,
@@ -110,6 +114,37 @@ func (h dashboardDataHandler) convertRobotStateToResponse(state dashboarddata.Ro
 				Error:           state.AppState.RFIDUSBConnection.Error,
 			},
 		},
+		CommandQueue: convertCommandQueueStateToResponse(state.CommandQueue),
+		LiftCalibration: gen.LiftCalibrationState{
+			Calibrated:   state.LiftCalibration.Calibrated(),
+			ZeroOffset:   state.LiftCalibration.ZeroOffset,
+			CalibratedAt: state.LiftCalibration.CalibratedAt,
+			UpdatedAt:    state.LiftCalibration.UpdatedAt,
+		},
+		BatteryPolicy: gen.BatteryPolicyState{
+			Level:     state.BatteryPolicy.Level.String(),
+			Percent:   state.BatteryPolicy.Percent,
+			UpdatedAt: state.BatteryPolicy.UpdatedAt,
+		},
+	}
+}
+
+func (dashboardDataHandler) convertBatteryDiagnosticsToResponse(diagnostics battery.Diagnostics) gen.BatteryDiagnostics {
+	faults := make([]string, 0, len(diagnostics.Faults))
+	for _, f := range diagnostics.Faults {
+		faults = append(faults, f.String())
+	}
+
+	outOfBoundsCells := diagnostics.OutOfBoundsCells
+	if outOfBoundsCells == nil {
+		outOfBoundsCells = []int{}
+	}
+
+	return gen.BatteryDiagnostics{
+		Faults:            faults,
+		CellImbalance:     diagnostics.CellImbalance,
+		ImbalanceExceeded: diagnostics.ImbalanceExceeded,
+		OutOfBoundsCells:  outOfBoundsCells,
 	}
 }
 
@@ -46,6 +46,10 @@ func TestDashboardDataHandler_GetRobotState(t *testing.T) {
 		require.Equal(t, validRobotState.Battery.Percent, res.Battery.Percent)
 		require.Equal(t, validRobotState.Battery.Fault, res.Battery.Fault)
 		require.Equal(t, validRobotState.Battery.Health, res.Battery.Health)
+		require.Equal(t, []string{"OVER_TEMP"}, res.Battery.Diagnostics.Faults)
+		require.Equal(t, validRobotState.Battery.Diagnostics.CellImbalance, res.Battery.Diagnostics.CellImbalance)
+		require.True(t, res.Battery.Diagnostics.ImbalanceExceeded)
+		require.Equal(t, validRobotState.Battery.Diagnostics.OutOfBoundsCells, res.Battery.Diagnostics.OutOfBoundsCells)
 		require.NotEmpty(t, validRobotState.Battery.UpdatedAt)
 
 		require.Equal(t, validRobotState.BatteryCharge.CurrentLimit, res.Charge.CurrentLimit)
@@ -67,6 +71,10 @@ func TestDashboardDataHandler_GetRobotState(t *testing.T) {
 		require.Equal(t, validRobotState.LiftMotor.Enabled, res.LiftMotor.Enabled)
 		require.NotEmpty(t, validRobotState.LiftMotor.UpdatedAt)
 
+		require.False(t, res.LiftCalibration.Calibrated)
+		require.Equal(t, validRobotState.LiftCalibration.ZeroOffset, res.LiftCalibration.ZeroOffset)
+		require.Nil(t, res.LiftCalibration.CalibratedAt)
+
 		require.Equal(t, validRobotState.DriveMotor.Direction.String(), "BACKWARD")
 		require.Equal(t, validRobotState.DriveMotor.Speed, res.DriveMotor.Speed)
 		require.Equal(t, validRobotState.DriveMotor.IsRunning, res.DriveMotor.IsRunning)
@@ -164,9 +172,15 @@ var validRobotState = dashboarddata.RobotState{
 		Temp:         25,
 		CellVoltages: []uint16{50, 60, 70, 80},
 		Percent:      100,
-		Fault:        0,
+		Fault:        8,
 		Health:       100,
-		UpdatedAt:    time.Now(),
+		Diagnostics: battery.Diagnostics{
+			Faults:            []battery.Fault{battery.FaultOverTemp},
+			CellImbalance:     30,
+			ImbalanceExceeded: true,
+			OutOfBoundsCells:  []int{0},
+		},
+		UpdatedAt: time.Now(),
 	},
 	BatteryCharge: battery.ChargeSetting{
 		CurrentLimit: 100,
@@ -191,6 +205,10 @@ var validRobotState = dashboarddata.RobotState{
 		Enabled:         true,
 		UpdatedAt:       time.Now(),
 	},
+	LiftCalibration: liftmotor.Calibration{
+		ZeroOffset: 12,
+		UpdatedAt:  time.Now(),
+	},
 	DriveMotor: drivemotor.DriveMotorState{
 		Direction: drivemotor.DirectionBackward,
 		Speed:     50,
@@ -10,6 +10,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
@@ -22,6 +23,18 @@ import (
 	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
 )
 
+// Defines values for QueueMove.
+const (
+	BACK  QueueMove = "BACK"
+	FRONT QueueMove = "FRONT"
+)
+
+// Defines values for RetryableError.
+const (
+	ACKTIMEOUT         RetryableError = "ACK_TIMEOUT"
+	SERIALNOTCONNECTED RetryableError = "SERIAL_NOT_CONNECTED"
+)
+
 // APConfig defines model for APConfig.
 type APConfig struct {
 	// Enable Whether to enable the AP mode
@@ -45,6 +58,99 @@ type AppConnection struct {
 	RfidUsbConnection   RFIDUSBConnection   `json:"rfidUsbConnection"`
 }
 
+// AttemptError defines model for AttemptError.
+type AttemptError struct {
+	// Attempt The attempt number
+	Attempt uint32 `json:"attempt"`
+
+	// Error The error of the attempt
+	Error string `json:"error"`
+
+	// FailedAt The time the attempt failed
+	FailedAt time.Time `json:"failedAt"`
+}
+
+// BatteryCellsConfig defines model for BatteryCellsConfig.
+type BatteryCellsConfig struct {
+	// MinVoltage The minimum cell voltage in mV, 0 disables the check
+	MinVoltage uint16 `json:"minVoltage"`
+
+	// MaxVoltage The maximum cell voltage in mV, 0 disables the check
+	MaxVoltage uint16 `json:"maxVoltage"`
+
+	// MaxImbalance The maximum difference between the highest and the lowest cell voltage in mV, 0 disables the check
+	MaxImbalance uint16 `json:"maxImbalance"`
+}
+
+// BatteryCommandConfig defines model for BatteryCommandConfig.
+type BatteryCommandConfig struct {
+	// MaxChargeCurrentLimit The maximum charge current limit that can be set, 0 means no maximum
+	MaxChargeCurrentLimit uint16 `json:"maxChargeCurrentLimit"`
+
+	// MaxDischargeCurrentLimit The maximum discharge current limit that can be set, 0 means no maximum
+	MaxDischargeCurrentLimit uint16 `json:"maxDischargeCurrentLimit"`
+
+	// ConfirmTimeoutMs How long to wait for the PIC to report the new setting, 0 uses the default
+	ConfirmTimeoutMs int `json:"confirmTimeoutMs"`
+}
+
+// BatteryDiagnostics defines model for BatteryDiagnostics.
+type BatteryDiagnostics struct {
+	// Faults The conditions set in the fault bitfield of the battery. The bit layout is not confirmed by the PIC firmware yet, check the raw fault
+	Faults []string `json:"faults"`
+
+	// CellImbalance The difference between the highest and the lowest cell voltage in mV
+	CellImbalance uint16 `json:"cellImbalance"`
+
+	// ImbalanceExceeded Whether the cell imbalance is above the configured maximum
+	ImbalanceExceeded bool `json:"imbalanceExceeded"`
+
+	// OutOfBoundsCells The indexes of the cells outside the configured voltage bounds
+	OutOfBoundsCells []int `json:"outOfBoundsCells"`
+}
+
+// BatteryPolicyState defines model for BatteryPolicyState.
+type BatteryPolicyState struct {
+	// Level The low-battery level, new commands except the charging ones are rejected at CRITICAL
+	Level string `json:"level"`
+
+	// Percent The last battery percent handled by the policy
+	Percent uint8 `json:"percent"`
+
+	// UpdatedAt The date and time of the last battery update
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// BatterySetChargeInputs defines model for BatterySetChargeInputs.
+type BatterySetChargeInputs struct {
+	// CurrentLimit The charge current limit, required if charging is enabled
+	CurrentLimit uint16 `json:"currentLimit"`
+
+	// Enabled Whether charging is enabled
+	Enabled bool `json:"enabled"`
+}
+
+// BatterySetChargeOutputs defines model for BatterySetChargeOutputs.
+type BatterySetChargeOutputs struct {
+	// ConfirmedAt The date and time when the PIC reported the new setting
+	ConfirmedAt time.Time `json:"confirmedAt"`
+}
+
+// BatterySetDischargeInputs defines model for BatterySetDischargeInputs.
+type BatterySetDischargeInputs struct {
+	// CurrentLimit The discharge current limit, required if discharging is enabled
+	CurrentLimit uint16 `json:"currentLimit"`
+
+	// Enabled Whether discharging is enabled
+	Enabled bool `json:"enabled"`
+}
+
+// BatterySetDischargeOutputs defines model for BatterySetDischargeOutputs.
+type BatterySetDischargeOutputs struct {
+	// ConfirmedAt The date and time when the PIC reported the new setting
+	ConfirmedAt time.Time `json:"confirmedAt"`
+}
+
 // BatteryState defines model for BatteryState.
 type BatteryState struct {
 	// Current The current of the battery
@@ -69,7 +175,8 @@ type BatteryState struct {
 	Health uint8 `json:"health"`
 
 	// UpdatedAt The updated at time of the battery
-	UpdatedAt time.Time `json:"updatedAt"`
+	UpdatedAt   time.Time          `json:"updatedAt"`
+	Diagnostics BatteryDiagnostics `json:"diagnostics"`
 }
 
 // BottomObstacleTracking defines model for BottomObstacleTracking.
@@ -117,6 +224,21 @@ type CargoDoorMotorState struct {
 	UpdatedAt time.Time `json:"updatedAt"`
 }
 
+// CargoHomeInputs defines model for CargoHomeInputs.
+type CargoHomeInputs struct {
+	// MotorSpeed The speed of the motor
+	MotorSpeed MotorSpeed `json:"motorSpeed"`
+}
+
+// CargoHomeOutputs defines model for CargoHomeOutputs.
+type CargoHomeOutputs struct {
+	// ZeroOffset The down distance read at the top limit switch, used as the lift zero
+	ZeroOffset uint16 `json:"zeroOffset"`
+
+	// CalibratedAt The date and time when the lift was calibrated
+	CalibratedAt time.Time `json:"calibratedAt"`
+}
+
 // CargoLiftConfig defines model for CargoLiftConfig.
 type CargoLiftConfig struct {
 	// StableReadCount The number of stable reads required to consider the lift position reached
@@ -125,7 +247,7 @@ type CargoLiftConfig struct {
 
 // CargoLiftInputs defines model for CargoLiftInputs.
 type CargoLiftInputs struct {
-	// Position The position to lift the cargo
+	// Position The position to lift the cargo, relative to the lift zero calibrated by CARGO_HOME
 	Position uint16 `json:"position"`
 
 	// MotorSpeed The speed of the motor
@@ -144,7 +266,7 @@ type CargoLowerConfig struct {
 
 // CargoLowerInputs defines model for CargoLowerInputs.
 type CargoLowerInputs struct {
-	// Position The position to lower the cargo
+	// Position The position to lower the cargo, relative to the lift zero calibrated by CARGO_HOME
 	Position uint16 `json:"position"`
 
 	// BottomObstacleTracking This field is deprecated and will be removed in the future, use command config instead
@@ -218,8 +340,16 @@ type CloudConnection struct {
 
 // CommandConfig defines model for CommandConfig.
 type CommandConfig struct {
-	CargoLift  CargoLiftConfig  `json:"cargoLift"`
-	CargoLower CargoLowerConfig `json:"cargoLower"`
+	Battery    BatteryCommandConfig  `json:"battery"`
+	CargoLift  CargoLiftConfig       `json:"cargoLift"`
+	CargoLower CargoLowerConfig      `json:"cargoLower"`
+	Drive      DriveConfig           `json:"drive"`
+	LowBattery LowBatteryConfig      `json:"lowBattery"`
+	MoveTo     MoveToConfig          `json:"moveTo"`
+	Preemption PreemptionConfig      `json:"preemption"`
+	Recovery   CommandRecoveryConfig `json:"recovery"`
+	Retry      CommandRetryConfig    `json:"retry"`
+	Timeout    CommandTimeoutConfig  `json:"timeout"`
 }
 
 // CommandInputs defines model for CommandInputs.
@@ -232,6 +362,67 @@ type CommandOutputs struct {
 	union json.RawMessage
 }
 
+// CommandProgressResponse defines model for CommandProgressResponse.
+type CommandProgressResponse struct {
+	// CommandId The id of the command
+	CommandId int `json:"commandId"`
+
+	// CommandType The type of command
+	CommandType CommandType `json:"commandType"`
+
+	// Phase The step the command is in, empty until the executor reports the progress
+	Phase string `json:"phase"`
+
+	// Percentage The completion from 0 to 100, null if it can not be computed
+	Percentage *uint8 `json:"percentage"`
+	Message    string `json:"message"`
+
+	// UpdatedAt The time of the last progress report, null until the executor reports the progress
+	UpdatedAt *time.Time `json:"updatedAt"`
+}
+
+// CommandQueueState defines model for CommandQueueState.
+type CommandQueueState struct {
+	// Paused Whether the queue is paused, only the battery policy commands are started while the queue is paused
+	Paused bool `json:"paused"`
+
+	// PausedBy The source that paused the queue
+	PausedBy *string `json:"pausedBy"`
+
+	// Reason The reason the queue was paused
+	Reason *string `json:"reason"`
+
+	// PausedAt The time the queue was paused
+	PausedAt *time.Time `json:"pausedAt"`
+
+	// UpdatedAt The time the queue state was last updated
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// CommandRecovery defines model for CommandRecovery.
+type CommandRecovery struct {
+	// Action The action applied to the command on startup
+	Action string `json:"action"`
+
+	// Policy The startup recovery policy that was applied
+	Policy string `json:"policy"`
+
+	// PreviousStatus The status of the command when the application started
+	PreviousStatus string `json:"previousStatus"`
+
+	// RecoveredAt The time the decision was made
+	RecoveredAt time.Time `json:"recoveredAt"`
+}
+
+// CommandRecoveryConfig defines model for CommandRecoveryConfig.
+type CommandRecoveryConfig struct {
+	// Policy The action applied on startup to the commands that were queued or processing when the application stopped
+	Policy string `json:"policy"`
+
+	// ResumableTypes The command types that are put back into the queue by the RESUME policy when they were interrupted
+	ResumableTypes []string `json:"resumableTypes"`
+}
+
 // CommandResponse defines model for CommandResponse.
 type CommandResponse struct {
 	// Id The id of the command
@@ -262,6 +453,35 @@ type CommandResponse struct {
 
 	// UpdatedAt The update date of the command
 	UpdatedAt time.Time `json:"updatedAt"`
+
+	// Priority The priority of the command, commands with higher priority are executed first
+	Priority int64 `json:"priority"`
+
+	// Timeout The timeout of the command in seconds, null if the timeout of the command type is used
+	Timeout *int `json:"timeout"`
+
+	// Deadline The time by which the command must be completed
+	Deadline *time.Time `json:"deadline"`
+
+	// RetryPolicy The retry policy of the command, null if the retry policy of the command type is used
+	RetryPolicy *RetryPolicy `json:"retryPolicy"`
+
+	// Attempts The number of failed attempts of the command, a suspension is not a failed attempt
+	Attempts uint32 `json:"attempts"`
+
+	// AttemptErrors The errors of the failed attempts of the command
+	AttemptErrors []AttemptError `json:"attemptErrors"`
+
+	// Recovery The startup recovery decision of the command, null if the command was not pending on startup
+	Recovery *CommandRecovery `json:"recovery"`
+}
+
+// CommandRetryConfig defines model for CommandRetryConfig.
+type CommandRetryConfig struct {
+	Default RetryPolicy `json:"default"`
+
+	// PerType The retry policy by command type
+	PerType map[string]RetryPolicy `json:"perType"`
 }
 
 // CommandSource The source of the command
@@ -270,6 +490,15 @@ type CommandSource = string
 // CommandStatus The status of the command
 type CommandStatus = string
 
+// CommandTimeoutConfig defines model for CommandTimeoutConfig.
+type CommandTimeoutConfig struct {
+	// Default The timeout in seconds of command types without their own timeout, 0 means no timeout
+	Default int `json:"default"`
+
+	// PerType The timeout in seconds by command type, 0 means no timeout. It also bounds the steps of MISSION and DELIVER of that type
+	PerType map[string]int `json:"perType"`
+}
+
 // CommandType The type of command
 type CommandType = string
 
@@ -287,6 +516,70 @@ type CreateCommandRequest struct {
 	// Type The type of command
 	Type   CommandType   `json:"type"`
 	Inputs CommandInputs `json:"inputs"`
+
+	// Priority The priority of the command, commands with higher priority are executed first. Defaults to 0
+	Priority *int64 `json:"priority,omitempty"`
+
+	// Timeout The timeout of the command in seconds, the command fails if it is still running after the timeout. Defaults to the timeout of the command type in the command config
+	Timeout *int `json:"timeout,omitempty"`
+
+	// Deadline The time by which the command must be completed, the command fails if it is still running after the deadline
+	Deadline    *time.Time   `json:"deadline,omitempty"`
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
+}
+
+// CreateTrackMapTagRequest defines model for CreateTrackMapTagRequest.
+type CreateTrackMapTagRequest struct {
+	// Location The location read from the RFID tag
+	Location string `json:"location"`
+
+	// Name The station name of the tag
+	Name *string `json:"name,omitempty"`
+
+	// DistanceToNext The length in cm of the segment to the next tag, 0 if unknown
+	DistanceToNext *uint32 `json:"distanceToNext,omitempty"`
+
+	// Position The 0-based index the tag is inserted at, the tag is appended if not set
+	Position *uint `json:"position,omitempty"`
+}
+
+// DeliverInputs defines model for DeliverInputs.
+type DeliverInputs struct {
+	// Station The location of the station tag, or the station name in the track map
+	Station string `json:"station"`
+
+	// Direction The direction when moving, AUTO picks the shortest direction using the track map
+	Direction *MoveDirection `json:"direction,omitempty"`
+
+	// LowerPosition The position to lower the cargo to at the station
+	LowerPosition uint16 `json:"lowerPosition"`
+
+	// QrCode The QR code checked while the cargo is open, if empty the robot waits for waitDurationMs instead
+	QrCode *string `json:"qrCode,omitempty"`
+
+	// WaitDurationMs The time to wait while the cargo is open when there is no QR code
+	WaitDurationMs *int64 `json:"waitDurationMs,omitempty"`
+
+	// MoveSpeed The speed of the motor
+	MoveSpeed MotorSpeed `json:"moveSpeed"`
+
+	// LiftSpeed The speed of the motor
+	LiftSpeed MotorSpeed `json:"liftSpeed"`
+
+	// DoorSpeed The speed of the motor
+	DoorSpeed MotorSpeed `json:"doorSpeed"`
+}
+
+// DeliverOutputs defines model for DeliverOutputs.
+type DeliverOutputs struct {
+	// Location The location of the station
+	Location string `json:"location"`
+
+	// Phases The status and outputs of each phase of the delivery
+	Phases []MissionStepOutputs `json:"phases"`
+
+	// Rollback The steps run to close and lift the cargo after the delivery failed
+	Rollback []MissionStepOutputs `json:"rollback"`
 }
 
 // DischargeState defines model for DischargeState.
@@ -316,6 +609,15 @@ type DistanceSensorState struct {
 	UpdatedAt time.Time `json:"updatedAt"`
 }
 
+// DriveConfig defines model for DriveConfig.
+type DriveConfig struct {
+	ObstacleTracking ObstacleTracking `json:"obstacleTracking"`
+
+	// TrackManualDrive Whether MOVE_FORWARD and MOVE_BACKWARD run until canceled to track the obstacles.
+	// They hold the command queue while running, otherwise they complete once the drive motor is started.
+	TrackManualDrive bool `json:"trackManualDrive"`
+}
+
 // DriveMotorState defines model for DriveMotorState.
 type DriveMotorState struct {
 	// Direction The direction of the drive motor
@@ -343,6 +645,11 @@ type ESPConfig struct {
 
 	// CommandAckTimeout The timeout for the command ACK in milliseconds
 	CommandAckTimeout int `json:"commandAckTimeout"`
+
+	// CommandAckRetries Experimental. The number of times a command is sent again with the same ID when its ACK times out.
+	// It is only safe if the firmware ignores an ID it already ran, which the board firmware does not do yet,
+	// so a resend may run the command twice.
+	CommandAckRetries uint8 `json:"commandAckRetries"`
 }
 
 // ESPSerialConnection defines model for ESPSerialConnection.
@@ -350,6 +657,9 @@ type ESPSerialConnection struct {
 	Connected       bool       `json:"connected"`
 	LastConnectedAt *time.Time `json:"lastConnectedAt"`
 	Error           *string    `json:"error"`
+
+	// ReconnectCount The number of times the lost link was opened again
+	ReconnectCount uint32 `json:"reconnectCount"`
 }
 
 // ErrorCodeResponse defines model for ErrorCodeResponse.
@@ -388,8 +698,10 @@ type HTTPConfig struct {
 
 // HardwareConfig defines model for HardwareConfig.
 type HardwareConfig struct {
-	Esp ESPConfig `json:"esp"`
-	Pic PICConfig `json:"pic"`
+	BatteryCells BatteryCellsConfig `json:"batteryCells"`
+	Esp          ESPConfig          `json:"esp"`
+	Pic          PICConfig          `json:"pic"`
+	Rfid         RFIDConfig         `json:"rfid"`
 }
 
 // HealthResponse defines model for HealthResponse.
@@ -398,6 +710,30 @@ type HealthResponse struct {
 	Status string `json:"status"`
 }
 
+// ImportTrackMapFromScanRequest defines model for ImportTrackMapFromScanRequest.
+type ImportTrackMapFromScanRequest struct {
+	// CommandId The id of a succeeded SCAN_LOCATION command
+	CommandId int `json:"commandId"`
+
+	// Topology The shape of the rail, the last tag of a loop is followed by the first tag
+	Topology TrackMapTopology `json:"topology"`
+}
+
+// LiftCalibrationState defines model for LiftCalibrationState.
+type LiftCalibrationState struct {
+	// Calibrated Whether the lift was calibrated by CARGO_HOME, lift positions are raw down distances until it is
+	Calibrated bool `json:"calibrated"`
+
+	// ZeroOffset The down distance read at the top limit switch, used as the lift zero
+	ZeroOffset uint16 `json:"zeroOffset"`
+
+	// CalibratedAt The date and time when the lift was calibrated
+	CalibratedAt *time.Time `json:"calibratedAt"`
+
+	// UpdatedAt The updated at time of the lift calibration
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
 // LiftMotorState defines model for LiftMotorState.
 type LiftMotorState struct {
 	// CurrentPosition The current position of the lift motor
@@ -430,6 +766,36 @@ type LimitSwitchState struct {
 	LimitSwitch1 LimitSwitch `json:"limitSwitch1"`
 }
 
+// LinkStats defines model for LinkStats.
+type LinkStats struct {
+	// CommandsSent The number of commands sent with ACK enabled, not counting the resends
+	CommandsSent uint64 `json:"commandsSent"`
+
+	// CommandsAcked The number of commands acknowledged by the board
+	CommandsAcked uint64 `json:"commandsAcked"`
+
+	// CommandsLost The number of commands not acknowledged after all the resends
+	CommandsLost uint64 `json:"commandsLost"`
+
+	// Resends The number of times a command was sent again after an ACK timeout
+	Resends uint64 `json:"resends"`
+
+	// AvgAckLatencyMs The average time from the first send of a command to its ACK in milliseconds
+	AvgAckLatencyMs float32 `json:"avgAckLatencyMs"`
+
+	// MaxAckLatencyMs The longest time from the first send of a command to its ACK in milliseconds
+	MaxAckLatencyMs float32 `json:"maxAckLatencyMs"`
+
+	// FramesReceived The number of valid frames received, only counted with the CRC16 framing
+	FramesReceived uint64 `json:"framesReceived"`
+
+	// FramesRejected The number of frames failing the version, length or CRC check
+	FramesRejected uint64 `json:"framesRejected"`
+
+	// FrameSequenceGaps The number of frames missing between the sequence numbers of valid frames
+	FrameSequenceGaps uint64 `json:"frameSequenceGaps"`
+}
+
 // Location defines model for Location.
 type Location struct {
 	// Location The location (RFID tag)
@@ -484,6 +850,81 @@ type LogFileHandler struct {
 	Format string `json:"format"`
 }
 
+// LowBatteryConfig defines model for LowBatteryConfig.
+type LowBatteryConfig struct {
+	// WarningPercent The battery percent at or below which an alert is raised, 0 disables the alert
+	WarningPercent uint8 `json:"warningPercent"`
+
+	// CriticalPercent The battery percent at or below which new work is rejected and the robot returns to the charger, 0 disables the return
+	CriticalPercent uint8 `json:"criticalPercent"`
+
+	// ChargerLocation The location of the charger station, it must be in the track map when criticalPercent is set
+	ChargerLocation string `json:"chargerLocation"`
+
+	// ChargeCurrentLimit The charge current limit set once the robot reaches the charger
+	ChargeCurrentLimit uint16 `json:"chargeCurrentLimit"`
+
+	// MoveSpeed The speed used to move to the charger, 0 uses the default
+	MoveSpeed uint8 `json:"moveSpeed"`
+}
+
+// MissionInputs defines model for MissionInputs.
+type MissionInputs struct {
+	// Steps The steps to execute in order
+	Steps []MissionStep `json:"steps"`
+}
+
+// MissionOutputs defines model for MissionOutputs.
+type MissionOutputs struct {
+	// Steps The status and outputs of each step
+	Steps []MissionStepOutputs `json:"steps"`
+}
+
+// MissionStep defines model for MissionStep.
+type MissionStep struct {
+	// Type The type of command
+	Type   CommandType   `json:"type"`
+	Inputs CommandInputs `json:"inputs"`
+}
+
+// MissionStepOutputs defines model for MissionStepOutputs.
+type MissionStepOutputs struct {
+	// Type The type of command
+	Type CommandType `json:"type"`
+
+	// Status The status of the command
+	Status CommandStatus `json:"status"`
+
+	// Outputs The outputs of the step, null if the step has not been executed
+	Outputs *CommandOutputs `json:"outputs"`
+
+	// Error The error of the step
+	Error *string `json:"error"`
+
+	// StartedAt The start date of the step
+	StartedAt *time.Time `json:"startedAt"`
+
+	// CompletedAt The completion date of the step
+	CompletedAt *time.Time `json:"completedAt"`
+}
+
+// MotionOutputs defines model for MotionOutputs.
+type MotionOutputs struct {
+	// StartLocation The location before moving, empty if unknown
+	StartLocation string `json:"startLocation"`
+
+	// FinalLocation The location the robot stopped at, empty if it did not stop at a known location
+	FinalLocation string      `json:"finalLocation"`
+	TagsPassed    []PassedTag `json:"tagsPassed"`
+
+	// TravelDurationMs The time from starting to stopping in milliseconds, including the obstacle pauses
+	TravelDurationMs int64 `json:"travelDurationMs"`
+
+	// AverageMotorSpeed The time-weighted average motor speed setpoint (0-100) while the motor was running, not a travel speed
+	AverageMotorSpeed float32         `json:"averageMotorSpeed"`
+	ObstaclePauses    []ObstaclePause `json:"obstaclePauses"`
+}
+
 // MotorSpeed The speed of the motor
 type MotorSpeed = uint8
 
@@ -494,9 +935,9 @@ type MoveBackwardInputs struct {
 }
 
 // MoveBackwardOutputs defines model for MoveBackwardOutputs.
-type MoveBackwardOutputs = map[string]interface{}
+type MoveBackwardOutputs = MotionOutputs
 
-// MoveDirection The direction when moving
+// MoveDirection The direction when moving, AUTO picks the shortest direction using the track map
 type MoveDirection = string
 
 // MoveForwardInputs defines model for MoveForwardInputs.
@@ -506,12 +947,35 @@ type MoveForwardInputs struct {
 }
 
 // MoveForwardOutputs defines model for MoveForwardOutputs.
-type MoveForwardOutputs = map[string]interface{}
+type MoveForwardOutputs = MotionOutputs
+
+// MoveQueuedCommandRequest defines model for MoveQueuedCommandRequest.
+type MoveQueuedCommandRequest struct {
+	Move QueueMove `json:"move"`
+}
+
+// MoveToConfig defines model for MoveToConfig.
+type MoveToConfig struct {
+	// CreepSpeed The speed used after passing the tag before the target, 0 disables slowing down
+	CreepSpeed uint8 `json:"creepSpeed"`
+
+	// PreTargetTags The tags to slow down at by target tag, used instead of the tag before the target in the track map
+	PreTargetTags map[string][]string `json:"preTargetTags"`
+
+	// SegmentSpeedLimits The maximum speeds between two tags, in both directions
+	SegmentSpeedLimits []SegmentSpeedLimit `json:"segmentSpeedLimits"`
+
+	// OvershootWindowMs How long locations are watched after stopping at the target to detect an overshoot, 0 disables the correction
+	OvershootWindowMs int `json:"overshootWindowMs"`
+
+	// MaxCorrections The maximum number of moves back to an overshot target
+	MaxCorrections uint8 `json:"maxCorrections"`
+}
 
 // MoveToInputs defines model for MoveToInputs.
 type MoveToInputs struct {
-	// Direction The direction when moving
-	Direction MoveDirection `json:"direction"`
+	// Direction The direction when moving, AUTO picks the shortest direction using the track map
+	Direction *MoveDirection `json:"direction,omitempty"`
 
 	// Location The location to move to
 	Location string `json:"location"`
@@ -521,7 +985,34 @@ type MoveToInputs struct {
 }
 
 // MoveToOutputs defines model for MoveToOutputs.
-type MoveToOutputs = map[string]interface{}
+type MoveToOutputs struct {
+	// StartLocation The location before moving, empty if unknown
+	StartLocation string `json:"startLocation"`
+
+	// FinalLocation The location the robot stopped at, empty if it did not stop at a known location
+	FinalLocation string      `json:"finalLocation"`
+	TagsPassed    []PassedTag `json:"tagsPassed"`
+
+	// TravelDurationMs The time from starting to stopping in milliseconds, including the obstacle pauses
+	TravelDurationMs int64 `json:"travelDurationMs"`
+
+	// AverageMotorSpeed The time-weighted average motor speed setpoint (0-100) while the motor was running, not a travel speed
+	AverageMotorSpeed float32         `json:"averageMotorSpeed"`
+	ObstaclePauses    []ObstaclePause `json:"obstaclePauses"`
+
+	// Corrections The number of moves back to the target after overshooting it
+	Corrections uint8 `json:"corrections"`
+}
+
+// ObstaclePause defines model for ObstaclePause.
+type ObstaclePause struct {
+	// Distance The distance to the obstacle that caused the pause (cm)
+	Distance uint16    `json:"distance"`
+	PausedAt time.Time `json:"pausedAt"`
+
+	// ResumedAt Null if the command ended while paused
+	ResumedAt *time.Time `json:"resumedAt"`
+}
 
 // ObstacleTracking defines model for ObstacleTracking.
 type ObstacleTracking struct {
@@ -541,6 +1032,11 @@ type PICConfig struct {
 
 	// CommandAckTimeout The timeout for the command ACK in milliseconds
 	CommandAckTimeout int `json:"commandAckTimeout"`
+
+	// CommandAckRetries Experimental. The number of times a command is sent again with the same ID when its ACK times out.
+	// It is only safe if the firmware ignores an ID it already ran, which the board firmware does not do yet,
+	// so a resend may run the command twice.
+	CommandAckRetries uint8 `json:"commandAckRetries"`
 }
 
 // PICSerialConnection defines model for PICSerialConnection.
@@ -548,6 +1044,48 @@ type PICSerialConnection struct {
 	Connected       bool       `json:"connected"`
 	LastConnectedAt *time.Time `json:"lastConnectedAt"`
 	Error           *string    `json:"error"`
+
+	// ReconnectCount The number of times the lost link was opened again
+	ReconnectCount uint32 `json:"reconnectCount"`
+}
+
+// PassedTag defines model for PassedTag.
+type PassedTag struct {
+	Location string    `json:"location"`
+	PassedAt time.Time `json:"passedAt"`
+}
+
+// PauseCommandQueueRequest defines model for PauseCommandQueueRequest.
+type PauseCommandQueueRequest struct {
+	// Reason The reason the queue is paused
+	Reason *string `json:"reason,omitempty"`
+}
+
+// PreemptionConfig defines model for PreemptionConfig.
+type PreemptionConfig struct {
+	// Policy The action applied to the running command when a command with a higher priority is created
+	Policy string `json:"policy"`
+}
+
+// QueueMove defines model for QueueMove.
+type QueueMove string
+
+// QueuePositionResponse defines model for QueuePositionResponse.
+type QueuePositionResponse struct {
+	// CommandId The id of the command
+	CommandId int `json:"commandId"`
+
+	// Position The position of the command in the queue, 1 is the next command to be executed
+	Position int `json:"position"`
+
+	// QueueLength The number of queued commands
+	QueueLength int `json:"queueLength"`
+}
+
+// RFIDConfig defines model for RFIDConfig.
+type RFIDConfig struct {
+	// SerialPort The port of a reader sending each tag as a line, the USB HID reader is used if empty
+	SerialPort string `json:"serialPort"`
 }
 
 // RFIDUSBConnection defines model for RFIDUSBConnection.
@@ -557,18 +1095,39 @@ type RFIDUSBConnection struct {
 	Error           *string    `json:"error"`
 }
 
+// RetryPolicy defines model for RetryPolicy.
+type RetryPolicy struct {
+	// MaxAttempts The maximum number of attempts, including the first one
+	MaxAttempts uint8 `json:"maxAttempts"`
+
+	// Backoff The delay in milliseconds before the first retry, doubled after every retry
+	Backoff int `json:"backoff"`
+
+	// MaxBackoff The maximum delay in milliseconds between retries, 0 means no limit
+	MaxBackoff int `json:"maxBackoff"`
+
+	// RetryableErrors The error classes that trigger a retry
+	RetryableErrors []RetryableError `json:"retryableErrors"`
+}
+
+// RetryableError defines model for RetryableError.
+type RetryableError string
+
 // RobotStateResponse defines model for RobotStateResponse.
 type RobotStateResponse struct {
-	Battery        BatteryState        `json:"battery"`
-	Charge         ChargeState         `json:"charge"`
-	Discharge      DischargeState      `json:"discharge"`
-	DistanceSensor DistanceSensorState `json:"distanceSensor"`
-	LiftMotor      LiftMotorState      `json:"liftMotor"`
-	DriveMotor     DriveMotorState     `json:"driveMotor"`
-	Location       LocationState       `json:"location"`
-	Cargo          CargoState          `json:"cargo"`
-	CargoDoorMotor CargoDoorMotorState `json:"cargoDoorMotor"`
-	AppConnection  AppConnection       `json:"appConnection"`
+	Battery         BatteryState         `json:"battery"`
+	Charge          ChargeState          `json:"charge"`
+	Discharge       DischargeState       `json:"discharge"`
+	DistanceSensor  DistanceSensorState  `json:"distanceSensor"`
+	LiftMotor       LiftMotorState       `json:"liftMotor"`
+	DriveMotor      DriveMotorState      `json:"driveMotor"`
+	Location        LocationState        `json:"location"`
+	Cargo           CargoState           `json:"cargo"`
+	CargoDoorMotor  CargoDoorMotorState  `json:"cargoDoorMotor"`
+	AppConnection   AppConnection        `json:"appConnection"`
+	CommandQueue    CommandQueueState    `json:"commandQueue"`
+	LiftCalibration LiftCalibrationState `json:"liftCalibration"`
+	BatteryPolicy   BatteryPolicyState   `json:"batteryPolicy"`
 }
 
 // STAConfig defines model for STAConfig.
@@ -594,6 +1153,68 @@ type ScanLocationOutputs struct {
 	Locations []Location `json:"locations"`
 }
 
+// ScheduleNextRunsResponse defines model for ScheduleNextRunsResponse.
+type ScheduleNextRunsResponse struct {
+	// Items The upcoming run times of the schedule
+	Items []time.Time `json:"items"`
+}
+
+// ScheduleResponse defines model for ScheduleResponse.
+type ScheduleResponse struct {
+	// Id The id of the schedule
+	Id int `json:"id"`
+
+	// Name The name of the schedule
+	Name string `json:"name"`
+
+	// Type The type of command
+	Type   CommandType   `json:"type"`
+	Inputs CommandInputs `json:"inputs"`
+
+	// Priority The priority of the command created by the schedule
+	Priority int64 `json:"priority"`
+
+	// RunAt The time of a one-time schedule
+	RunAt *time.Time `json:"runAt"`
+
+	// CronExpr The cron expression of a recurring schedule
+	CronExpr *string `json:"cronExpr"`
+
+	// Enabled Whether the schedule is enabled
+	Enabled bool `json:"enabled"`
+
+	// NextRunAt The next run time of the schedule, null if the schedule will not run anymore
+	NextRunAt *time.Time `json:"nextRunAt"`
+
+	// LastRunAt The last run time of the schedule
+	LastRunAt *time.Time `json:"lastRunAt"`
+
+	// LastCommandId The id of the last command created by the schedule
+	LastCommandId *int `json:"lastCommandId"`
+
+	// CreatedAt The creation date of the schedule
+	CreatedAt time.Time `json:"createdAt"`
+
+	// UpdatedAt The update date of the schedule
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// SchedulesListResponse defines model for SchedulesListResponse.
+type SchedulesListResponse struct {
+	// Items The list of schedules
+	Items []ScheduleResponse `json:"items"`
+
+	// TotalItems The total number of schedules
+	TotalItems int `json:"totalItems"`
+}
+
+// SegmentSpeedLimit defines model for SegmentSpeedLimit.
+type SegmentSpeedLimit struct {
+	From     string `json:"from"`
+	To       string `json:"to"`
+	MaxSpeed uint8  `json:"maxSpeed"`
+}
+
 // SerialConfig defines model for SerialConfig.
 type SerialConfig struct {
 	// Port The port name for the serial connection
@@ -613,6 +1234,18 @@ type SerialConfig struct {
 
 	// ReadTimeout The read timeout for the serial connection in seconds
 	ReadTimeout int `json:"readTimeout"`
+
+	// ReconnectMinBackoffMs The wait before reopening a lost port, doubled after each failed attempt. 0 uses the default
+	ReconnectMinBackoffMs int `json:"reconnectMinBackoffMs"`
+
+	// ReconnectMaxBackoffMs The maximum wait between the attempts to reopen a lost port. 0 uses the default
+	ReconnectMaxBackoffMs int `json:"reconnectMaxBackoffMs"`
+
+	// Framing The frame format of the board firmware. CRC16 adds a protocol version, a sequence number and a checksum to every frame
+	Framing string `json:"framing"`
+
+	// Codec The encoding of the messages of the board firmware. MSGPACK requires the CRC16 framing
+	Codec string `json:"codec"`
 }
 
 // SerialPort defines model for SerialPort.
@@ -648,29 +1281,113 @@ type SystemInfo struct {
 	TotalMemory float32 `json:"totalMemory"`
 
 	// Uptime The uptime of the system in seconds
-	Uptime float32 `json:"uptime"`
+	Uptime  float32   `json:"uptime"`
+	PicLink LinkStats `json:"picLink"`
+	EspLink LinkStats `json:"espLink"`
 }
 
-// Version defines model for Version.
-type Version struct {
-	BuildDate string `json:"buildDate"`
-	GoVersion string `json:"goVersion"`
-	Version   string `json:"version"`
-}
+// TrackMapFileFormat The file format of an exported track map
+type TrackMapFileFormat = string
 
-// WaitInputs defines model for WaitInputs.
-type WaitInputs struct {
-	// DurationMs The duration in milliseconds
-	DurationMs int `json:"durationMs"`
-}
+// TrackMapResponse defines model for TrackMapResponse.
+type TrackMapResponse struct {
+	// Topology The shape of the rail, the last tag of a loop is followed by the first tag
+	Topology TrackMapTopology `json:"topology"`
 
-// WaitOutputs defines model for WaitOutputs.
-type WaitOutputs = map[string]interface{}
+	// Tags The tags in the order they are passed when the robot moves forward
+	Tags []TrackMapTag `json:"tags"`
 
-// WifiConfig defines model for WifiConfig.
-type WifiConfig struct {
-	Ap  APConfig  `json:"ap"`
-	Sta STAConfig `json:"sta"`
+	// UpdatedAt The time the track map was last updated
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// TrackMapTag defines model for TrackMapTag.
+type TrackMapTag struct {
+	// Location The location read from the RFID tag
+	Location string `json:"location"`
+
+	// Name The station name of the tag, empty if the tag is not a station
+	Name string `json:"name"`
+
+	// DistanceToNext The length in cm of the segment to the next tag, 0 if unknown
+	DistanceToNext uint32 `json:"distanceToNext"`
+}
+
+// TrackMapTagRequest defines model for TrackMapTagRequest.
+type TrackMapTagRequest struct {
+	// Location The location read from the RFID tag
+	Location string `json:"location"`
+
+	// Name The station name of the tag
+	Name *string `json:"name,omitempty"`
+
+	// DistanceToNext The length in cm of the segment to the next tag, 0 if unknown
+	DistanceToNext *uint32 `json:"distanceToNext,omitempty"`
+}
+
+// TrackMapTopology The shape of the rail, the last tag of a loop is followed by the first tag
+type TrackMapTopology = string
+
+// UpdateTrackMapRequest defines model for UpdateTrackMapRequest.
+type UpdateTrackMapRequest struct {
+	// Topology The shape of the rail, the last tag of a loop is followed by the first tag
+	Topology TrackMapTopology `json:"topology"`
+
+	// Tags The tags in the order they are passed when the robot moves forward
+	Tags []TrackMapTagRequest `json:"tags"`
+}
+
+// UpdateTrackMapTagRequest defines model for UpdateTrackMapTagRequest.
+type UpdateTrackMapTagRequest struct {
+	// Name The station name of the tag
+	Name string `json:"name"`
+
+	// DistanceToNext The length in cm of the segment to the next tag, 0 if unknown
+	DistanceToNext uint32 `json:"distanceToNext"`
+}
+
+// UpsertScheduleRequest Exactly one of runAt and cronExpr must be set
+type UpsertScheduleRequest struct {
+	// Name The name of the schedule
+	Name string `json:"name"`
+
+	// Type The type of command
+	Type   CommandType   `json:"type"`
+	Inputs CommandInputs `json:"inputs"`
+
+	// Priority The priority of the command created by the schedule. Defaults to 0
+	Priority *int64 `json:"priority,omitempty"`
+
+	// RunAt The time of a one-time schedule, must be in the future
+	RunAt *time.Time `json:"runAt,omitempty"`
+
+	// CronExpr The standard 5-field cron expression of a recurring schedule. Descriptors like `@daily` and `@every 1h` are also supported.
+	CronExpr *string `json:"cronExpr,omitempty"`
+
+	// Enabled Whether the schedule is enabled
+	Enabled bool `json:"enabled"`
+}
+
+// Version defines model for Version.
+type Version struct {
+	BuildDate string `json:"buildDate"`
+	GoVersion string `json:"goVersion"`
+	Version   string `json:"version"`
+}
+
+// WaitInputs defines model for WaitInputs.
+type WaitInputs struct {
+	// DurationMs The duration in milliseconds
+	DurationMs int `json:"durationMs"`
+}
+
+// WaitOutputs defines model for WaitOutputs.
+type WaitOutputs = map[string]interface{}
+
+// WifiConfig defines model for WifiConfig.
+type WifiConfig struct {
+	Ap  APConfig  `json:"ap"`
+	Sta STAConfig `json:"sta"`
 }
 
 // Page defines model for Page.
@@ -691,6 +1408,7 @@ type ListCommandsParams struct {
 	//   - type
 	//   - status
 	//   - source
+	//   - priority
 	//   - created_at
 	//   - updated_at
 	//   - completed_at
@@ -705,9 +1423,40 @@ type ListCommandsParams struct {
 	Statuses *string `form:"statuses,omitempty" json:"statuses,omitempty"`
 }
 
+// ListSchedulesParams defines parameters for ListSchedules.
+type ListSchedulesParams struct {
+	// Page The page number
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize The number of items per page
+	PageSize *PageSize `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+}
+
+// ListScheduleNextRunsParams defines parameters for ListScheduleNextRuns.
+type ListScheduleNextRunsParams struct {
+	// Count The number of run times to list
+	Count *uint `form:"count,omitempty" json:"count,omitempty"`
+}
+
+// ExportTrackMapParams defines parameters for ExportTrackMap.
+type ExportTrackMapParams struct {
+	Format TrackMapFileFormat `form:"format" json:"format"`
+}
+
+// ImportTrackMapParams defines parameters for ImportTrackMap.
+type ImportTrackMapParams struct {
+	Format TrackMapFileFormat `form:"format" json:"format"`
+}
+
 // CreateCommandJSONRequestBody defines body for CreateCommand for application/json ContentType.
 type CreateCommandJSONRequestBody = CreateCommandRequest
 
+// PauseCommandQueueJSONRequestBody defines body for PauseCommandQueue for application/json ContentType.
+type PauseCommandQueueJSONRequestBody = PauseCommandQueueRequest
+
+// MoveQueuedCommandJSONRequestBody defines body for MoveQueuedCommand for application/json ContentType.
+type MoveQueuedCommandJSONRequestBody = MoveQueuedCommandRequest
+
 // UpdateCloudConfigJSONRequestBody defines body for UpdateCloudConfig for application/json ContentType.
 type UpdateCloudConfigJSONRequestBody = CloudConfig
 
@@ -726,6 +1475,24 @@ type UpdateLogConfigJSONRequestBody = LogConfig
 // UpdateWifiConfigJSONRequestBody defines body for UpdateWifiConfig for application/json ContentType.
 type UpdateWifiConfigJSONRequestBody = WifiConfig
 
+// CreateScheduleJSONRequestBody defines body for CreateSchedule for application/json ContentType.
+type CreateScheduleJSONRequestBody = UpsertScheduleRequest
+
+// UpdateScheduleByIdJSONRequestBody defines body for UpdateScheduleById for application/json ContentType.
+type UpdateScheduleByIdJSONRequestBody = UpsertScheduleRequest
+
+// UpdateTrackMapJSONRequestBody defines body for UpdateTrackMap for application/json ContentType.
+type UpdateTrackMapJSONRequestBody = UpdateTrackMapRequest
+
+// ImportTrackMapFromScanJSONRequestBody defines body for ImportTrackMapFromScan for application/json ContentType.
+type ImportTrackMapFromScanJSONRequestBody = ImportTrackMapFromScanRequest
+
+// CreateTrackMapTagJSONRequestBody defines body for CreateTrackMapTag for application/json ContentType.
+type CreateTrackMapTagJSONRequestBody = CreateTrackMapTagRequest
+
+// UpdateTrackMapTagJSONRequestBody defines body for UpdateTrackMapTag for application/json ContentType.
+type UpdateTrackMapTagJSONRequestBody = UpdateTrackMapTagRequest
+
 // AsStopInputs returns the union data inside the CommandInputs as a StopInputs
 func (t CommandInputs) AsStopInputs() (StopInputs, error) {
 	var body StopInputs
@@ -960,6 +1727,32 @@ func (t *CommandInputs) MergeCargoCheckQRInputs(v CargoCheckQRInputs) error {
 	return err
 }
 
+// AsCargoHomeInputs returns the union data inside the CommandInputs as a CargoHomeInputs
+func (t CommandInputs) AsCargoHomeInputs() (CargoHomeInputs, error) {
+	var body CargoHomeInputs
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromCargoHomeInputs overwrites any union data inside the CommandInputs as the provided CargoHomeInputs
+func (t *CommandInputs) FromCargoHomeInputs(v CargoHomeInputs) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeCargoHomeInputs performs a merge with any union data inside the CommandInputs, using the provided CargoHomeInputs
+func (t *CommandInputs) MergeCargoHomeInputs(v CargoHomeInputs) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 // AsScanLocationInputs returns the union data inside the CommandInputs as a ScanLocationInputs
 func (t CommandInputs) AsScanLocationInputs() (ScanLocationInputs, error) {
 	var body ScanLocationInputs
@@ -1012,6 +1805,110 @@ func (t *CommandInputs) MergeWaitInputs(v WaitInputs) error {
 	return err
 }
 
+// AsMissionInputs returns the union data inside the CommandInputs as a MissionInputs
+func (t CommandInputs) AsMissionInputs() (MissionInputs, error) {
+	var body MissionInputs
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromMissionInputs overwrites any union data inside the CommandInputs as the provided MissionInputs
+func (t *CommandInputs) FromMissionInputs(v MissionInputs) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeMissionInputs performs a merge with any union data inside the CommandInputs, using the provided MissionInputs
+func (t *CommandInputs) MergeMissionInputs(v MissionInputs) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsDeliverInputs returns the union data inside the CommandInputs as a DeliverInputs
+func (t CommandInputs) AsDeliverInputs() (DeliverInputs, error) {
+	var body DeliverInputs
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromDeliverInputs overwrites any union data inside the CommandInputs as the provided DeliverInputs
+func (t *CommandInputs) FromDeliverInputs(v DeliverInputs) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeDeliverInputs performs a merge with any union data inside the CommandInputs, using the provided DeliverInputs
+func (t *CommandInputs) MergeDeliverInputs(v DeliverInputs) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsBatterySetChargeInputs returns the union data inside the CommandInputs as a BatterySetChargeInputs
+func (t CommandInputs) AsBatterySetChargeInputs() (BatterySetChargeInputs, error) {
+	var body BatterySetChargeInputs
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromBatterySetChargeInputs overwrites any union data inside the CommandInputs as the provided BatterySetChargeInputs
+func (t *CommandInputs) FromBatterySetChargeInputs(v BatterySetChargeInputs) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeBatterySetChargeInputs performs a merge with any union data inside the CommandInputs, using the provided BatterySetChargeInputs
+func (t *CommandInputs) MergeBatterySetChargeInputs(v BatterySetChargeInputs) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsBatterySetDischargeInputs returns the union data inside the CommandInputs as a BatterySetDischargeInputs
+func (t CommandInputs) AsBatterySetDischargeInputs() (BatterySetDischargeInputs, error) {
+	var body BatterySetDischargeInputs
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromBatterySetDischargeInputs overwrites any union data inside the CommandInputs as the provided BatterySetDischargeInputs
+func (t *CommandInputs) FromBatterySetDischargeInputs(v BatterySetDischargeInputs) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeBatterySetDischargeInputs performs a merge with any union data inside the CommandInputs, using the provided BatterySetDischargeInputs
+func (t *CommandInputs) MergeBatterySetDischargeInputs(v BatterySetDischargeInputs) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t CommandInputs) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
@@ -1256,6 +2153,32 @@ func (t *CommandOutputs) MergeCargoCheckQROutputs(v CargoCheckQROutputs) error {
 	return err
 }
 
+// AsCargoHomeOutputs returns the union data inside the CommandOutputs as a CargoHomeOutputs
+func (t CommandOutputs) AsCargoHomeOutputs() (CargoHomeOutputs, error) {
+	var body CargoHomeOutputs
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromCargoHomeOutputs overwrites any union data inside the CommandOutputs as the provided CargoHomeOutputs
+func (t *CommandOutputs) FromCargoHomeOutputs(v CargoHomeOutputs) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeCargoHomeOutputs performs a merge with any union data inside the CommandOutputs, using the provided CargoHomeOutputs
+func (t *CommandOutputs) MergeCargoHomeOutputs(v CargoHomeOutputs) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 // AsScanLocationOutputs returns the union data inside the CommandOutputs as a ScanLocationOutputs
 func (t CommandOutputs) AsScanLocationOutputs() (ScanLocationOutputs, error) {
 	var body ScanLocationOutputs
@@ -1308,84 +2231,227 @@ func (t *CommandOutputs) MergeWaitOutputs(v WaitOutputs) error {
 	return err
 }
 
-func (t CommandOutputs) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
-	return b, err
+// AsMissionOutputs returns the union data inside the CommandOutputs as a MissionOutputs
+func (t CommandOutputs) AsMissionOutputs() (MissionOutputs, error) {
+	var body MissionOutputs
+	err := json.Unmarshal(t.union, &body)
+	return body, err
 }
 
-func (t *CommandOutputs) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
+// FromMissionOutputs overwrites any union data inside the CommandOutputs as the provided MissionOutputs
+func (t *CommandOutputs) FromMissionOutputs(v MissionOutputs) error {
+	b, err := json.Marshal(v)
+	t.union = b
 	return err
 }
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// List all commands
-	// (GET /commands)
-	ListCommands(w http.ResponseWriter, r *http.Request, params ListCommandsParams)
-	// Create a command
-	// (POST /commands)
-	CreateCommand(w http.ResponseWriter, r *http.Request)
-	// Get current processing command
-	// (GET /commands/processing)
-	GetCurrentProcessingCommand(w http.ResponseWriter, r *http.Request)
-	// Cancel current processing command
-	// (POST /commands/processing/cancel)
-	CancelCurrentProcessingCommand(w http.ResponseWriter, r *http.Request)
-	// Delete a command by ID
-	// (DELETE /commands/{commandId})
-	DeleteCommandById(w http.ResponseWriter, r *http.Request, commandId int)
-	// Get a command by ID
-	// (GET /commands/{commandId})
-	GetCommandById(w http.ResponseWriter, r *http.Request, commandId int)
-	// Get the cloud configuration
-	// (GET /configs/cloud)
-	GetCloudConfig(w http.ResponseWriter, r *http.Request)
-	// Update the cloud configuration
-	// (PUT /configs/cloud)
-	UpdateCloudConfig(w http.ResponseWriter, r *http.Request)
-	// Get the command configuration
-	// (GET /configs/command)
-	GetCommandConfig(w http.ResponseWriter, r *http.Request)
-	// Update the command configuration
-	// (PUT /configs/command)
-	UpdateCommandConfig(w http.ResponseWriter, r *http.Request)
-	// Get the hardware configuration
-	// (GET /configs/hardware)
-	GetHardwareConfig(w http.ResponseWriter, r *http.Request)
-	// Update the hardware configuration
-	// (PUT /configs/hardware)
-	UpdateHardwareConfig(w http.ResponseWriter, r *http.Request)
-	// Get the HTTP configuration
-	// (GET /configs/http)
-	GetHTTPConfig(w http.ResponseWriter, r *http.Request)
-	// Update the HTTP configuration
-	// (PUT /configs/http)
-	UpdateHTTPConfig(w http.ResponseWriter, r *http.Request)
-	// Get the log configuration
-	// (GET /configs/log)
-	GetLogConfig(w http.ResponseWriter, r *http.Request)
-	// Update the log configuration
-	// (PUT /configs/log)
-	UpdateLogConfig(w http.ResponseWriter, r *http.Request)
-	// Get the wifi configuration
-	// (GET /configs/wifi)
-	GetWifiConfig(w http.ResponseWriter, r *http.Request)
-	// Update the wifi configuration
-	// (PUT /configs/wifi)
-	UpdateWifiConfig(w http.ResponseWriter, r *http.Request)
-	// Get all error codes
-	// (GET /error-codes)
-	GetErrorCodes(w http.ResponseWriter, r *http.Request)
-	// Get the health of the server
-	// (GET /health)
-	GetHealth(w http.ResponseWriter, r *http.Request)
+// MergeMissionOutputs performs a merge with any union data inside the CommandOutputs, using the provided MissionOutputs
+func (t *CommandOutputs) MergeMissionOutputs(v MissionOutputs) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsDeliverOutputs returns the union data inside the CommandOutputs as a DeliverOutputs
+func (t CommandOutputs) AsDeliverOutputs() (DeliverOutputs, error) {
+	var body DeliverOutputs
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromDeliverOutputs overwrites any union data inside the CommandOutputs as the provided DeliverOutputs
+func (t *CommandOutputs) FromDeliverOutputs(v DeliverOutputs) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeDeliverOutputs performs a merge with any union data inside the CommandOutputs, using the provided DeliverOutputs
+func (t *CommandOutputs) MergeDeliverOutputs(v DeliverOutputs) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsBatterySetChargeOutputs returns the union data inside the CommandOutputs as a BatterySetChargeOutputs
+func (t CommandOutputs) AsBatterySetChargeOutputs() (BatterySetChargeOutputs, error) {
+	var body BatterySetChargeOutputs
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromBatterySetChargeOutputs overwrites any union data inside the CommandOutputs as the provided BatterySetChargeOutputs
+func (t *CommandOutputs) FromBatterySetChargeOutputs(v BatterySetChargeOutputs) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeBatterySetChargeOutputs performs a merge with any union data inside the CommandOutputs, using the provided BatterySetChargeOutputs
+func (t *CommandOutputs) MergeBatterySetChargeOutputs(v BatterySetChargeOutputs) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsBatterySetDischargeOutputs returns the union data inside the CommandOutputs as a BatterySetDischargeOutputs
+func (t CommandOutputs) AsBatterySetDischargeOutputs() (BatterySetDischargeOutputs, error) {
+	var body BatterySetDischargeOutputs
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromBatterySetDischargeOutputs overwrites any union data inside the CommandOutputs as the provided BatterySetDischargeOutputs
+func (t *CommandOutputs) FromBatterySetDischargeOutputs(v BatterySetDischargeOutputs) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeBatterySetDischargeOutputs performs a merge with any union data inside the CommandOutputs, using the provided BatterySetDischargeOutputs
+func (t *CommandOutputs) MergeBatterySetDischargeOutputs(v BatterySetDischargeOutputs) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t CommandOutputs) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *CommandOutputs) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// List all commands
+	// (GET /commands)
+	ListCommands(w http.ResponseWriter, r *http.Request, params ListCommandsParams)
+	// Create a command
+	// (POST /commands)
+	CreateCommand(w http.ResponseWriter, r *http.Request)
+	// Get current processing command
+	// (GET /commands/processing)
+	GetCurrentProcessingCommand(w http.ResponseWriter, r *http.Request)
+	// Cancel current processing command
+	// (POST /commands/processing/cancel)
+	CancelCurrentProcessingCommand(w http.ResponseWriter, r *http.Request)
+	// Get the progress of the current processing command
+	// (GET /commands/processing/progress)
+	GetCurrentProcessingCommandProgress(w http.ResponseWriter, r *http.Request)
+	// Get command queue state
+	// (GET /commands/queue)
+	GetCommandQueueState(w http.ResponseWriter, r *http.Request)
+	// Pause the command queue
+	// (POST /commands/queue/pause)
+	PauseCommandQueue(w http.ResponseWriter, r *http.Request)
+	// Resume the command queue
+	// (POST /commands/queue/resume)
+	ResumeCommandQueue(w http.ResponseWriter, r *http.Request)
+	// Delete a command by ID
+	// (DELETE /commands/{commandId})
+	DeleteCommandById(w http.ResponseWriter, r *http.Request, commandId int)
+	// Get a command by ID
+	// (GET /commands/{commandId})
+	GetCommandById(w http.ResponseWriter, r *http.Request, commandId int)
+	// Cancel a queued command
+	// (POST /commands/{commandId}/cancel)
+	CancelQueuedCommand(w http.ResponseWriter, r *http.Request, commandId int)
+	// Move a queued command
+	// (POST /commands/{commandId}/move)
+	MoveQueuedCommand(w http.ResponseWriter, r *http.Request, commandId int)
+	// Get the queue position of a command
+	// (GET /commands/{commandId}/queue-position)
+	GetCommandQueuePosition(w http.ResponseWriter, r *http.Request, commandId int)
+	// Get the cloud configuration
+	// (GET /configs/cloud)
+	GetCloudConfig(w http.ResponseWriter, r *http.Request)
+	// Update the cloud configuration
+	// (PUT /configs/cloud)
+	UpdateCloudConfig(w http.ResponseWriter, r *http.Request)
+	// Get the command configuration
+	// (GET /configs/command)
+	GetCommandConfig(w http.ResponseWriter, r *http.Request)
+	// Update the command configuration
+	// (PUT /configs/command)
+	UpdateCommandConfig(w http.ResponseWriter, r *http.Request)
+	// Get the hardware configuration
+	// (GET /configs/hardware)
+	GetHardwareConfig(w http.ResponseWriter, r *http.Request)
+	// Update the hardware configuration
+	// (PUT /configs/hardware)
+	UpdateHardwareConfig(w http.ResponseWriter, r *http.Request)
+	// Get the HTTP configuration
+	// (GET /configs/http)
+	GetHTTPConfig(w http.ResponseWriter, r *http.Request)
+	// Update the HTTP configuration
+	// (PUT /configs/http)
+	UpdateHTTPConfig(w http.ResponseWriter, r *http.Request)
+	// Get the log configuration
+	// (GET /configs/log)
+	GetLogConfig(w http.ResponseWriter, r *http.Request)
+	// Update the log configuration
+	// (PUT /configs/log)
+	UpdateLogConfig(w http.ResponseWriter, r *http.Request)
+	// Get the wifi configuration
+	// (GET /configs/wifi)
+	GetWifiConfig(w http.ResponseWriter, r *http.Request)
+	// Update the wifi configuration
+	// (PUT /configs/wifi)
+	UpdateWifiConfig(w http.ResponseWriter, r *http.Request)
+	// Get all error codes
+	// (GET /error-codes)
+	GetErrorCodes(w http.ResponseWriter, r *http.Request)
+	// Get the health of the server
+	// (GET /health)
+	GetHealth(w http.ResponseWriter, r *http.Request)
 	// List available serial ports
 	// (GET /peripherals/serials)
 	ListAvailableSerialPorts(w http.ResponseWriter, r *http.Request)
 	// Get robot state
 	// (GET /robot-state)
 	GetRobotState(w http.ResponseWriter, r *http.Request)
+	// List all schedules
+	// (GET /schedules)
+	ListSchedules(w http.ResponseWriter, r *http.Request, params ListSchedulesParams)
+	// Create a schedule
+	// (POST /schedules)
+	CreateSchedule(w http.ResponseWriter, r *http.Request)
+	// Delete a schedule by ID
+	// (DELETE /schedules/{scheduleId})
+	DeleteScheduleById(w http.ResponseWriter, r *http.Request, scheduleId int)
+	// Get a schedule by ID
+	// (GET /schedules/{scheduleId})
+	GetScheduleById(w http.ResponseWriter, r *http.Request, scheduleId int)
+	// Update a schedule by ID
+	// (PUT /schedules/{scheduleId})
+	UpdateScheduleById(w http.ResponseWriter, r *http.Request, scheduleId int)
+	// List the next run times of a schedule
+	// (GET /schedules/{scheduleId}/next-runs)
+	ListScheduleNextRuns(w http.ResponseWriter, r *http.Request, scheduleId int, params ListScheduleNextRunsParams)
 	// Get the limit switch state
 	// (GET /states/limit-switch)
 	GetLimitSwitchState(w http.ResponseWriter, r *http.Request)
@@ -1398,6 +2464,33 @@ type ServerInterface interface {
 	// Stop all motors and cancel all running, queued and processing commands
 	// (POST /system/stop-emergency)
 	StopEmergency(w http.ResponseWriter, r *http.Request)
+	// Delete the track map
+	// (DELETE /track-map)
+	DeleteTrackMap(w http.ResponseWriter, r *http.Request)
+	// Get the track map
+	// (GET /track-map)
+	GetTrackMap(w http.ResponseWriter, r *http.Request)
+	// Replace the track map
+	// (PUT /track-map)
+	UpdateTrackMap(w http.ResponseWriter, r *http.Request)
+	// Export the track map
+	// (GET /track-map/export)
+	ExportTrackMap(w http.ResponseWriter, r *http.Request, params ExportTrackMapParams)
+	// Import the track map
+	// (POST /track-map/import)
+	ImportTrackMap(w http.ResponseWriter, r *http.Request, params ImportTrackMapParams)
+	// Import the track map from a location scan
+	// (POST /track-map/import-scan)
+	ImportTrackMapFromScan(w http.ResponseWriter, r *http.Request)
+	// Add a tag to the track map
+	// (POST /track-map/tags)
+	CreateTrackMapTag(w http.ResponseWriter, r *http.Request)
+	// Delete a tag of the track map
+	// (DELETE /track-map/tags/{location})
+	DeleteTrackMapTag(w http.ResponseWriter, r *http.Request, location string)
+	// Update a tag of the track map
+	// (PUT /track-map/tags/{location})
+	UpdateTrackMapTag(w http.ResponseWriter, r *http.Request, location string)
 	// Get application version information
 	// (GET /version)
 	GetVersion(w http.ResponseWriter, r *http.Request)
@@ -1431,6 +2524,30 @@ func (_ Unimplemented) CancelCurrentProcessingCommand(w http.ResponseWriter, r *
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get the progress of the current processing command
+// (GET /commands/processing/progress)
+func (_ Unimplemented) GetCurrentProcessingCommandProgress(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get command queue state
+// (GET /commands/queue)
+func (_ Unimplemented) GetCommandQueueState(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Pause the command queue
+// (POST /commands/queue/pause)
+func (_ Unimplemented) PauseCommandQueue(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Resume the command queue
+// (POST /commands/queue/resume)
+func (_ Unimplemented) ResumeCommandQueue(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Delete a command by ID
 // (DELETE /commands/{commandId})
 func (_ Unimplemented) DeleteCommandById(w http.ResponseWriter, r *http.Request, commandId int) {
@@ -1443,6 +2560,24 @@ func (_ Unimplemented) GetCommandById(w http.ResponseWriter, r *http.Request, co
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Cancel a queued command
+// (POST /commands/{commandId}/cancel)
+func (_ Unimplemented) CancelQueuedCommand(w http.ResponseWriter, r *http.Request, commandId int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Move a queued command
+// (POST /commands/{commandId}/move)
+func (_ Unimplemented) MoveQueuedCommand(w http.ResponseWriter, r *http.Request, commandId int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the queue position of a command
+// (GET /commands/{commandId}/queue-position)
+func (_ Unimplemented) GetCommandQueuePosition(w http.ResponseWriter, r *http.Request, commandId int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get the cloud configuration
 // (GET /configs/cloud)
 func (_ Unimplemented) GetCloudConfig(w http.ResponseWriter, r *http.Request) {
@@ -1539,6 +2674,42 @@ func (_ Unimplemented) GetRobotState(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List all schedules
+// (GET /schedules)
+func (_ Unimplemented) ListSchedules(w http.ResponseWriter, r *http.Request, params ListSchedulesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a schedule
+// (POST /schedules)
+func (_ Unimplemented) CreateSchedule(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a schedule by ID
+// (DELETE /schedules/{scheduleId})
+func (_ Unimplemented) DeleteScheduleById(w http.ResponseWriter, r *http.Request, scheduleId int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a schedule by ID
+// (GET /schedules/{scheduleId})
+func (_ Unimplemented) GetScheduleById(w http.ResponseWriter, r *http.Request, scheduleId int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a schedule by ID
+// (PUT /schedules/{scheduleId})
+func (_ Unimplemented) UpdateScheduleById(w http.ResponseWriter, r *http.Request, scheduleId int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the next run times of a schedule
+// (GET /schedules/{scheduleId}/next-runs)
+func (_ Unimplemented) ListScheduleNextRuns(w http.ResponseWriter, r *http.Request, scheduleId int, params ListScheduleNextRunsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get the limit switch state
 // (GET /states/limit-switch)
 func (_ Unimplemented) GetLimitSwitchState(w http.ResponseWriter, r *http.Request) {
@@ -1563,6 +2734,60 @@ func (_ Unimplemented) StopEmergency(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Delete the track map
+// (DELETE /track-map)
+func (_ Unimplemented) DeleteTrackMap(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the track map
+// (GET /track-map)
+func (_ Unimplemented) GetTrackMap(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Replace the track map
+// (PUT /track-map)
+func (_ Unimplemented) UpdateTrackMap(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Export the track map
+// (GET /track-map/export)
+func (_ Unimplemented) ExportTrackMap(w http.ResponseWriter, r *http.Request, params ExportTrackMapParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Import the track map
+// (POST /track-map/import)
+func (_ Unimplemented) ImportTrackMap(w http.ResponseWriter, r *http.Request, params ImportTrackMapParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Import the track map from a location scan
+// (POST /track-map/import-scan)
+func (_ Unimplemented) ImportTrackMapFromScan(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add a tag to the track map
+// (POST /track-map/tags)
+func (_ Unimplemented) CreateTrackMapTag(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a tag of the track map
+// (DELETE /track-map/tags/{location})
+func (_ Unimplemented) DeleteTrackMapTag(w http.ResponseWriter, r *http.Request, location string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a tag of the track map
+// (PUT /track-map/tags/{location})
+func (_ Unimplemented) UpdateTrackMapTag(w http.ResponseWriter, r *http.Request, location string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get application version information
 // (GET /version)
 func (_ Unimplemented) GetVersion(w http.ResponseWriter, r *http.Request) {
@@ -1671,22 +2896,11 @@ func (siw *ServerInterfaceWrapper) CancelCurrentProcessingCommand(w http.Respons
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteCommandById operation middleware
-func (siw *ServerInterfaceWrapper) DeleteCommandById(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "commandId" -------------
-	var commandId int
-
-	err = runtime.BindStyledParameterWithOptions("simple", "commandId", chi.URLParam(r, "commandId"), &commandId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "commandId", Err: err})
-		return
-	}
+// GetCurrentProcessingCommandProgress operation middleware
+func (siw *ServerInterfaceWrapper) GetCurrentProcessingCommandProgress(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteCommandById(w, r, commandId)
+		siw.Handler.GetCurrentProcessingCommandProgress(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1696,22 +2910,11 @@ func (siw *ServerInterfaceWrapper) DeleteCommandById(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// GetCommandById operation middleware
-func (siw *ServerInterfaceWrapper) GetCommandById(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "commandId" -------------
-	var commandId int
-
-	err = runtime.BindStyledParameterWithOptions("simple", "commandId", chi.URLParam(r, "commandId"), &commandId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "commandId", Err: err})
-		return
-	}
+// GetCommandQueueState operation middleware
+func (siw *ServerInterfaceWrapper) GetCommandQueueState(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCommandById(w, r, commandId)
+		siw.Handler.GetCommandQueueState(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1721,11 +2924,11 @@ func (siw *ServerInterfaceWrapper) GetCommandById(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// GetCloudConfig operation middleware
-func (siw *ServerInterfaceWrapper) GetCloudConfig(w http.ResponseWriter, r *http.Request) {
+// PauseCommandQueue operation middleware
+func (siw *ServerInterfaceWrapper) PauseCommandQueue(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCloudConfig(w, r)
+		siw.Handler.PauseCommandQueue(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1735,11 +2938,11 @@ func (siw *ServerInterfaceWrapper) GetCloudConfig(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateCloudConfig operation middleware
-func (siw *ServerInterfaceWrapper) UpdateCloudConfig(w http.ResponseWriter, r *http.Request) {
+// ResumeCommandQueue operation middleware
+func (siw *ServerInterfaceWrapper) ResumeCommandQueue(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateCloudConfig(w, r)
+		siw.Handler.ResumeCommandQueue(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1749,11 +2952,164 @@ func (siw *ServerInterfaceWrapper) UpdateCloudConfig(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// GetCommandConfig operation middleware
-func (siw *ServerInterfaceWrapper) GetCommandConfig(w http.ResponseWriter, r *http.Request) {
+// DeleteCommandById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCommandById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "commandId" -------------
+	var commandId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "commandId", chi.URLParam(r, "commandId"), &commandId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "commandId", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCommandConfig(w, r)
+		siw.Handler.DeleteCommandById(w, r, commandId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCommandById operation middleware
+func (siw *ServerInterfaceWrapper) GetCommandById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "commandId" -------------
+	var commandId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "commandId", chi.URLParam(r, "commandId"), &commandId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "commandId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCommandById(w, r, commandId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CancelQueuedCommand operation middleware
+func (siw *ServerInterfaceWrapper) CancelQueuedCommand(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "commandId" -------------
+	var commandId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "commandId", chi.URLParam(r, "commandId"), &commandId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "commandId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CancelQueuedCommand(w, r, commandId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// MoveQueuedCommand operation middleware
+func (siw *ServerInterfaceWrapper) MoveQueuedCommand(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "commandId" -------------
+	var commandId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "commandId", chi.URLParam(r, "commandId"), &commandId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "commandId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.MoveQueuedCommand(w, r, commandId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCommandQueuePosition operation middleware
+func (siw *ServerInterfaceWrapper) GetCommandQueuePosition(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "commandId" -------------
+	var commandId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "commandId", chi.URLParam(r, "commandId"), &commandId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "commandId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCommandQueuePosition(w, r, commandId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCloudConfig operation middleware
+func (siw *ServerInterfaceWrapper) GetCloudConfig(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCloudConfig(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateCloudConfig operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCloudConfig(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateCloudConfig(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCommandConfig operation middleware
+func (siw *ServerInterfaceWrapper) GetCommandConfig(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCommandConfig(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1945,11 +3301,32 @@ func (siw *ServerInterfaceWrapper) GetRobotState(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// GetLimitSwitchState operation middleware
-func (siw *ServerInterfaceWrapper) GetLimitSwitchState(w http.ResponseWriter, r *http.Request) {
+// ListSchedules operation middleware
+func (siw *ServerInterfaceWrapper) ListSchedules(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListSchedulesParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetLimitSwitchState(w, r)
+		siw.Handler.ListSchedules(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1959,11 +3336,11 @@ func (siw *ServerInterfaceWrapper) GetLimitSwitchState(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// GetSystemInfo operation middleware
-func (siw *ServerInterfaceWrapper) GetSystemInfo(w http.ResponseWriter, r *http.Request) {
+// CreateSchedule operation middleware
+func (siw *ServerInterfaceWrapper) CreateSchedule(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSystemInfo(w, r)
+		siw.Handler.CreateSchedule(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1973,11 +3350,22 @@ func (siw *ServerInterfaceWrapper) GetSystemInfo(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// RebootSystem operation middleware
-func (siw *ServerInterfaceWrapper) RebootSystem(w http.ResponseWriter, r *http.Request) {
+// DeleteScheduleById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteScheduleById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "scheduleId" -------------
+	var scheduleId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "scheduleId", chi.URLParam(r, "scheduleId"), &scheduleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scheduleId", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RebootSystem(w, r)
+		siw.Handler.DeleteScheduleById(w, r, scheduleId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1987,11 +3375,22 @@ func (siw *ServerInterfaceWrapper) RebootSystem(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// StopEmergency operation middleware
-func (siw *ServerInterfaceWrapper) StopEmergency(w http.ResponseWriter, r *http.Request) {
+// GetScheduleById operation middleware
+func (siw *ServerInterfaceWrapper) GetScheduleById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "scheduleId" -------------
+	var scheduleId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "scheduleId", chi.URLParam(r, "scheduleId"), &scheduleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scheduleId", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.StopEmergency(w, r)
+		siw.Handler.GetScheduleById(w, r, scheduleId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2001,11 +3400,22 @@ func (siw *ServerInterfaceWrapper) StopEmergency(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// GetVersion operation middleware
-func (siw *ServerInterfaceWrapper) GetVersion(w http.ResponseWriter, r *http.Request) {
+// UpdateScheduleById operation middleware
+func (siw *ServerInterfaceWrapper) UpdateScheduleById(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "scheduleId" -------------
+	var scheduleId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "scheduleId", chi.URLParam(r, "scheduleId"), &scheduleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scheduleId", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetVersion(w, r)
+		siw.Handler.UpdateScheduleById(w, r, scheduleId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2015,64 +3425,358 @@ func (siw *ServerInterfaceWrapper) GetVersion(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+// ListScheduleNextRuns operation middleware
+func (siw *ServerInterfaceWrapper) ListScheduleNextRuns(w http.ResponseWriter, r *http.Request) {
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	var err error
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	// ------------- Path parameter "scheduleId" -------------
+	var scheduleId int
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "scheduleId", chi.URLParam(r, "scheduleId"), &scheduleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scheduleId", Err: err})
+		return
+	}
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListScheduleNextRunsParams
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	// ------------- Optional query parameter "count" -------------
 
-type RequiredParamError struct {
-	ParamName string
-}
+	err = runtime.BindQueryParameter("form", true, false, "count", r.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "count", Err: err})
+		return
+	}
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListScheduleNextRuns(w, r, scheduleId, params)
+	}))
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+	handler.ServeHTTP(w, r)
 }
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+// GetLimitSwitchState operation middleware
+func (siw *ServerInterfaceWrapper) GetLimitSwitchState(w http.ResponseWriter, r *http.Request) {
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLimitSwitchState(w, r)
+	}))
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
+	handler.ServeHTTP(w, r)
+}
+
+// GetSystemInfo operation middleware
+func (siw *ServerInterfaceWrapper) GetSystemInfo(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSystemInfo(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RebootSystem operation middleware
+func (siw *ServerInterfaceWrapper) RebootSystem(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RebootSystem(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StopEmergency operation middleware
+func (siw *ServerInterfaceWrapper) StopEmergency(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StopEmergency(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteTrackMap operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTrackMap(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTrackMap(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTrackMap operation middleware
+func (siw *ServerInterfaceWrapper) GetTrackMap(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTrackMap(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateTrackMap operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTrackMap(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateTrackMap(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportTrackMap operation middleware
+func (siw *ServerInterfaceWrapper) ExportTrackMap(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportTrackMapParams
+
+	// ------------- Required query parameter "format" -------------
+
+	if paramValue := r.URL.Query().Get("format"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "format"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportTrackMap(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ImportTrackMap operation middleware
+func (siw *ServerInterfaceWrapper) ImportTrackMap(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportTrackMapParams
+
+	// ------------- Required query parameter "format" -------------
+
+	if paramValue := r.URL.Query().Get("format"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "format"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportTrackMap(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ImportTrackMapFromScan operation middleware
+func (siw *ServerInterfaceWrapper) ImportTrackMapFromScan(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportTrackMapFromScan(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateTrackMapTag operation middleware
+func (siw *ServerInterfaceWrapper) CreateTrackMapTag(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateTrackMapTag(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteTrackMapTag operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTrackMapTag(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "location" -------------
+	var location string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "location", chi.URLParam(r, "location"), &location, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "location", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTrackMapTag(w, r, location)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateTrackMapTag operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTrackMapTag(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "location" -------------
+	var location string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "location", chi.URLParam(r, "location"), &location, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "location", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateTrackMapTag(w, r, location)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetVersion operation middleware
+func (siw *ServerInterfaceWrapper) GetVersion(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVersion(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
 }
 
 type TooManyValuesForParamError struct {
@@ -2141,11 +3845,32 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		r.Post(options.BaseURL+"/commands/processing/cancel", wrapper.CancelCurrentProcessingCommand)
 	})
 	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/commands/{commandId}", wrapper.DeleteCommandById)
+		r.Get(options.BaseURL+"/commands/processing/progress", wrapper.GetCurrentProcessingCommandProgress)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/commands/queue", wrapper.GetCommandQueueState)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/commands/queue/pause", wrapper.PauseCommandQueue)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/commands/queue/resume", wrapper.ResumeCommandQueue)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/commands/{commandId}", wrapper.DeleteCommandById)
 	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/commands/{commandId}", wrapper.GetCommandById)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/commands/{commandId}/cancel", wrapper.CancelQueuedCommand)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/commands/{commandId}/move", wrapper.MoveQueuedCommand)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/commands/{commandId}/queue-position", wrapper.GetCommandQueuePosition)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/configs/cloud", wrapper.GetCloudConfig)
 	})
@@ -2194,6 +3919,24 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/robot-state", wrapper.GetRobotState)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/schedules", wrapper.ListSchedules)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/schedules", wrapper.CreateSchedule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/schedules/{scheduleId}", wrapper.DeleteScheduleById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/schedules/{scheduleId}", wrapper.GetScheduleById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/schedules/{scheduleId}", wrapper.UpdateScheduleById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/schedules/{scheduleId}/next-runs", wrapper.ListScheduleNextRuns)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/states/limit-switch", wrapper.GetLimitSwitchState)
 	})
@@ -2206,6 +3949,33 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/system/stop-emergency", wrapper.StopEmergency)
 	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/track-map", wrapper.DeleteTrackMap)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/track-map", wrapper.GetTrackMap)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/track-map", wrapper.UpdateTrackMap)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/track-map/export", wrapper.ExportTrackMap)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/track-map/import", wrapper.ImportTrackMap)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/track-map/import-scan", wrapper.ImportTrackMapFromScan)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/track-map/tags", wrapper.CreateTrackMapTag)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/track-map/tags/{location}", wrapper.DeleteTrackMapTag)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/track-map/tags/{location}", wrapper.UpdateTrackMapTag)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/version", wrapper.GetVersion)
 	})
@@ -2314,6 +4084,89 @@ func (response CancelCurrentProcessingCommand400JSONResponse) VisitCancelCurrent
 	return json.NewEncoder(w).Encode(response)
 }
 
+type GetCurrentProcessingCommandProgressRequestObject struct {
+}
+
+type GetCurrentProcessingCommandProgressResponseObject interface {
+	VisitGetCurrentProcessingCommandProgressResponse(w http.ResponseWriter) error
+}
+
+type GetCurrentProcessingCommandProgress200JSONResponse CommandProgressResponse
+
+func (response GetCurrentProcessingCommandProgress200JSONResponse) VisitGetCurrentProcessingCommandProgressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCurrentProcessingCommandProgress404JSONResponse ErrorResponse
+
+func (response GetCurrentProcessingCommandProgress404JSONResponse) VisitGetCurrentProcessingCommandProgressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCommandQueueStateRequestObject struct {
+}
+
+type GetCommandQueueStateResponseObject interface {
+	VisitGetCommandQueueStateResponse(w http.ResponseWriter) error
+}
+
+type GetCommandQueueState200JSONResponse CommandQueueState
+
+func (response GetCommandQueueState200JSONResponse) VisitGetCommandQueueStateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PauseCommandQueueRequestObject struct {
+	Body *PauseCommandQueueJSONRequestBody
+}
+
+type PauseCommandQueueResponseObject interface {
+	VisitPauseCommandQueueResponse(w http.ResponseWriter) error
+}
+
+type PauseCommandQueue200JSONResponse CommandQueueState
+
+func (response PauseCommandQueue200JSONResponse) VisitPauseCommandQueueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PauseCommandQueue400JSONResponse ErrorResponse
+
+func (response PauseCommandQueue400JSONResponse) VisitPauseCommandQueueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeCommandQueueRequestObject struct {
+}
+
+type ResumeCommandQueueResponseObject interface {
+	VisitResumeCommandQueueResponse(w http.ResponseWriter) error
+}
+
+type ResumeCommandQueue200JSONResponse CommandQueueState
+
+func (response ResumeCommandQueue200JSONResponse) VisitResumeCommandQueueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type DeleteCommandByIdRequestObject struct {
 	CommandId int `json:"commandId"`
 }
@@ -2365,6 +4218,112 @@ func (response GetCommandById404JSONResponse) VisitGetCommandByIdResponse(w http
 	return json.NewEncoder(w).Encode(response)
 }
 
+type CancelQueuedCommandRequestObject struct {
+	CommandId int `json:"commandId"`
+}
+
+type CancelQueuedCommandResponseObject interface {
+	VisitCancelQueuedCommandResponse(w http.ResponseWriter) error
+}
+
+type CancelQueuedCommand200JSONResponse CommandResponse
+
+func (response CancelQueuedCommand200JSONResponse) VisitCancelQueuedCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelQueuedCommand400JSONResponse ErrorResponse
+
+func (response CancelQueuedCommand400JSONResponse) VisitCancelQueuedCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelQueuedCommand404JSONResponse ErrorResponse
+
+func (response CancelQueuedCommand404JSONResponse) VisitCancelQueuedCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MoveQueuedCommandRequestObject struct {
+	CommandId int `json:"commandId"`
+	Body      *MoveQueuedCommandJSONRequestBody
+}
+
+type MoveQueuedCommandResponseObject interface {
+	VisitMoveQueuedCommandResponse(w http.ResponseWriter) error
+}
+
+type MoveQueuedCommand200JSONResponse CommandResponse
+
+func (response MoveQueuedCommand200JSONResponse) VisitMoveQueuedCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MoveQueuedCommand400JSONResponse ErrorResponse
+
+func (response MoveQueuedCommand400JSONResponse) VisitMoveQueuedCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MoveQueuedCommand404JSONResponse ErrorResponse
+
+func (response MoveQueuedCommand404JSONResponse) VisitMoveQueuedCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCommandQueuePositionRequestObject struct {
+	CommandId int `json:"commandId"`
+}
+
+type GetCommandQueuePositionResponseObject interface {
+	VisitGetCommandQueuePositionResponse(w http.ResponseWriter) error
+}
+
+type GetCommandQueuePosition200JSONResponse QueuePositionResponse
+
+func (response GetCommandQueuePosition200JSONResponse) VisitGetCommandQueuePositionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCommandQueuePosition400JSONResponse ErrorResponse
+
+func (response GetCommandQueuePosition400JSONResponse) VisitGetCommandQueuePositionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCommandQueuePosition404JSONResponse ErrorResponse
+
+func (response GetCommandQueuePosition404JSONResponse) VisitGetCommandQueuePositionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetCloudConfigRequestObject struct {
 }
 
@@ -2771,133 +4730,542 @@ func (response GetRobotState400JSONResponse) VisitGetRobotStateResponse(w http.R
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetLimitSwitchStateRequestObject struct {
+type ListSchedulesRequestObject struct {
+	Params ListSchedulesParams
 }
 
-type GetLimitSwitchStateResponseObject interface {
-	VisitGetLimitSwitchStateResponse(w http.ResponseWriter) error
+type ListSchedulesResponseObject interface {
+	VisitListSchedulesResponse(w http.ResponseWriter) error
 }
 
-type GetLimitSwitchState200JSONResponse LimitSwitchState
+type ListSchedules200JSONResponse SchedulesListResponse
 
-func (response GetLimitSwitchState200JSONResponse) VisitGetLimitSwitchStateResponse(w http.ResponseWriter) error {
+func (response ListSchedules200JSONResponse) VisitListSchedulesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetLimitSwitchState400JSONResponse ErrorResponse
+type ListSchedules400JSONResponse ErrorResponse
 
-func (response GetLimitSwitchState400JSONResponse) VisitGetLimitSwitchStateResponse(w http.ResponseWriter) error {
+func (response ListSchedules400JSONResponse) VisitListSchedulesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSystemInfoRequestObject struct {
+type CreateScheduleRequestObject struct {
+	Body *CreateScheduleJSONRequestBody
 }
 
-type GetSystemInfoResponseObject interface {
-	VisitGetSystemInfoResponse(w http.ResponseWriter) error
+type CreateScheduleResponseObject interface {
+	VisitCreateScheduleResponse(w http.ResponseWriter) error
 }
 
-type GetSystemInfo200JSONResponse SystemInfo
+type CreateSchedule201JSONResponse ScheduleResponse
 
-func (response GetSystemInfo200JSONResponse) VisitGetSystemInfoResponse(w http.ResponseWriter) error {
+func (response CreateSchedule201JSONResponse) VisitCreateScheduleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSystemInfo400JSONResponse ErrorResponse
+type CreateSchedule400JSONResponse ErrorResponse
 
-func (response GetSystemInfo400JSONResponse) VisitGetSystemInfoResponse(w http.ResponseWriter) error {
+func (response CreateSchedule400JSONResponse) VisitCreateScheduleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RebootSystemRequestObject struct {
+type DeleteScheduleByIdRequestObject struct {
+	ScheduleId int `json:"scheduleId"`
 }
 
-type RebootSystemResponseObject interface {
-	VisitRebootSystemResponse(w http.ResponseWriter) error
+type DeleteScheduleByIdResponseObject interface {
+	VisitDeleteScheduleByIdResponse(w http.ResponseWriter) error
 }
 
-type RebootSystem204Response struct {
+type DeleteScheduleById204Response struct {
 }
 
-func (response RebootSystem204Response) VisitRebootSystemResponse(w http.ResponseWriter) error {
+func (response DeleteScheduleById204Response) VisitDeleteScheduleByIdResponse(w http.ResponseWriter) error {
 	w.WriteHeader(204)
 	return nil
 }
 
-type RebootSystem400JSONResponse ErrorResponse
+type DeleteScheduleById404JSONResponse ErrorResponse
 
-func (response RebootSystem400JSONResponse) VisitRebootSystemResponse(w http.ResponseWriter) error {
+func (response DeleteScheduleById404JSONResponse) VisitDeleteScheduleByIdResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StopEmergencyRequestObject struct {
+type GetScheduleByIdRequestObject struct {
+	ScheduleId int `json:"scheduleId"`
 }
 
-type StopEmergencyResponseObject interface {
-	VisitStopEmergencyResponse(w http.ResponseWriter) error
+type GetScheduleByIdResponseObject interface {
+	VisitGetScheduleByIdResponse(w http.ResponseWriter) error
 }
 
-type StopEmergency204Response struct {
+type GetScheduleById200JSONResponse ScheduleResponse
+
+func (response GetScheduleById200JSONResponse) VisitGetScheduleByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response StopEmergency204Response) VisitStopEmergencyResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+type GetScheduleById404JSONResponse ErrorResponse
+
+func (response GetScheduleById404JSONResponse) VisitGetScheduleByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type StopEmergency400JSONResponse ErrorResponse
+type UpdateScheduleByIdRequestObject struct {
+	ScheduleId int `json:"scheduleId"`
+	Body       *UpdateScheduleByIdJSONRequestBody
+}
 
-func (response StopEmergency400JSONResponse) VisitStopEmergencyResponse(w http.ResponseWriter) error {
+type UpdateScheduleByIdResponseObject interface {
+	VisitUpdateScheduleByIdResponse(w http.ResponseWriter) error
+}
+
+type UpdateScheduleById200JSONResponse ScheduleResponse
+
+func (response UpdateScheduleById200JSONResponse) VisitUpdateScheduleByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateScheduleById400JSONResponse ErrorResponse
+
+func (response UpdateScheduleById400JSONResponse) VisitUpdateScheduleByIdResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetVersionRequestObject struct {
+type UpdateScheduleById404JSONResponse ErrorResponse
+
+func (response UpdateScheduleById404JSONResponse) VisitUpdateScheduleByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GetVersionResponseObject interface {
-	VisitGetVersionResponse(w http.ResponseWriter) error
+type ListScheduleNextRunsRequestObject struct {
+	ScheduleId int `json:"scheduleId"`
+	Params     ListScheduleNextRunsParams
 }
 
-type GetVersion200JSONResponse Version
+type ListScheduleNextRunsResponseObject interface {
+	VisitListScheduleNextRunsResponse(w http.ResponseWriter) error
+}
 
-func (response GetVersion200JSONResponse) VisitGetVersionResponse(w http.ResponseWriter) error {
+type ListScheduleNextRuns200JSONResponse ScheduleNextRunsResponse
+
+func (response ListScheduleNextRuns200JSONResponse) VisitListScheduleNextRunsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetVersion400JSONResponse ErrorResponse
+type ListScheduleNextRuns404JSONResponse ErrorResponse
 
-func (response GetVersion400JSONResponse) VisitGetVersionResponse(w http.ResponseWriter) error {
+func (response ListScheduleNextRuns404JSONResponse) VisitListScheduleNextRunsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// List all commands
-	// (GET /commands)
+type GetLimitSwitchStateRequestObject struct {
+}
+
+type GetLimitSwitchStateResponseObject interface {
+	VisitGetLimitSwitchStateResponse(w http.ResponseWriter) error
+}
+
+type GetLimitSwitchState200JSONResponse LimitSwitchState
+
+func (response GetLimitSwitchState200JSONResponse) VisitGetLimitSwitchStateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLimitSwitchState400JSONResponse ErrorResponse
+
+func (response GetLimitSwitchState400JSONResponse) VisitGetLimitSwitchStateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSystemInfoRequestObject struct {
+}
+
+type GetSystemInfoResponseObject interface {
+	VisitGetSystemInfoResponse(w http.ResponseWriter) error
+}
+
+type GetSystemInfo200JSONResponse SystemInfo
+
+func (response GetSystemInfo200JSONResponse) VisitGetSystemInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSystemInfo400JSONResponse ErrorResponse
+
+func (response GetSystemInfo400JSONResponse) VisitGetSystemInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RebootSystemRequestObject struct {
+}
+
+type RebootSystemResponseObject interface {
+	VisitRebootSystemResponse(w http.ResponseWriter) error
+}
+
+type RebootSystem204Response struct {
+}
+
+func (response RebootSystem204Response) VisitRebootSystemResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RebootSystem400JSONResponse ErrorResponse
+
+func (response RebootSystem400JSONResponse) VisitRebootSystemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StopEmergencyRequestObject struct {
+}
+
+type StopEmergencyResponseObject interface {
+	VisitStopEmergencyResponse(w http.ResponseWriter) error
+}
+
+type StopEmergency204Response struct {
+}
+
+func (response StopEmergency204Response) VisitStopEmergencyResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type StopEmergency400JSONResponse ErrorResponse
+
+func (response StopEmergency400JSONResponse) VisitStopEmergencyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTrackMapRequestObject struct {
+}
+
+type DeleteTrackMapResponseObject interface {
+	VisitDeleteTrackMapResponse(w http.ResponseWriter) error
+}
+
+type DeleteTrackMap204Response struct {
+}
+
+func (response DeleteTrackMap204Response) VisitDeleteTrackMapResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type GetTrackMapRequestObject struct {
+}
+
+type GetTrackMapResponseObject interface {
+	VisitGetTrackMapResponse(w http.ResponseWriter) error
+}
+
+type GetTrackMap200JSONResponse TrackMapResponse
+
+func (response GetTrackMap200JSONResponse) VisitGetTrackMapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTrackMapRequestObject struct {
+	Body *UpdateTrackMapJSONRequestBody
+}
+
+type UpdateTrackMapResponseObject interface {
+	VisitUpdateTrackMapResponse(w http.ResponseWriter) error
+}
+
+type UpdateTrackMap200JSONResponse TrackMapResponse
+
+func (response UpdateTrackMap200JSONResponse) VisitUpdateTrackMapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTrackMap400JSONResponse ErrorResponse
+
+func (response UpdateTrackMap400JSONResponse) VisitUpdateTrackMapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportTrackMapRequestObject struct {
+	Params ExportTrackMapParams
+}
+
+type ExportTrackMapResponseObject interface {
+	VisitExportTrackMapResponse(w http.ResponseWriter) error
+}
+
+type ExportTrackMap200ApplicationoctetStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response ExportTrackMap200ApplicationoctetStreamResponse) VisitExportTrackMapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/octet-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportTrackMap400JSONResponse ErrorResponse
+
+func (response ExportTrackMap400JSONResponse) VisitExportTrackMapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportTrackMapRequestObject struct {
+	Params ImportTrackMapParams
+	Body   io.Reader
+}
+
+type ImportTrackMapResponseObject interface {
+	VisitImportTrackMapResponse(w http.ResponseWriter) error
+}
+
+type ImportTrackMap200JSONResponse TrackMapResponse
+
+func (response ImportTrackMap200JSONResponse) VisitImportTrackMapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportTrackMap400JSONResponse ErrorResponse
+
+func (response ImportTrackMap400JSONResponse) VisitImportTrackMapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportTrackMapFromScanRequestObject struct {
+	Body *ImportTrackMapFromScanJSONRequestBody
+}
+
+type ImportTrackMapFromScanResponseObject interface {
+	VisitImportTrackMapFromScanResponse(w http.ResponseWriter) error
+}
+
+type ImportTrackMapFromScan200JSONResponse TrackMapResponse
+
+func (response ImportTrackMapFromScan200JSONResponse) VisitImportTrackMapFromScanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportTrackMapFromScan400JSONResponse ErrorResponse
+
+func (response ImportTrackMapFromScan400JSONResponse) VisitImportTrackMapFromScanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportTrackMapFromScan404JSONResponse ErrorResponse
+
+func (response ImportTrackMapFromScan404JSONResponse) VisitImportTrackMapFromScanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTrackMapTagRequestObject struct {
+	Body *CreateTrackMapTagJSONRequestBody
+}
+
+type CreateTrackMapTagResponseObject interface {
+	VisitCreateTrackMapTagResponse(w http.ResponseWriter) error
+}
+
+type CreateTrackMapTag201JSONResponse TrackMapResponse
+
+func (response CreateTrackMapTag201JSONResponse) VisitCreateTrackMapTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTrackMapTag400JSONResponse ErrorResponse
+
+func (response CreateTrackMapTag400JSONResponse) VisitCreateTrackMapTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTrackMapTagRequestObject struct {
+	Location string `json:"location"`
+}
+
+type DeleteTrackMapTagResponseObject interface {
+	VisitDeleteTrackMapTagResponse(w http.ResponseWriter) error
+}
+
+type DeleteTrackMapTag200JSONResponse TrackMapResponse
+
+func (response DeleteTrackMapTag200JSONResponse) VisitDeleteTrackMapTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTrackMapTag404JSONResponse ErrorResponse
+
+func (response DeleteTrackMapTag404JSONResponse) VisitDeleteTrackMapTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTrackMapTagRequestObject struct {
+	Location string `json:"location"`
+	Body     *UpdateTrackMapTagJSONRequestBody
+}
+
+type UpdateTrackMapTagResponseObject interface {
+	VisitUpdateTrackMapTagResponse(w http.ResponseWriter) error
+}
+
+type UpdateTrackMapTag200JSONResponse TrackMapResponse
+
+func (response UpdateTrackMapTag200JSONResponse) VisitUpdateTrackMapTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTrackMapTag400JSONResponse ErrorResponse
+
+func (response UpdateTrackMapTag400JSONResponse) VisitUpdateTrackMapTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTrackMapTag404JSONResponse ErrorResponse
+
+func (response UpdateTrackMapTag404JSONResponse) VisitUpdateTrackMapTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVersionRequestObject struct {
+}
+
+type GetVersionResponseObject interface {
+	VisitGetVersionResponse(w http.ResponseWriter) error
+}
+
+type GetVersion200JSONResponse Version
+
+func (response GetVersion200JSONResponse) VisitGetVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVersion400JSONResponse ErrorResponse
+
+func (response GetVersion400JSONResponse) VisitGetVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// List all commands
+	// (GET /commands)
 	ListCommands(ctx context.Context, request ListCommandsRequestObject) (ListCommandsResponseObject, error)
 	// Create a command
 	// (POST /commands)
@@ -2908,12 +5276,33 @@ type StrictServerInterface interface {
 	// Cancel current processing command
 	// (POST /commands/processing/cancel)
 	CancelCurrentProcessingCommand(ctx context.Context, request CancelCurrentProcessingCommandRequestObject) (CancelCurrentProcessingCommandResponseObject, error)
+	// Get the progress of the current processing command
+	// (GET /commands/processing/progress)
+	GetCurrentProcessingCommandProgress(ctx context.Context, request GetCurrentProcessingCommandProgressRequestObject) (GetCurrentProcessingCommandProgressResponseObject, error)
+	// Get command queue state
+	// (GET /commands/queue)
+	GetCommandQueueState(ctx context.Context, request GetCommandQueueStateRequestObject) (GetCommandQueueStateResponseObject, error)
+	// Pause the command queue
+	// (POST /commands/queue/pause)
+	PauseCommandQueue(ctx context.Context, request PauseCommandQueueRequestObject) (PauseCommandQueueResponseObject, error)
+	// Resume the command queue
+	// (POST /commands/queue/resume)
+	ResumeCommandQueue(ctx context.Context, request ResumeCommandQueueRequestObject) (ResumeCommandQueueResponseObject, error)
 	// Delete a command by ID
 	// (DELETE /commands/{commandId})
 	DeleteCommandById(ctx context.Context, request DeleteCommandByIdRequestObject) (DeleteCommandByIdResponseObject, error)
 	// Get a command by ID
 	// (GET /commands/{commandId})
 	GetCommandById(ctx context.Context, request GetCommandByIdRequestObject) (GetCommandByIdResponseObject, error)
+	// Cancel a queued command
+	// (POST /commands/{commandId}/cancel)
+	CancelQueuedCommand(ctx context.Context, request CancelQueuedCommandRequestObject) (CancelQueuedCommandResponseObject, error)
+	// Move a queued command
+	// (POST /commands/{commandId}/move)
+	MoveQueuedCommand(ctx context.Context, request MoveQueuedCommandRequestObject) (MoveQueuedCommandResponseObject, error)
+	// Get the queue position of a command
+	// (GET /commands/{commandId}/queue-position)
+	GetCommandQueuePosition(ctx context.Context, request GetCommandQueuePositionRequestObject) (GetCommandQueuePositionResponseObject, error)
 	// Get the cloud configuration
 	// (GET /configs/cloud)
 	GetCloudConfig(ctx context.Context, request GetCloudConfigRequestObject) (GetCloudConfigResponseObject, error)
@@ -2962,6 +5351,24 @@ type StrictServerInterface interface {
 	// Get robot state
 	// (GET /robot-state)
 	GetRobotState(ctx context.Context, request GetRobotStateRequestObject) (GetRobotStateResponseObject, error)
+	// List all schedules
+	// (GET /schedules)
+	ListSchedules(ctx context.Context, request ListSchedulesRequestObject) (ListSchedulesResponseObject, error)
+	// Create a schedule
+	// (POST /schedules)
+	CreateSchedule(ctx context.Context, request CreateScheduleRequestObject) (CreateScheduleResponseObject, error)
+	// Delete a schedule by ID
+	// (DELETE /schedules/{scheduleId})
+	DeleteScheduleById(ctx context.Context, request DeleteScheduleByIdRequestObject) (DeleteScheduleByIdResponseObject, error)
+	// Get a schedule by ID
+	// (GET /schedules/{scheduleId})
+	GetScheduleById(ctx context.Context, request GetScheduleByIdRequestObject) (GetScheduleByIdResponseObject, error)
+	// Update a schedule by ID
+	// (PUT /schedules/{scheduleId})
+	UpdateScheduleById(ctx context.Context, request UpdateScheduleByIdRequestObject) (UpdateScheduleByIdResponseObject, error)
+	// List the next run times of a schedule
+	// (GET /schedules/{scheduleId}/next-runs)
+	ListScheduleNextRuns(ctx context.Context, request ListScheduleNextRunsRequestObject) (ListScheduleNextRunsResponseObject, error)
 	// Get the limit switch state
 	// (GET /states/limit-switch)
 	GetLimitSwitchState(ctx context.Context, request GetLimitSwitchStateRequestObject) (GetLimitSwitchStateResponseObject, error)
@@ -2974,59 +5381,625 @@ type StrictServerInterface interface {
 	// Stop all motors and cancel all running, queued and processing commands
 	// (POST /system/stop-emergency)
 	StopEmergency(ctx context.Context, request StopEmergencyRequestObject) (StopEmergencyResponseObject, error)
+	// Delete the track map
+	// (DELETE /track-map)
+	DeleteTrackMap(ctx context.Context, request DeleteTrackMapRequestObject) (DeleteTrackMapResponseObject, error)
+	// Get the track map
+	// (GET /track-map)
+	GetTrackMap(ctx context.Context, request GetTrackMapRequestObject) (GetTrackMapResponseObject, error)
+	// Replace the track map
+	// (PUT /track-map)
+	UpdateTrackMap(ctx context.Context, request UpdateTrackMapRequestObject) (UpdateTrackMapResponseObject, error)
+	// Export the track map
+	// (GET /track-map/export)
+	ExportTrackMap(ctx context.Context, request ExportTrackMapRequestObject) (ExportTrackMapResponseObject, error)
+	// Import the track map
+	// (POST /track-map/import)
+	ImportTrackMap(ctx context.Context, request ImportTrackMapRequestObject) (ImportTrackMapResponseObject, error)
+	// Import the track map from a location scan
+	// (POST /track-map/import-scan)
+	ImportTrackMapFromScan(ctx context.Context, request ImportTrackMapFromScanRequestObject) (ImportTrackMapFromScanResponseObject, error)
+	// Add a tag to the track map
+	// (POST /track-map/tags)
+	CreateTrackMapTag(ctx context.Context, request CreateTrackMapTagRequestObject) (CreateTrackMapTagResponseObject, error)
+	// Delete a tag of the track map
+	// (DELETE /track-map/tags/{location})
+	DeleteTrackMapTag(ctx context.Context, request DeleteTrackMapTagRequestObject) (DeleteTrackMapTagResponseObject, error)
+	// Update a tag of the track map
+	// (PUT /track-map/tags/{location})
+	UpdateTrackMapTag(ctx context.Context, request UpdateTrackMapTagRequestObject) (UpdateTrackMapTagResponseObject, error)
 	// Get application version information
 	// (GET /version)
 	GetVersion(ctx context.Context, request GetVersionRequestObject) (GetVersionResponseObject, error)
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// ListCommands operation middleware
+func (sh *strictHandler) ListCommands(w http.ResponseWriter, r *http.Request, params ListCommandsParams) {
+	var request ListCommandsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListCommands(ctx, request.(ListCommandsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListCommands")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListCommandsResponseObject); ok {
+		if err := validResponse.VisitListCommandsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateCommand operation middleware
+func (sh *strictHandler) CreateCommand(w http.ResponseWriter, r *http.Request) {
+	var request CreateCommandRequestObject
+
+	var body CreateCommandJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateCommand(ctx, request.(CreateCommandRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateCommand")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateCommandResponseObject); ok {
+		if err := validResponse.VisitCreateCommandResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCurrentProcessingCommand operation middleware
+func (sh *strictHandler) GetCurrentProcessingCommand(w http.ResponseWriter, r *http.Request) {
+	var request GetCurrentProcessingCommandRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCurrentProcessingCommand(ctx, request.(GetCurrentProcessingCommandRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCurrentProcessingCommand")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCurrentProcessingCommandResponseObject); ok {
+		if err := validResponse.VisitGetCurrentProcessingCommandResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CancelCurrentProcessingCommand operation middleware
+func (sh *strictHandler) CancelCurrentProcessingCommand(w http.ResponseWriter, r *http.Request) {
+	var request CancelCurrentProcessingCommandRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CancelCurrentProcessingCommand(ctx, request.(CancelCurrentProcessingCommandRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CancelCurrentProcessingCommand")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CancelCurrentProcessingCommandResponseObject); ok {
+		if err := validResponse.VisitCancelCurrentProcessingCommandResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCurrentProcessingCommandProgress operation middleware
+func (sh *strictHandler) GetCurrentProcessingCommandProgress(w http.ResponseWriter, r *http.Request) {
+	var request GetCurrentProcessingCommandProgressRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCurrentProcessingCommandProgress(ctx, request.(GetCurrentProcessingCommandProgressRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCurrentProcessingCommandProgress")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCurrentProcessingCommandProgressResponseObject); ok {
+		if err := validResponse.VisitGetCurrentProcessingCommandProgressResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCommandQueueState operation middleware
+func (sh *strictHandler) GetCommandQueueState(w http.ResponseWriter, r *http.Request) {
+	var request GetCommandQueueStateRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCommandQueueState(ctx, request.(GetCommandQueueStateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCommandQueueState")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCommandQueueStateResponseObject); ok {
+		if err := validResponse.VisitGetCommandQueueStateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PauseCommandQueue operation middleware
+func (sh *strictHandler) PauseCommandQueue(w http.ResponseWriter, r *http.Request) {
+	var request PauseCommandQueueRequestObject
+
+	var body PauseCommandQueueJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PauseCommandQueue(ctx, request.(PauseCommandQueueRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PauseCommandQueue")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PauseCommandQueueResponseObject); ok {
+		if err := validResponse.VisitPauseCommandQueueResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ResumeCommandQueue operation middleware
+func (sh *strictHandler) ResumeCommandQueue(w http.ResponseWriter, r *http.Request) {
+	var request ResumeCommandQueueRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ResumeCommandQueue(ctx, request.(ResumeCommandQueueRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ResumeCommandQueue")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ResumeCommandQueueResponseObject); ok {
+		if err := validResponse.VisitResumeCommandQueueResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteCommandById operation middleware
+func (sh *strictHandler) DeleteCommandById(w http.ResponseWriter, r *http.Request, commandId int) {
+	var request DeleteCommandByIdRequestObject
+
+	request.CommandId = commandId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCommandById(ctx, request.(DeleteCommandByIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCommandById")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteCommandByIdResponseObject); ok {
+		if err := validResponse.VisitDeleteCommandByIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCommandById operation middleware
+func (sh *strictHandler) GetCommandById(w http.ResponseWriter, r *http.Request, commandId int) {
+	var request GetCommandByIdRequestObject
+
+	request.CommandId = commandId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCommandById(ctx, request.(GetCommandByIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCommandById")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCommandByIdResponseObject); ok {
+		if err := validResponse.VisitGetCommandByIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CancelQueuedCommand operation middleware
+func (sh *strictHandler) CancelQueuedCommand(w http.ResponseWriter, r *http.Request, commandId int) {
+	var request CancelQueuedCommandRequestObject
+
+	request.CommandId = commandId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CancelQueuedCommand(ctx, request.(CancelQueuedCommandRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CancelQueuedCommand")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CancelQueuedCommandResponseObject); ok {
+		if err := validResponse.VisitCancelQueuedCommandResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// MoveQueuedCommand operation middleware
+func (sh *strictHandler) MoveQueuedCommand(w http.ResponseWriter, r *http.Request, commandId int) {
+	var request MoveQueuedCommandRequestObject
+
+	request.CommandId = commandId
+
+	var body MoveQueuedCommandJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.MoveQueuedCommand(ctx, request.(MoveQueuedCommandRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MoveQueuedCommand")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(MoveQueuedCommandResponseObject); ok {
+		if err := validResponse.VisitMoveQueuedCommandResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCommandQueuePosition operation middleware
+func (sh *strictHandler) GetCommandQueuePosition(w http.ResponseWriter, r *http.Request, commandId int) {
+	var request GetCommandQueuePositionRequestObject
+
+	request.CommandId = commandId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCommandQueuePosition(ctx, request.(GetCommandQueuePositionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCommandQueuePosition")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCommandQueuePositionResponseObject); ok {
+		if err := validResponse.VisitGetCommandQueuePositionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCloudConfig operation middleware
+func (sh *strictHandler) GetCloudConfig(w http.ResponseWriter, r *http.Request) {
+	var request GetCloudConfigRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCloudConfig(ctx, request.(GetCloudConfigRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCloudConfig")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCloudConfigResponseObject); ok {
+		if err := validResponse.VisitGetCloudConfigResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateCloudConfig operation middleware
+func (sh *strictHandler) UpdateCloudConfig(w http.ResponseWriter, r *http.Request) {
+	var request UpdateCloudConfigRequestObject
+
+	var body UpdateCloudConfigJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateCloudConfig(ctx, request.(UpdateCloudConfigRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateCloudConfig")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateCloudConfigResponseObject); ok {
+		if err := validResponse.VisitUpdateCloudConfigResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCommandConfig operation middleware
+func (sh *strictHandler) GetCommandConfig(w http.ResponseWriter, r *http.Request) {
+	var request GetCommandConfigRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCommandConfig(ctx, request.(GetCommandConfigRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCommandConfig")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCommandConfigResponseObject); ok {
+		if err := validResponse.VisitGetCommandConfigResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateCommandConfig operation middleware
+func (sh *strictHandler) UpdateCommandConfig(w http.ResponseWriter, r *http.Request) {
+	var request UpdateCommandConfigRequestObject
+
+	var body UpdateCommandConfigJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateCommandConfig(ctx, request.(UpdateCommandConfigRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateCommandConfig")
+	}
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateCommandConfigResponseObject); ok {
+		if err := validResponse.VisitUpdateCommandConfigResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
+// GetHardwareConfig operation middleware
+func (sh *strictHandler) GetHardwareConfig(w http.ResponseWriter, r *http.Request) {
+	var request GetHardwareConfigRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetHardwareConfig(ctx, request.(GetHardwareConfigRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetHardwareConfig")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetHardwareConfigResponseObject); ok {
+		if err := validResponse.VisitGetHardwareConfigResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+// UpdateHardwareConfig operation middleware
+func (sh *strictHandler) UpdateHardwareConfig(w http.ResponseWriter, r *http.Request) {
+	var request UpdateHardwareConfigRequestObject
+
+	var body UpdateHardwareConfigJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateHardwareConfig(ctx, request.(UpdateHardwareConfigRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateHardwareConfig")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateHardwareConfigResponseObject); ok {
+		if err := validResponse.VisitUpdateHardwareConfigResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+// GetHTTPConfig operation middleware
+func (sh *strictHandler) GetHTTPConfig(w http.ResponseWriter, r *http.Request) {
+	var request GetHTTPConfigRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetHTTPConfig(ctx, request.(GetHTTPConfigRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetHTTPConfig")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetHTTPConfigResponseObject); ok {
+		if err := validResponse.VisitGetHTTPConfigResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// ListCommands operation middleware
-func (sh *strictHandler) ListCommands(w http.ResponseWriter, r *http.Request, params ListCommandsParams) {
-	var request ListCommandsRequestObject
+// UpdateHTTPConfig operation middleware
+func (sh *strictHandler) UpdateHTTPConfig(w http.ResponseWriter, r *http.Request) {
+	var request UpdateHTTPConfigRequestObject
 
-	request.Params = params
+	var body UpdateHTTPConfigJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListCommands(ctx, request.(ListCommandsRequestObject))
+		return sh.ssi.UpdateHTTPConfig(ctx, request.(UpdateHTTPConfigRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListCommands")
+		handler = middleware(handler, "UpdateHTTPConfig")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListCommandsResponseObject); ok {
-		if err := validResponse.VisitListCommandsResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateHTTPConfigResponseObject); ok {
+		if err := validResponse.VisitUpdateHTTPConfigResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3034,11 +6007,35 @@ func (sh *strictHandler) ListCommands(w http.ResponseWriter, r *http.Request, pa
 	}
 }
 
-// CreateCommand operation middleware
-func (sh *strictHandler) CreateCommand(w http.ResponseWriter, r *http.Request) {
-	var request CreateCommandRequestObject
+// GetLogConfig operation middleware
+func (sh *strictHandler) GetLogConfig(w http.ResponseWriter, r *http.Request) {
+	var request GetLogConfigRequestObject
 
-	var body CreateCommandJSONRequestBody
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetLogConfig(ctx, request.(GetLogConfigRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetLogConfig")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetLogConfigResponseObject); ok {
+		if err := validResponse.VisitGetLogConfigResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateLogConfig operation middleware
+func (sh *strictHandler) UpdateLogConfig(w http.ResponseWriter, r *http.Request) {
+	var request UpdateLogConfigRequestObject
+
+	var body UpdateLogConfigJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -3046,18 +6043,18 @@ func (sh *strictHandler) CreateCommand(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateCommand(ctx, request.(CreateCommandRequestObject))
+		return sh.ssi.UpdateLogConfig(ctx, request.(UpdateLogConfigRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateCommand")
+		handler = middleware(handler, "UpdateLogConfig")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateCommandResponseObject); ok {
-		if err := validResponse.VisitCreateCommandResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateLogConfigResponseObject); ok {
+		if err := validResponse.VisitUpdateLogConfigResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3065,23 +6062,23 @@ func (sh *strictHandler) CreateCommand(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetCurrentProcessingCommand operation middleware
-func (sh *strictHandler) GetCurrentProcessingCommand(w http.ResponseWriter, r *http.Request) {
-	var request GetCurrentProcessingCommandRequestObject
+// GetWifiConfig operation middleware
+func (sh *strictHandler) GetWifiConfig(w http.ResponseWriter, r *http.Request) {
+	var request GetWifiConfigRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCurrentProcessingCommand(ctx, request.(GetCurrentProcessingCommandRequestObject))
+		return sh.ssi.GetWifiConfig(ctx, request.(GetWifiConfigRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCurrentProcessingCommand")
+		handler = middleware(handler, "GetWifiConfig")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetCurrentProcessingCommandResponseObject); ok {
-		if err := validResponse.VisitGetCurrentProcessingCommandResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetWifiConfigResponseObject); ok {
+		if err := validResponse.VisitGetWifiConfigResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3089,23 +6086,30 @@ func (sh *strictHandler) GetCurrentProcessingCommand(w http.ResponseWriter, r *h
 	}
 }
 
-// CancelCurrentProcessingCommand operation middleware
-func (sh *strictHandler) CancelCurrentProcessingCommand(w http.ResponseWriter, r *http.Request) {
-	var request CancelCurrentProcessingCommandRequestObject
+// UpdateWifiConfig operation middleware
+func (sh *strictHandler) UpdateWifiConfig(w http.ResponseWriter, r *http.Request) {
+	var request UpdateWifiConfigRequestObject
+
+	var body UpdateWifiConfigJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CancelCurrentProcessingCommand(ctx, request.(CancelCurrentProcessingCommandRequestObject))
+		return sh.ssi.UpdateWifiConfig(ctx, request.(UpdateWifiConfigRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CancelCurrentProcessingCommand")
+		handler = middleware(handler, "UpdateWifiConfig")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CancelCurrentProcessingCommandResponseObject); ok {
-		if err := validResponse.VisitCancelCurrentProcessingCommandResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateWifiConfigResponseObject); ok {
+		if err := validResponse.VisitUpdateWifiConfigResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3113,25 +6117,23 @@ func (sh *strictHandler) CancelCurrentProcessingCommand(w http.ResponseWriter, r
 	}
 }
 
-// DeleteCommandById operation middleware
-func (sh *strictHandler) DeleteCommandById(w http.ResponseWriter, r *http.Request, commandId int) {
-	var request DeleteCommandByIdRequestObject
-
-	request.CommandId = commandId
+// GetErrorCodes operation middleware
+func (sh *strictHandler) GetErrorCodes(w http.ResponseWriter, r *http.Request) {
+	var request GetErrorCodesRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteCommandById(ctx, request.(DeleteCommandByIdRequestObject))
+		return sh.ssi.GetErrorCodes(ctx, request.(GetErrorCodesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteCommandById")
+		handler = middleware(handler, "GetErrorCodes")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteCommandByIdResponseObject); ok {
-		if err := validResponse.VisitDeleteCommandByIdResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetErrorCodesResponseObject); ok {
+		if err := validResponse.VisitGetErrorCodesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3139,25 +6141,47 @@ func (sh *strictHandler) DeleteCommandById(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// GetCommandById operation middleware
-func (sh *strictHandler) GetCommandById(w http.ResponseWriter, r *http.Request, commandId int) {
-	var request GetCommandByIdRequestObject
+// GetHealth operation middleware
+func (sh *strictHandler) GetHealth(w http.ResponseWriter, r *http.Request) {
+	var request GetHealthRequestObject
 
-	request.CommandId = commandId
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetHealth(ctx, request.(GetHealthRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetHealth")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetHealthResponseObject); ok {
+		if err := validResponse.VisitGetHealthResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListAvailableSerialPorts operation middleware
+func (sh *strictHandler) ListAvailableSerialPorts(w http.ResponseWriter, r *http.Request) {
+	var request ListAvailableSerialPortsRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCommandById(ctx, request.(GetCommandByIdRequestObject))
+		return sh.ssi.ListAvailableSerialPorts(ctx, request.(ListAvailableSerialPortsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCommandById")
+		handler = middleware(handler, "ListAvailableSerialPorts")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetCommandByIdResponseObject); ok {
-		if err := validResponse.VisitGetCommandByIdResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListAvailableSerialPortsResponseObject); ok {
+		if err := validResponse.VisitListAvailableSerialPortsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3165,23 +6189,23 @@ func (sh *strictHandler) GetCommandById(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// GetCloudConfig operation middleware
-func (sh *strictHandler) GetCloudConfig(w http.ResponseWriter, r *http.Request) {
-	var request GetCloudConfigRequestObject
+// GetRobotState operation middleware
+func (sh *strictHandler) GetRobotState(w http.ResponseWriter, r *http.Request) {
+	var request GetRobotStateRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCloudConfig(ctx, request.(GetCloudConfigRequestObject))
+		return sh.ssi.GetRobotState(ctx, request.(GetRobotStateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCloudConfig")
+		handler = middleware(handler, "GetRobotState")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetCloudConfigResponseObject); ok {
-		if err := validResponse.VisitGetCloudConfigResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetRobotStateResponseObject); ok {
+		if err := validResponse.VisitGetRobotStateResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3189,30 +6213,25 @@ func (sh *strictHandler) GetCloudConfig(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// UpdateCloudConfig operation middleware
-func (sh *strictHandler) UpdateCloudConfig(w http.ResponseWriter, r *http.Request) {
-	var request UpdateCloudConfigRequestObject
+// ListSchedules operation middleware
+func (sh *strictHandler) ListSchedules(w http.ResponseWriter, r *http.Request, params ListSchedulesParams) {
+	var request ListSchedulesRequestObject
 
-	var body UpdateCloudConfigJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateCloudConfig(ctx, request.(UpdateCloudConfigRequestObject))
+		return sh.ssi.ListSchedules(ctx, request.(ListSchedulesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateCloudConfig")
+		handler = middleware(handler, "ListSchedules")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateCloudConfigResponseObject); ok {
-		if err := validResponse.VisitUpdateCloudConfigResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListSchedulesResponseObject); ok {
+		if err := validResponse.VisitListSchedulesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3220,23 +6239,30 @@ func (sh *strictHandler) UpdateCloudConfig(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// GetCommandConfig operation middleware
-func (sh *strictHandler) GetCommandConfig(w http.ResponseWriter, r *http.Request) {
-	var request GetCommandConfigRequestObject
+// CreateSchedule operation middleware
+func (sh *strictHandler) CreateSchedule(w http.ResponseWriter, r *http.Request) {
+	var request CreateScheduleRequestObject
+
+	var body CreateScheduleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCommandConfig(ctx, request.(GetCommandConfigRequestObject))
+		return sh.ssi.CreateSchedule(ctx, request.(CreateScheduleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCommandConfig")
+		handler = middleware(handler, "CreateSchedule")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetCommandConfigResponseObject); ok {
-		if err := validResponse.VisitGetCommandConfigResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateScheduleResponseObject); ok {
+		if err := validResponse.VisitCreateScheduleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3244,30 +6270,25 @@ func (sh *strictHandler) GetCommandConfig(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// UpdateCommandConfig operation middleware
-func (sh *strictHandler) UpdateCommandConfig(w http.ResponseWriter, r *http.Request) {
-	var request UpdateCommandConfigRequestObject
+// DeleteScheduleById operation middleware
+func (sh *strictHandler) DeleteScheduleById(w http.ResponseWriter, r *http.Request, scheduleId int) {
+	var request DeleteScheduleByIdRequestObject
 
-	var body UpdateCommandConfigJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.ScheduleId = scheduleId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateCommandConfig(ctx, request.(UpdateCommandConfigRequestObject))
+		return sh.ssi.DeleteScheduleById(ctx, request.(DeleteScheduleByIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateCommandConfig")
+		handler = middleware(handler, "DeleteScheduleById")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateCommandConfigResponseObject); ok {
-		if err := validResponse.VisitUpdateCommandConfigResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteScheduleByIdResponseObject); ok {
+		if err := validResponse.VisitDeleteScheduleByIdResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3275,23 +6296,25 @@ func (sh *strictHandler) UpdateCommandConfig(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// GetHardwareConfig operation middleware
-func (sh *strictHandler) GetHardwareConfig(w http.ResponseWriter, r *http.Request) {
-	var request GetHardwareConfigRequestObject
+// GetScheduleById operation middleware
+func (sh *strictHandler) GetScheduleById(w http.ResponseWriter, r *http.Request, scheduleId int) {
+	var request GetScheduleByIdRequestObject
+
+	request.ScheduleId = scheduleId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetHardwareConfig(ctx, request.(GetHardwareConfigRequestObject))
+		return sh.ssi.GetScheduleById(ctx, request.(GetScheduleByIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetHardwareConfig")
+		handler = middleware(handler, "GetScheduleById")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetHardwareConfigResponseObject); ok {
-		if err := validResponse.VisitGetHardwareConfigResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetScheduleByIdResponseObject); ok {
+		if err := validResponse.VisitGetScheduleByIdResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3299,11 +6322,13 @@ func (sh *strictHandler) GetHardwareConfig(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// UpdateHardwareConfig operation middleware
-func (sh *strictHandler) UpdateHardwareConfig(w http.ResponseWriter, r *http.Request) {
-	var request UpdateHardwareConfigRequestObject
+// UpdateScheduleById operation middleware
+func (sh *strictHandler) UpdateScheduleById(w http.ResponseWriter, r *http.Request, scheduleId int) {
+	var request UpdateScheduleByIdRequestObject
 
-	var body UpdateHardwareConfigJSONRequestBody
+	request.ScheduleId = scheduleId
+
+	var body UpdateScheduleByIdJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -3311,18 +6336,18 @@ func (sh *strictHandler) UpdateHardwareConfig(w http.ResponseWriter, r *http.Req
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateHardwareConfig(ctx, request.(UpdateHardwareConfigRequestObject))
+		return sh.ssi.UpdateScheduleById(ctx, request.(UpdateScheduleByIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateHardwareConfig")
+		handler = middleware(handler, "UpdateScheduleById")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateHardwareConfigResponseObject); ok {
-		if err := validResponse.VisitUpdateHardwareConfigResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateScheduleByIdResponseObject); ok {
+		if err := validResponse.VisitUpdateScheduleByIdResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3330,23 +6355,26 @@ func (sh *strictHandler) UpdateHardwareConfig(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// GetHTTPConfig operation middleware
-func (sh *strictHandler) GetHTTPConfig(w http.ResponseWriter, r *http.Request) {
-	var request GetHTTPConfigRequestObject
+// ListScheduleNextRuns operation middleware
+func (sh *strictHandler) ListScheduleNextRuns(w http.ResponseWriter, r *http.Request, scheduleId int, params ListScheduleNextRunsParams) {
+	var request ListScheduleNextRunsRequestObject
+
+	request.ScheduleId = scheduleId
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetHTTPConfig(ctx, request.(GetHTTPConfigRequestObject))
+		return sh.ssi.ListScheduleNextRuns(ctx, request.(ListScheduleNextRunsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetHTTPConfig")
+		handler = middleware(handler, "ListScheduleNextRuns")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetHTTPConfigResponseObject); ok {
-		if err := validResponse.VisitGetHTTPConfigResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListScheduleNextRunsResponseObject); ok {
+		if err := validResponse.VisitListScheduleNextRunsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3354,30 +6382,23 @@ func (sh *strictHandler) GetHTTPConfig(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// UpdateHTTPConfig operation middleware
-func (sh *strictHandler) UpdateHTTPConfig(w http.ResponseWriter, r *http.Request) {
-	var request UpdateHTTPConfigRequestObject
-
-	var body UpdateHTTPConfigJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+// GetLimitSwitchState operation middleware
+func (sh *strictHandler) GetLimitSwitchState(w http.ResponseWriter, r *http.Request) {
+	var request GetLimitSwitchStateRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateHTTPConfig(ctx, request.(UpdateHTTPConfigRequestObject))
+		return sh.ssi.GetLimitSwitchState(ctx, request.(GetLimitSwitchStateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateHTTPConfig")
+		handler = middleware(handler, "GetLimitSwitchState")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateHTTPConfigResponseObject); ok {
-		if err := validResponse.VisitUpdateHTTPConfigResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetLimitSwitchStateResponseObject); ok {
+		if err := validResponse.VisitGetLimitSwitchStateResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3385,23 +6406,23 @@ func (sh *strictHandler) UpdateHTTPConfig(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// GetLogConfig operation middleware
-func (sh *strictHandler) GetLogConfig(w http.ResponseWriter, r *http.Request) {
-	var request GetLogConfigRequestObject
+// GetSystemInfo operation middleware
+func (sh *strictHandler) GetSystemInfo(w http.ResponseWriter, r *http.Request) {
+	var request GetSystemInfoRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetLogConfig(ctx, request.(GetLogConfigRequestObject))
+		return sh.ssi.GetSystemInfo(ctx, request.(GetSystemInfoRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetLogConfig")
+		handler = middleware(handler, "GetSystemInfo")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetLogConfigResponseObject); ok {
-		if err := validResponse.VisitGetLogConfigResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSystemInfoResponseObject); ok {
+		if err := validResponse.VisitGetSystemInfoResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3409,30 +6430,23 @@ func (sh *strictHandler) GetLogConfig(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// UpdateLogConfig operation middleware
-func (sh *strictHandler) UpdateLogConfig(w http.ResponseWriter, r *http.Request) {
-	var request UpdateLogConfigRequestObject
-
-	var body UpdateLogConfigJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+// RebootSystem operation middleware
+func (sh *strictHandler) RebootSystem(w http.ResponseWriter, r *http.Request) {
+	var request RebootSystemRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateLogConfig(ctx, request.(UpdateLogConfigRequestObject))
+		return sh.ssi.RebootSystem(ctx, request.(RebootSystemRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateLogConfig")
+		handler = middleware(handler, "RebootSystem")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateLogConfigResponseObject); ok {
-		if err := validResponse.VisitUpdateLogConfigResponse(w); err != nil {
+	} else if validResponse, ok := response.(RebootSystemResponseObject); ok {
+		if err := validResponse.VisitRebootSystemResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3440,23 +6454,23 @@ func (sh *strictHandler) UpdateLogConfig(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// GetWifiConfig operation middleware
-func (sh *strictHandler) GetWifiConfig(w http.ResponseWriter, r *http.Request) {
-	var request GetWifiConfigRequestObject
+// StopEmergency operation middleware
+func (sh *strictHandler) StopEmergency(w http.ResponseWriter, r *http.Request) {
+	var request StopEmergencyRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetWifiConfig(ctx, request.(GetWifiConfigRequestObject))
+		return sh.ssi.StopEmergency(ctx, request.(StopEmergencyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetWifiConfig")
+		handler = middleware(handler, "StopEmergency")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetWifiConfigResponseObject); ok {
-		if err := validResponse.VisitGetWifiConfigResponse(w); err != nil {
+	} else if validResponse, ok := response.(StopEmergencyResponseObject); ok {
+		if err := validResponse.VisitStopEmergencyResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3464,30 +6478,23 @@ func (sh *strictHandler) GetWifiConfig(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// UpdateWifiConfig operation middleware
-func (sh *strictHandler) UpdateWifiConfig(w http.ResponseWriter, r *http.Request) {
-	var request UpdateWifiConfigRequestObject
-
-	var body UpdateWifiConfigJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+// DeleteTrackMap operation middleware
+func (sh *strictHandler) DeleteTrackMap(w http.ResponseWriter, r *http.Request) {
+	var request DeleteTrackMapRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateWifiConfig(ctx, request.(UpdateWifiConfigRequestObject))
+		return sh.ssi.DeleteTrackMap(ctx, request.(DeleteTrackMapRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateWifiConfig")
+		handler = middleware(handler, "DeleteTrackMap")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateWifiConfigResponseObject); ok {
-		if err := validResponse.VisitUpdateWifiConfigResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteTrackMapResponseObject); ok {
+		if err := validResponse.VisitDeleteTrackMapResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3495,23 +6502,23 @@ func (sh *strictHandler) UpdateWifiConfig(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// GetErrorCodes operation middleware
-func (sh *strictHandler) GetErrorCodes(w http.ResponseWriter, r *http.Request) {
-	var request GetErrorCodesRequestObject
+// GetTrackMap operation middleware
+func (sh *strictHandler) GetTrackMap(w http.ResponseWriter, r *http.Request) {
+	var request GetTrackMapRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetErrorCodes(ctx, request.(GetErrorCodesRequestObject))
+		return sh.ssi.GetTrackMap(ctx, request.(GetTrackMapRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetErrorCodes")
+		handler = middleware(handler, "GetTrackMap")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetErrorCodesResponseObject); ok {
-		if err := validResponse.VisitGetErrorCodesResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetTrackMapResponseObject); ok {
+		if err := validResponse.VisitGetTrackMapResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3519,23 +6526,30 @@ func (sh *strictHandler) GetErrorCodes(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetHealth operation middleware
-func (sh *strictHandler) GetHealth(w http.ResponseWriter, r *http.Request) {
-	var request GetHealthRequestObject
+// UpdateTrackMap operation middleware
+func (sh *strictHandler) UpdateTrackMap(w http.ResponseWriter, r *http.Request) {
+	var request UpdateTrackMapRequestObject
+
+	var body UpdateTrackMapJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetHealth(ctx, request.(GetHealthRequestObject))
+		return sh.ssi.UpdateTrackMap(ctx, request.(UpdateTrackMapRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetHealth")
+		handler = middleware(handler, "UpdateTrackMap")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetHealthResponseObject); ok {
-		if err := validResponse.VisitGetHealthResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateTrackMapResponseObject); ok {
+		if err := validResponse.VisitUpdateTrackMapResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3543,23 +6557,25 @@ func (sh *strictHandler) GetHealth(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// ListAvailableSerialPorts operation middleware
-func (sh *strictHandler) ListAvailableSerialPorts(w http.ResponseWriter, r *http.Request) {
-	var request ListAvailableSerialPortsRequestObject
+// ExportTrackMap operation middleware
+func (sh *strictHandler) ExportTrackMap(w http.ResponseWriter, r *http.Request, params ExportTrackMapParams) {
+	var request ExportTrackMapRequestObject
+
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListAvailableSerialPorts(ctx, request.(ListAvailableSerialPortsRequestObject))
+		return sh.ssi.ExportTrackMap(ctx, request.(ExportTrackMapRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListAvailableSerialPorts")
+		handler = middleware(handler, "ExportTrackMap")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListAvailableSerialPortsResponseObject); ok {
-		if err := validResponse.VisitListAvailableSerialPortsResponse(w); err != nil {
+	} else if validResponse, ok := response.(ExportTrackMapResponseObject); ok {
+		if err := validResponse.VisitExportTrackMapResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3567,23 +6583,27 @@ func (sh *strictHandler) ListAvailableSerialPorts(w http.ResponseWriter, r *http
 	}
 }
 
-// GetRobotState operation middleware
-func (sh *strictHandler) GetRobotState(w http.ResponseWriter, r *http.Request) {
-	var request GetRobotStateRequestObject
+// ImportTrackMap operation middleware
+func (sh *strictHandler) ImportTrackMap(w http.ResponseWriter, r *http.Request, params ImportTrackMapParams) {
+	var request ImportTrackMapRequestObject
+
+	request.Params = params
+
+	request.Body = r.Body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetRobotState(ctx, request.(GetRobotStateRequestObject))
+		return sh.ssi.ImportTrackMap(ctx, request.(ImportTrackMapRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetRobotState")
+		handler = middleware(handler, "ImportTrackMap")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetRobotStateResponseObject); ok {
-		if err := validResponse.VisitGetRobotStateResponse(w); err != nil {
+	} else if validResponse, ok := response.(ImportTrackMapResponseObject); ok {
+		if err := validResponse.VisitImportTrackMapResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3591,23 +6611,30 @@ func (sh *strictHandler) GetRobotState(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetLimitSwitchState operation middleware
-func (sh *strictHandler) GetLimitSwitchState(w http.ResponseWriter, r *http.Request) {
-	var request GetLimitSwitchStateRequestObject
+// ImportTrackMapFromScan operation middleware
+func (sh *strictHandler) ImportTrackMapFromScan(w http.ResponseWriter, r *http.Request) {
+	var request ImportTrackMapFromScanRequestObject
+
+	var body ImportTrackMapFromScanJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetLimitSwitchState(ctx, request.(GetLimitSwitchStateRequestObject))
+		return sh.ssi.ImportTrackMapFromScan(ctx, request.(ImportTrackMapFromScanRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetLimitSwitchState")
+		handler = middleware(handler, "ImportTrackMapFromScan")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetLimitSwitchStateResponseObject); ok {
-		if err := validResponse.VisitGetLimitSwitchStateResponse(w); err != nil {
+	} else if validResponse, ok := response.(ImportTrackMapFromScanResponseObject); ok {
+		if err := validResponse.VisitImportTrackMapFromScanResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3615,23 +6642,30 @@ func (sh *strictHandler) GetLimitSwitchState(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// GetSystemInfo operation middleware
-func (sh *strictHandler) GetSystemInfo(w http.ResponseWriter, r *http.Request) {
-	var request GetSystemInfoRequestObject
+// CreateTrackMapTag operation middleware
+func (sh *strictHandler) CreateTrackMapTag(w http.ResponseWriter, r *http.Request) {
+	var request CreateTrackMapTagRequestObject
+
+	var body CreateTrackMapTagJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetSystemInfo(ctx, request.(GetSystemInfoRequestObject))
+		return sh.ssi.CreateTrackMapTag(ctx, request.(CreateTrackMapTagRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetSystemInfo")
+		handler = middleware(handler, "CreateTrackMapTag")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetSystemInfoResponseObject); ok {
-		if err := validResponse.VisitGetSystemInfoResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateTrackMapTagResponseObject); ok {
+		if err := validResponse.VisitCreateTrackMapTagResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3639,23 +6673,25 @@ func (sh *strictHandler) GetSystemInfo(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// RebootSystem operation middleware
-func (sh *strictHandler) RebootSystem(w http.ResponseWriter, r *http.Request) {
-	var request RebootSystemRequestObject
+// DeleteTrackMapTag operation middleware
+func (sh *strictHandler) DeleteTrackMapTag(w http.ResponseWriter, r *http.Request, location string) {
+	var request DeleteTrackMapTagRequestObject
+
+	request.Location = location
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.RebootSystem(ctx, request.(RebootSystemRequestObject))
+		return sh.ssi.DeleteTrackMapTag(ctx, request.(DeleteTrackMapTagRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RebootSystem")
+		handler = middleware(handler, "DeleteTrackMapTag")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RebootSystemResponseObject); ok {
-		if err := validResponse.VisitRebootSystemResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteTrackMapTagResponseObject); ok {
+		if err := validResponse.VisitDeleteTrackMapTagResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3663,23 +6699,32 @@ func (sh *strictHandler) RebootSystem(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// StopEmergency operation middleware
-func (sh *strictHandler) StopEmergency(w http.ResponseWriter, r *http.Request) {
-	var request StopEmergencyRequestObject
+// UpdateTrackMapTag operation middleware
+func (sh *strictHandler) UpdateTrackMapTag(w http.ResponseWriter, r *http.Request, location string) {
+	var request UpdateTrackMapTagRequestObject
+
+	request.Location = location
+
+	var body UpdateTrackMapTagJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.StopEmergency(ctx, request.(StopEmergencyRequestObject))
+		return sh.ssi.UpdateTrackMapTag(ctx, request.(UpdateTrackMapTagRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "StopEmergency")
+		handler = middleware(handler, "UpdateTrackMapTag")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(StopEmergencyResponseObject); ok {
-		if err := validResponse.VisitStopEmergencyResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateTrackMapTagResponseObject); ok {
+		if err := validResponse.VisitUpdateTrackMapTagResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3714,97 +6759,215 @@ func (sh *strictHandler) GetVersion(w http.ResponseWriter, r *http.Request) {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+w9e3PbtpNfhcO7P9oZ2pL8SF3/J8ty66tju5bc3Fwvk0IkJKOhCBYA7fjX0Xe/wYMk",
-	"SAIgZUuOcr/OZCaS8djFvrBY7EJ/+yFepjiBCaP+6d9+CghYQgaJ+HYLFpD/H0EaEpQyhBP/1J8+QC8F",
-	"C+gl2XIGiR/4iP/5rwySZz/wE7CE/qnPe/iBT8MHuARykjnIYuafDgJ/jskSMP/Uz1DC/MBfogQts6Vo",
-	"Y88pH48SBheQ+KtVIPCYoH9ZcJFoeHjuIQaX1Esh8RR0G2JiMjNy/TWxW+XTCIoNb0c4maOFoCXBKSQM",
-	"QdECEzCLDSv48ADZAyQew57s4rEH6A1vvSWOOI7wC1imfCAjGSzgzzCOIUj8wP+yh0kEiX86WAU+Ss0k",
-	"urz1QBQRSKk3x8QGwR/8eLA/eHeyP9gf+AUoyghKFjqko1Xgp4DSJ0wim3jIVie0YgoHqENOXoosYCaT",
-	"y3MnCAKeZ5i5ABxwBhL4V4YIjPzT33M+KbCBjiVK/Y/FVHj2JwyZvwr8YZqOcJLAUGJWZ3wY4yyqdvhP",
-	"Auf+qf8fvVL5ekqIeqNa91XgQ5pOIEEg7j7LeHLbGMK5hsJ1Z7q9HJlmInMU3dNZ93nuLi7P7ydn+iw1",
-	"ytcJZV64eREmhEy8OgOMQfI8YYBBA6tgHP+GYwYW8ntT4ngP71F14SaHS95MTqpL3u+DgyD/9zHwhWHi",
-	"M9bNR4EiIAQ8C8Fc4D31t98/cgM0eFfXvTAjBCbMgqFsdOA26PcbdqwKuAmWGxdlIU1ARZMDZBeAJzq8",
-	"d6vAf4AgZg9mgLLt1YuswPyBKwgkoZW0qpFvfXbAx2vDPeZSAJcW081bIAEsIy6oB8frQj1YBX6WRoDB",
-	"aGhZr2r2APMYWrrA+wf9g8Fen/+b9vun4t//+NpOyifa45O4bPHJKvCVbpkRUo0uth+sL9uHDUOk9Eux",
-	"pUQqqFqIUlxy5SiEVqet0Q5hxvDyZkYZCGM4JSD8zIlh8BoYJOeIMpCEBqJMGCDMiyDjJi9ZeFhN6D09",
-	"wMSL1DgPUW8GY/zksQdEvUcQZ3ATBgF+QcyFG047oQZm+BFaUDt4AWqGLV0nYg1vE3dGgCzw6AGGn3+9",
-	"u0zSTPnGFc78RUbc2zDK6a93XogjyH26kM9SdbLgCaB/NvSgjrSavw29m4zl+Fn6xZhC2yKWmGEySSGM",
-	"2rbv92XPOqbaJHZsORatuJ5jTCQg8/4cIVI6G02yF825gQj5pF6EMfEEkpwPCXfkf/dHVzeTsR/4N7fj",
-	"a452yZ+8pWmmSqFr2i6hD8J1jBw+vgEnrgH5wDW8fe4HIHqXJYmyG+tBJGrgGhCFK56LSpP4oslF+Ffs",
-	"zC/fq1yI8E3r+NWb1nFdHUohzemlc6qUkrYtQmjEFZoz24mSMj7RHQTRCGc2n6U8HMvuHoEgol6OsDBR",
-	"OKEoUsISoznzUkyR0CMCQfhQFczDdZk3qBOojrdz8Zu1XIGfL83i4eULZ1hSopCh1++XNSoUiASdDCin",
-	"Rav9vMJPkNjEZWZ1OVzUa/TnVmArgsdxf2PJC2xEsbOBY2mTSTuFQRzfzP3T3920tjiFq4+BH8GUwJBb",
-	"i9xQ1ymOqDdHMI64dS97eyCJvCcUx96Mc2CJH2HkoURQfJ7xQ0XgZRR6IV4ueddQCI+HEsogEArzBoom",
-	"OL87msbRaVW1mxQmX92r4ki0YmrxpaSw2t13ziXZp3TW9U31tWw6FA4MX0EX7wVRD/Oua8ZDuzjp3C55",
-	"c4KXGrhf7zwagiSBVYdheDb68vwvdzjxVZ7K5t2To7pcKZoXtAnqktDqlzwAsoC2CJo8Ol+hJWqJT8W8",
-	"S7F4MedGzqSdfHAB7oWe9ytY3FjlZuImtgCG5MIaHqcKwBrdB3V/YF5w/XJBhHI9CskjCqsLjnEI4gdM",
-	"2elxv388aNOl9W5NrGC72AqGP0PLbiWaOizuKDo4gicns6PB4Q9Hs8MjcHx00n8X9gcHR7Oj/vHBWkws",
-	"LiJyyucoulhnv4WQbVIz3ISAhGDCuyVZHEvyV+hnvhCKAWWjHIhUDKMUd55U6pkYZVGyim4JpoQFBbiT",
-	"Q2GIk4iWVC8uLB2qU9CpuaQCn5xGRk5IT8qmRmHuzLfeA9WOf6tAjeXuSbfB2mmgscwCjcq0jgWVzg5O",
-	"YAdndsJwqsasgjYv6BFeYPIESLTGiDMQfl5zyBR37Fx38Tr11yNtnQZo59tu/bXDRzeMKiHMtiGTECRX",
-	"OARcfToO+QBQsYKPpaxoPml3YckHrSEt6wzJxWWdMVPctXfDHe8uMWuN0MMA3WVmPaSqgeV1pKbrGC42",
-	"RV9Nbu4gTXFCTX4l5lusw+VSHbjl57tNsS3IiW0uVefN6Edufgl0OX2ieU349nByX9+Im8BEUxNI5/X8",
-	"wE9flkguipoTl3650SvXU1CKfcIpaZVNZRX4uDQZHcaVguZTnBF5fu0wbiI7yxgWcTCTyou1LUjSiQTO",
-	"sq5rncjORapAp0FT3rXrQWUzEtuIu6Ais6dYccGuQk5KzufyrvMmqCi+roKtZ5kKw808Fm0GWS+viO7P",
-	"tcldN0EaxIK3RqliGbVD/PV+fD8+9wP/9u5mNJ5MLq9/8gN/NLweja/k58n9aDQen4tOF8PLK/FBdhiv",
-	"j+tUCZThxPOcCso0cZxMb24/vb/5bfx+fD31A59//HRxc/dheHeefz0bjn7Rv09vBJZ3P918Evdt+Zf8",
-	"qk1+u7q8mJZfbj6M78qOP49Hv3z6lf9hMhpef7q6GQ2nlzd8pg/Dy+naC6dXiDL7blOk6zQJEyPKNMJw",
-	"sS16d9DLAqYp80c7+DDMQHxpR0O0azF2DR1nCKWuoxqcfCFGbRJ6V6zhrwxSZiDby0z/2matvgZpYhR0",
-	"E/rniIZbiFpF+bRvFrgqIL557Mq41t0KX+URzAlMqDWFYAbCzy1BbxB+boS8i+9UTP5ahnM+RPgpcWPC",
-	"e2wbk8NV4M8JTpgbFdFl27gMXiOdNkQ2I6ONMHqVZkFVrmrMbRVcgh7hJvNeIj5hI+Wl3KSL/bmS+FK2",
-	"byn1RUNr+1kvNWBbTnjRoX3X3xv0+99/tZyXGvc3qwhbS3cZT6ylE8q5GYafp2gJcWYhB5ONZZxe3WkP",
-	"R794KPGWKI5RGRI2nGi18HDJGFkCUhEKuaRh+LnzvUSJybq7NRWZ7m3uUZEPb4r1qil0vAMDTS1MMdUL",
-	"vOHVwuF2rhbWivrbg/1j3jLCEXRFruQFdOMosoSUqkxndyZoKK9p8/5WPNpxqMpqmFGGl56skVERpbBe",
-	"QcPPBPvXmF3gLHFW6nD2RpABFFePUC6xvUAwjgTurvPQYZVY7YvIO+vr4GccL8HcOrQs5OAF9NcW0iC+",
-	"yMppIi6TdURNmo6n+oOTzFZi2Jd/DZbizFCsax0CyBW4KfDzdGq13ykmtooKTEp7zacQ16rVrIuTvmXz",
-	"1ChCn8BiAUlXezyR3b37y/XMcSOxiHDzkAM3kgWQ6AkQaK0KpGmHUq7yCjBFYYeCLctGwIHJKYyoisoF",
-	"uxGhnQNbqjinmfqOP7tFu5muxyGakL1Cc+bymNUR8taZhJYf6otkNIW/yPx8Yd7yCw/2Jcjte8ZVWC90",
-	"jBkgC9hCX9lni+R9uY9sxmFLLnJdGBvUe5nHLOIjkyfEwgeDzSWQ0napWyLmUTEFl4V80JqZMy9lQQl8",
-	"83lvTWNdrK0zVS22JS57DNqMsc6jOkaVeYyoqGtVAwpaiyFGrVq97+4uLs89BhbfVyuQsi/gxwFuliAF",
-	"vsx6tLJSXBTxk4xgpKjkYjrAJ0BV4mRkYOnR3uBkOjhYi6UNouUr13F1Ea8l5OskZBH1zdenRJdgWVW+",
-	"TmboKxSlXPKGLdWBLfJaQmxRFrywH9oTiuPWwL6cgff8GSRRLGui56jTwAukjWr4rCJ3LsfCjrwO+pWP",
-	"NihgXowX65rQnG9mdV54sr1wlUGaxqiUChXg+6+JuBGbjv97Wo3sqYb1wnri5A0fYWzGahHjGYgFcqJX",
-	"C27n47P7n/zAv7y+uBG3dncco/Hd3c1dFde843rI2p9xkEsoKGwRBF2UXikFXPL+n4jA8bckAvJVEtuD",
-	"AbyFM8rGIT/GC9qTYYR92ebMTCWYifV1qoAS7EMxpByDzxCmVcfXdbq1pwWLtdYR6STv7yv1KS2RbbOj",
-	"vgRf1JM44lv+QE63KLdAoZHA+VXKaEyZgaZKGt7vvOstjPCLlvgxd+dd1y/d0haaGbJfjVq11EsbsYpU",
-	"W/dlVlsGZklzboq6eb0Mc+JDj+FOdffBZkineWn6lUgHipZZpiZivvqNiKl+P1qvuixeZeDHI+7qfhcu",
-	"v68+wLCFpyG6oRTGEBAYNVA6/BpPQpRRtX+up3bmesr0NtU/11MlfZpvbv1DHY06/BQvwgP2oDeov+3m",
-	"EvPqQ3CrwM8fKGoZV3mULK/z6VTiUx1SPJ/SaWztsRU+iUzwahusZdKt+GZHu42rJeHJoVrCVofxjfQu",
-	"PkmRPNM6QS3Nhgtnfo3QHser3DfUnBF3nEKPQ9Vlt3zCqsiu0zPtahTSEa6sPNDdj7ySuCYTQU2YTSox",
-	"mQ43837lZDrc9gOWT2iOtII/20OW/X7v4EiPjKH08WjDr1u6UNnwK5cuUG/y2qWhSszktprKgqxh7O4Z",
-	"A0Vksp4vYHPHzdeHFYfEkCOaRXcqamzKD80ijwAGC55ID8bClR/fua+vRSooYOAMMWoNugNvhhjtBvDE",
-	"HU7gPCaIPdtEm7e5Aanz7PXN9dgP/PFvIqH/5ryWTaia1w84tWQLJGDZkfJ+L4KPPcae7ydn/bbQPIEg",
-	"crrv4pmIug/fgF8tO9adeEOMpItD/06UC+HULh68dQ3x0F9fjnAmtd+F0DzGgL07cmbDqkyIQnE0kdbQ",
-	"L0SvSm67gt4qUVgrnUTFrRQRFGYvEQrTGt24vrqqREO6c2WJRipHElWzOsta7KFVbhstu1ara2x/pgwu",
-	"L5M5Npw20uyeWp/3HN3eexnVHvikYiquU+XTq65q/gPll8WXqT1GFOvORAVQe97VEpNnxwJkh9et4TCv",
-	"/nkvJnOV/yhwDUDvz1wAjtZ6V6GctcNrCsemXZgzIyg5XyVjda0FYiax/A0SajzCzjIUR+dqv24E9xZY",
-	"G9hofbS21VaSdww0cPrkJoy16vhmFDQjwj95b9v0VbszNNTvUOGlAbLh6NLmD2iOrE/CtOauDbXUNcpA",
-	"qykrjh/1VYBU1oUa1rASRcfS2jTpeCcTQ4e3l+L0FEJlmtWPAby/nHKpI7F/6j8wltLTXg+n/LSVkRDu",
-	"Y7LoqUG0x/ty5URMbCSVmQs58vv7g/2+qGhOYQJS5J/6h/v9/b66sRGE6xXleqd/+wto2M34TuKBONYL",
-	"+7B4B5q73ZHqMSob9R9usDy2UHbpiR92sFXp1/qJ30rgfWvP+/K9VoseUm/2LL4v0CNM5Ft0+949hd4f",
-	"e3/w0yHlA1Di8WlgEok3gbnZUJ2CstPs2VtmMUNpDOU8dN8bS6E/9f7YU0XAnwALZIbgH94wjvETjFTv",
-	"0/9NPG9P1LDKT7Kb+iw4Kz+XM8nvKtmh+F4UH4u/WH5PgqrNuvwxiYYlqdPuAsUMEgf1JMKQVmgzl6N0",
-	"6pT9SvrIIuKgLCEuySOeV87JI/vJz2Vn+b0oM5ZfZaWx/JwXG9vpoXBykuQjV2/pKAklOOj3VTSSqefX",
-	"tTvk3p9Umuhyvg61otUaX2EmqlwYNot5V4F/tEFMqmn5BhTOQOTl5bTil0Sy5RLwTd9oABhYUBnwVH/6",
-	"KN9ZNNgPWbDrAa2Au2o+KhW9vjS2kLIzHD1vjhGmquFV1bQzksFVQxgGmxYGFxOKxztgVJBrdwTBwEmD",
-	"HKyCclPppQSHkFJ1WWjcX36CFePtsQfAPETzjLf42ZtBbqHVVLApQD9BNlJ5rQU4XZy2q9yt/NT5ePR2",
-	"fLzGBUmd1KzymHOjSEYvqPkijvdCkIQyYcdiGUS7ZL4LZM1ciFHdGX5kfaVH0OYJUE8iCt9e16YPkIhi",
-	"mKRklps/imavYtHf6tNltJK04Y5Fk0rn4u+luvPt/vK8wQ/ZTZH/7PkyarqAYm9WSUJqay5Q8OsmuPpb",
-	"WI3o+/laLwKZtvcOAiFJ8lXkQVdaflIvGSweRQaJKB2bwRLHinxYmWbcsa0GuY3p3OR+Oxz/t7H5dTku",
-	"qwybVr6TiEi7wc/BtCfetWzfxvPXL+dooc77RunRHnjdJr80MDZ6GRDeHZ/LTdaSY2KFwgk33Rrcy8e1",
-	"uvJHdq+zaAteeZ07bc74mwpGXgOx2wLSytqGjFR0Whmqrs55u15X3pzdviVu0W0j2juo3RbyvkS/O3FK",
-	"aXiDWVvQ8Saf3lDLuwhJoec7LiwdmOzU9QdVAN6q7HnHdm2v1ZRvkZM1SBZWWjDfPYW3kvgFGt+RXXKE",
-	"gWOb13kTs95O6buJSq71Oy8yXTjt1nvG0ladFy9etOt7+bTGNhlYQrEwz4Dt7um4kaQv0O8OrFG6XeXO",
-	"FvS6xpg31OlWkcj1eadFo42rTj2OcXsQPcaLdi0uS6W3yLESiIVhTVR3T4VN5HyBBrdzRXauMmbz+lvj",
-	"ydupb6sw5Nq7y0LRwlCn7j6hOWpV3jyh2q29WhrMFjmmQbGwzIDt7imwkaQv0OAOrJG9a9zZvA5XGbPa",
-	"MREQUedcmWkWhpDSeRbHz7upx93EgyuyqN3aC3EEqVOPQRxr7xdSkwIXLzXS1ypwp6Tc5sOQzYKFBvVu",
-	"ftkxZW7SNWeTzhnJK/XD961hDvk2XJmkLV/7ax568t/R3553W33v7lvgh4OAOWMUGyRPUkhQ+gAJiGlP",
-	"ppZ3yDgEjwCJSsx6Nnoz/3CYdy1z0Ok2WWbJtN911knS2siac05jlmKfeGZqj+YPWLnvClRuguhdf6eq",
-	"oV1l6es22WUosP0WtExQTRJSY4/ODMke8Zn2xDNue7R4h899VNRf3MtBNM+K9TfotnlKqMOynRybmO/g",
-	"0dFE3pyDFd6JsoZenjfu5FlRAiHrpiwnBK3sZZsWsIRi4ZMB293jk5GkBZ9kMU6FUQTOMGb2rLY70a7N",
-	"vd/gkewyySt92vOTrrE3UvTaHQo2FtpCOMpwugeXkCxgEj7bCThhOBXbv3iwhuZ5TyGMxV/VK7GB91cG",
-	"M/Wz8s00uKaXwKcdF9C/WapvjDpGVmlFSPYjTrlsT/Vvs0i/FSVLWzNHOYhv4jDTSsGcOTk/PgoY0sWW",
-	"GXayUKgHUtR7HPirj6v/CwAA//+j85qmQJUAAA==",
+	"H4sIAAAAAAAC/+y9aXPjNtYo/FdYfN8Pz3OLtmW33dPxp8ctq9O68RZLTu7cTFcHJmGJ0xTBAJCXSfm/",
+	"38JKkARIUIujzKQmVdMWsRycBTg4OMvvYYwWBcphTkl4+ntYAAwWkELM/7oBM8j+P4EkxmlBU5SHp+F0",
+	"DoMCzGCQLxf3EIdRmLKff1tC/BJGYQ4WMDwNWYswCkk8hwsgBnkAy4yGp4dR+IDwAtDwNFymOQ2jcJHm",
+	"6WK54N/oS8H6pzmFM4jD19eIwzFJ/+WARYARoIcgpXBBggLiQM7uAowPZgdu0BO6VzUMx9jZzRDlD+mM",
+	"/bvAqICYppB/gTm4zywr+HkO6RzigKJANAnoHAZnN8ECJQxG+AwWBetI8RLq+e8RyiDIwyh83kM4gTg8",
+	"PXyNwrSwo2h8E4AkwZCQ4AFh1wzh4XdH+4fvP+wf7h+GeipCcZrPzJmOX6OwAIQ8IZy42EN8bZ1ND9Ey",
+	"1TuGXpI6pplMxuetU2Dwco9o2wRHjIAY/rZMMUzC018UneS0kQllWoRf9FDo/p8wpuFrFJ4VxRDlOYwF",
+	"ZHXCxxlaJtUG/z+GD+Fp+P8dlMJ3IJnoYFhr/hqFkBQTiFOQ+Y8ymtw0ujCqpXHfkW7GQ9tI+CFN7si9",
+	"/zi3n8bnd5OP5ig1zNcRZV+4fRE2gKy0ohQuCjrCGOEmqYD4amc2+bHc9TSbNbcFxmAztCd/ZdvIu6O6",
+	"rEIFQ3Mq/oltaLSct52Jo/ABpBlMzhzA03QBzdEC0Tw0NrsEULjH2rXLY41oJXRiPQYgNvx/ZO3xyxBm",
+	"GXHtlAvwPF7cgwzksWPHX4BntiEHSfrwADHMYxjcQ/oEYc7XOE9nc0hoAPKE/52hJ/ZnDLMseEQZZWdX",
+	"mgeLn6JgECQpYQJPeMt4DuNvFdIOBsb+P/Ag9OH7+v61AM8/iVnbV7MKfMdHawLIWGeR5u0ApvnKAL4b",
+	"rAngYZ3jDGgruI2qjNPGfWixAHni4r+Y/Y4X03QB0ZJekiZSPqOnIEP5jB3aTyCl+gy6GQ/ZbxgWCFP+",
+	"Sw6fAgIpTfMZQ9aSSEQphcNA1YkPqqp8NZwDPIPDJcYwpxfpIqUdLMbbB7HoEGSsR0DngAYxyIN7yEBl",
+	"YC4gyEmQI9XRBPNofYpy2M9TEvcEP0nJJlZwMlhbaOo8aSVFyzKjJpe1MOx5CmY5IjSNiYVdYZZ17Jfr",
+	"7pNV8q+0x3BuJ3bwYpQnKfubMOqxKRk0vEdwn9KHFGaJOg3vBUb2A9bxPqVBBl7QkgYpIzYNJFZhEty/",
+	"aIlkvzwBDIMXxht8i+LfMHgKGlL4S3j90+j263R0ecNIwq8UQn9fLvTXn64vpmffj8IovLs6r/zNPw/v",
+	"bm9HV9MwMsZSTeUfk8/Xt9Ovw/Ht8G7MGg5HFxdfx5cfzy7OroZsoOH15eXd1Xh4Nh1fX4Vf6mfyq/4B",
+	"YAxeajT45Uvz6Ob3A8Uno+cYwgQmLfeRORScoPswHIN79CgUCY7p2RLDxCZiDyAjrbcVtoGhJb1++IiW",
+	"eUK4QmDnjjRP4DMkigEYTCRAS0rSpAGJ4tp7PmiFrEcmMeu3OAsy9V2nJuqSkaOa3Nlwa1lhi5DfoCyN",
+	"XyYUUNgU8gw+wsyOnww97UmpCHiziB86sTjkSACfY1hQeToDPEvzWYBySAImERgyKGASABoMb8fT8fDs",
+	"Iow0s19d317yH34+u70aX33P+FK1+mJgt2zY1BxLrrTzZAFxDHPHzp8BQpXMB7JlMAd5kpUiXnDEmcT+",
+	"4LNHfahvUcsiAdStQrOPYqtkurTkxgp8YgATkPBocHS4N2D/TQeDU/7f/11f6RbcUKLOhL2FwyaQilNq",
+	"nBdLajtKOk9i2/EbBQq2IH0oeSwl0qCRVBTqkw1oD2pc5+bVAUSXKaVxvsfVs1sN6YPr6yV1IFsdVX4M",
+	"9zSHuT7RhIIJk7qKWee9k73B8d7hh+nhUS/eayzfALV9zVrbWZ3FHEpelctUIzeNTwZvwWjdcLwNr2m8",
+	"/yexm/2oZOeyvBO69E1DwSU1xbKiMxweReq/ntqDqYo1WYsZUCWZHRCKjy2wccPEChydVK8SbRY7y+VD",
+	"KfJ2qPmnFph7n8rvX6NwDkFG5/YJxbe1sVSZ829dKon8yNRM98Qnvec9YWwEFw4DPvsCMaBL3Dbr0ckW",
+	"9B75mWmIpuZjmX4z2s6H1yh8bDNHyY9tZD9axVJn34dDSZYSqKi6xZhqmLpNSqY1cVsVPeu2hihFi+t7",
+	"QkGcwSkG8TeGGstLEoX4PCXUfuGfUIBpkEAKY8p1fTmg2NIT2Y+dVvcwQ08BnackeATZEm5if4HPKW2D",
+	"DRVeoKl7pg20o8EGLDZVJNbgtlFnCPAMDZnt4Mdbl3LzGx6ixMG1P94GMUpgQFHTSBoewg+A/LPzYJTj",
+	"d4Fn6ACOdhkiTg1tgSjCkwLCpOuAuCxb1iE1BvkStUHRCes5QlhMZD/ukxSXD1A2bVJ+1vYDNmiQIIQD",
+	"DqRx2x1eXE+4DedmdFW93qov/W+3nRqkDaYVVUmmVqTkdpnnct/oNyOWHXvMyJ9nFas0kc8/tSF+jXN6",
+	"9ZOrDRCpxa55hJ3UxaFkUoUvk1Ill3Rd5LlEfEaLP154GRDuuwbI0nvsb0zRl40sfaDBEyBBOcKG7hg1",
+	"zvkXxOj64YFAF3zoyTiQMASCi5gmhgr56kCeUhrPI/amkwSAlAtgg1fVkfVfvQyAoyp+nSS6SB+o642L",
+	"UMZwtxAkQ7R0abqlY41ozvFAyns4O8pQTtIE4nLxBSLcls8ax/Mq/d71FfIGGupwty5+s0IShWppjnuB",
+	"WjhFAhN6r4kCDDNA00d++lfYxGB0Zs4cnt1+f/318/XlaH1drIY5DXzkJd8Mf51n8wV6gtjFYvdOdbYN",
+	"4432r1GD6JthVgb7G3Nr5EKKmwwMShcfuzEMsuz6ITz9peOCb+//+iUKE1hgGPP9VyoBdYynJBAPcykJ",
+	"ytZ8T39Ks4w9xWK4QI8w0Q96S7rEkO+X6nVCvt0EaU4oBFzI3kA4OeX/3NLJltApntcFzP9wRYEB0Qmp",
+	"Q7cXDO6+TvI3YN6mPKtNJW9dMr3jCjVbgY82nZIAsaY9fTZ9Lo1cAXnAaGFM9+NtQGKQ51VftPDs4/D5",
+	"5V9d+s8amvPm1eXGG6vEucZNVOeETj2Z28JdBuLuV66Kd4laPB9zIzYSrzshn27lR4WVSdxY5ZbeMB3P",
+	"HJ2UlU6iVpVD+jjbF1x3gObupgGB+DGNqwvOUAyyOSKUPSKdHHbJUj/Pbue0PnsFRd+g44TjnzwWd5wc",
+	"HcMPH+6PD9/97fj+3TE4Of4weB8PDo+O748HJ0e9iKidpRXmFYhtpHN7SotvQjLaEaGdZ/NlloH7Bv7s",
+	"TuvstX6oJhGCYeVi70GFnPFeDiGryBYnSqwxwBQjAmMkXFXkJNq9uEV0NJ6aS9LwKBxZKdHuAKks6n7v",
+	"Q9XB2MuWuj90DVC/peq+TLvx62xcQNjjFk4fYVfHc9ao7JOhp49+673QLcveTMGdom4VirUqexUYwkXh",
+	"5X2vW5a9MYzRowe8kjC3srk5AO3Rm5pdqXBX9OwsnRtV9zobaz6p0L2CnnJGBbaxfkVwTYYoLB+DDLq2",
+	"iECpHqMcelyZ2AOG7PMadRP9E8JPACc9enwE8beeXabIs3H9UuDV3nwr8OpgWF782htXXD+IKo8wXl0M",
+	"k2lX+0kM8gsUA8Z+nl1+Bqnvii9TQvwHPodZ+uiNHIeXl3+3uvPO65dSUIwrnL+kqE49RKVPFyUrffpM",
+	"kW/rxu3VX1x69TAtbf4C0w+o6rugt8j4djBlxrcPExpvwgmp8W0uxca3uctjbwXB0V0NybnBaIYhIbeQ",
+	"FCgntgupPIscr2hp+YQmGnbHnhmasuwz5Y38Tm3WlOk2kBDphFEN2oRJcLh8Bt8doihYoMdUBMJ8ePh2",
+	"RAag/V1MO0s4vTsYXBlkPwiDx4CNzW7aAdPKme9fKmI9ckSDe9F+Seu+f04d3tOUy0Nc54A4gCQUFiZF",
+	"2DU9zaMALgr6Eixzmmb8M3yG8ZIiLD3oxBtRIRnChDi8vP5J+Fe3hsJ23OkbnslqKjm/xKE/fOvdjt43",
+	"Ly6K0at8qXBd4Y6SATttAmKoH5dw6bL5FIC90rVbXH5j/RklReMoQHn2YroYSU/z0rUeYMYKgLtGPs3T",
+	"DNrG6RUWwR3iebfOSE4xDXss1fOsR65jPffHFwfboyWOoQi3Ei1LSKpGyJubvtdoDAFxWe/FN9eqy2kX",
+	"gIl3Lk2E3tP7SlY5P2FcxqHgYiY7r+8noNek6aARY/CFpzzcGvfEmp2sxWFGfAtAUWSpeDYz9zmUC4Zf",
+	"FqbfDAtZuhidh1H4w+hmGkbh7ejHu9Hd6LzmRVO2WyFMhMueazvmIAXqZqgElTMqo5JcTdiE5uvZxUWX",
+	"ga/A8DFFS8L2liVxgkCXpHZQl44NHAChIKkdowLMze31cDSZeBwBco0erJrAOGVqE8fAAiSwN382DPNA",
+	"x+CrmJsabqrweTCnywbVRu4ai5Y8WeNWIhkAYim3SYAwO95iSAjTWhzkQUUBkwZ/S075YTS6+Sq5mzH6",
+	"5O5yZGNzJ2N1MTqGZLlg+xY7Gp0Rk4K/2EBylewsKpYsGCn+FqS5xITYrmSglABWCYda/ItAENs5MV4W",
+	"Nc78JRSvntwnLpJ/CHc4i0t8S3CiyxFTc1Jt3a2849KkgZHegbTkVtCCKpIVqKwIpKlo6xW2Kc6VrBIt",
+	"iz9kzuVqri43hnbQogAEZEkKmHMRl+GvoNZrzSwV78X9genj7v3GUNi5V1cDhetpJd8xGDBsO5z5557z",
+	"u0VwwAzJECRZmsOWHfaeSVAazyv7/WJJ9KWEI23t5R8e90gVUq7Ze3zGkelWLp6pNq163Dm1rSpES9qj",
+	"X3lXDwucIpxSx5mhvjbESJ8VTymdi5B4XLYGWF2SYMLiyAntE9CS5vT9cQUvRzX7vZ+jTl2hEx46nVqQ",
+	"VgDqa1Y36YqqAsQWUsA8ESHChqZXZ6dyQR/Ue8KNPrD91nRrdLKvhw+rTqu2JbQ05Cck2x2lXu1eCLNN",
+	"iPuNJ+tNRGPhn4ZbticiAjK2sDd+EJNLpdQHZtG4+pBj3+DQktZxWT5XVvHvaF5DvZaa937WGUWYd/pA",
+	"7WW98nJ/2MyJ0fDmSnVOM00fzVx6Yyy3ujJ3UslJ1YPXPAKrQUV61zNfyvTxVZVNQ/eIaqqSsSu1Kl7U",
+	"rbHrFHo95J4bfJRpEiQiCwjIbioD9xitYxO5f6kwZ1hfaZueWibsUSC3IGqiNxKnEaV5spZRKHfsajEZ",
+	"fh6d312MbsMo/Hg2nY5u//715vpiPPx7MxuI9V5hgNP34mqAo686lSuquOOIf0/uhsPR6Jw3+nQ2Fhd8",
+	"fdfvC2v1zbiNy9z7VrlRsXVVL0vsmGdt6BymOGBu/rJXJWtQKUvmYe+Xm+nQj61bBrNysmVxNX62rWA/",
+	"GNMAZATJnCgBleZrjprL8WQyvr7iXrvno4vxT6NbwQmAKgnR6/9dXf4+j4Y/fP3xVmzkl9c/jb5Or9kf",
+	"g9etyZPCpQUr7JApiWww7mR6ffOVgXcpUvFwSD9d3/58dnuu/vx4NvzB/Ht6HUbuC6/662L8aVr+cf3z",
+	"6Fb/pZEThRVv4cnw7OrrxbXM5sPyqfCsPxL/YRRK7BuyPhlNvw4/n91+P6r9eD6eyN/7yha5SAl13571",
+	"XbeJ5iwl1EAz8b0Z12/srZaBKKSIgmzsBoN/N+7IBjitXpl15jPmUQuxch8/dPUafltCQm1b0oYui1Hl",
+	"M7vJE/nelZKAUOZVL2P0AvBA5auFcdb3NT6vfEd7o6vWfnAuNgkSUBQM6puxzDrVM0dj4072rnmD6aFx",
+	"rKtHr0BxvbOb2OnUw/PKbyLuoq6VtyQ6rr0T9VbI6wIoDhfJf27Z40Epl6CYgpkhf343TEvf16guvO1h",
+	"I4O9e0B4BEsCnwWOwUw89hKIhft0ZP4OCnZ/Fjlq2G1axOuVoes9M9JwvNVQw1wLqo5B7THRXR4x57ox",
+	"UzvQSsE3LGJmlW7Mk+Vm1cAd9pMMymRKrEwZvG68B3MlXGEpPlEcPO6/8lRcCRyJGNMIDwL2DaN7RHl6",
+	"UeGzzv51vsR8oZdEh0x5pBGoya5Clv2gl248ahORjRl/RwHCld9ysNAbC2XCFixAUQEoQfG3vVYHeqYs",
+	"V1fW9qol0606EKifNDAUBnGF+WZeKH1UquPAvd2dWKL6JLNV+dfkHVMkTKmy7XQ1d6Vm7j9Jkl4E68kX",
+	"h8rfpf2WyJ+ABZxsPgjiecC7qdkTsZYXX/1QenZNKDScBVtVRIyyjL1xuR1zeCYDHu+ZISKizKvxuBXt",
+	"ScBb5sPeAtjN/H2KohrpxrqsLKIczDYb0qRTrb1ZVJOe8c0Dm6xr3a3YJhXeNoE5ceY7YTzSERHJduJ6",
+	"PKT+m/DB1yX4EdcVnvJ2SKpZFLYDCTuzHzDKaTsovMm2YTlchztdgGyGR5t5bCs4i6p8VSNuJ+MaITUN",
+	"hkUbyABAhSqfL0F2rmJ87JuMaeXhW3/FzsNPBuH+GLOVZdLDiQ3P6aCAJfv/yKfMN2KOsqRyc5IOaFwD",
+	"kXezKEBs7qeUQOFQoW70Acpj6YvDoC5T7Ug7//4/8m7vwDrhGvi0oMdJpE1mUjLWZJjdSgubQnrVO6b8",
+	"vqVkSjVUbzePUm2yLadQMmf7r8He4WDw339YFqUa9Te7W20tgdJocuMu+8AF/Cz+xkw8qU0THj0XEKcL",
+	"mFOQiQz0pSmSLYkEwPQIJzCnAZiBNBcWL66eszvT+FxcVdjV7mz4g+zLbDr/yMfc9sNdjwl4gOqNVeew",
+	"T2c5wmymnA2TMuM+hiB5CTDII8PKeI8ATspuCYLifT9BPA3+P3KCAhBgSCAzRYIXoTSbZqOnNIa1Hapq",
+	"dutpzGg42JcYn/pY0XRsswSQYS7Ng0WaZWkZRmtxUDFCait2wLoYCiY6i7+1yHw1lruEpK8SS3gFo66z",
+	"UNc5ssVPyiFMuG04jSyc7RANW22oNwzRfretEG0MJdxe+YOENIocQYTdmPJv3DsGFTCHiRDosLcz3XG/",
+	"KG7lEVAD3Uo41pRZn9oijIRtqvEwZMT4dOVkTsyQDCcc3TBUUR8vCUWLQBRqk95sdZMNv5PvXyH6iT1h",
+	"dpkxEkiZHb3yoNUmY59SmCWdrpvvqsjqXoRqbK6DvTjxTfihayFHK+DfWEgD+TxVUxNw/jO341XglD+0",
+	"otmJDPfyr7i9sMzJ1QsBYgXtGPg8nd64HcoxdZmYcXm4sCF43oxqWp0Pg0Hnmz95AjP2s+fhMRHNg7vx",
+	"Wuni+brKya1oAThhSkBHvgddg8Un6YNRwE1UKPSoSFi2L9LYo+5g2Z4V9/MpMOg4KRl4YtKoulg5shVp",
+	"PIWzezsj3j41Mkt5M+sv+tYuZBa789J+do8XjA3Ug9cnjBYsJNf5Yu0VcMr8y2NRySaouC+s5A1MUYEy",
+	"NOt8XtWPdqp9WwyhHtSGE55YROZv47Zau+W0TDTaetGzZCatpoSLqukvZXkd8FQ1ghFpfeAvvH2z/2wz",
+	"rar3nW2TUXWOqyUHNy5Jtw1r2O6ngG2qACbx3Plgu67ATC7azEDSeN3+LCsblc+zJulWTO+84pNCOeX2",
+	"zT3VuVa09lCAZ7ADv6LNFtF7tJ50vpXdp86MDeytZgbiLzMTLrwWTRFDQrq5rpR/HustO/Xc0lclQTn5",
+	"5tMxNlVMvTZvrLrK2JUtDjvTbZVtG0pAZRw7KPk3BoPlOR08zs7ibxeAwjx+cbkbgEeIwUy6HejEm9wr",
+	"LeAWM64gaVMZ0qa8pkHKmdftpDSBkTPmGNJlmCjzDsTfcvSUwWRWVsHj9j4fs8T74/o+oMa9QIR6w8DD",
+	"DU04xKM6yDIZk0NgZf2+AL0zAJrA3B8gbm3ldlZGB7kTRLIqKNO68tl6kB3yl0awgBOmV+cx/B4U3VGc",
+	"rAMJFqmIODbLrxI5jGzN7wuPIEsT2ak/hN8pCMktjGH62M1S5nwBlp1k5guONZgInDKAh7fDw/e8sXx2",
+	"6gfd3wzo/qmNiB7IY74ZinqPEJMU5VGQwXxG5wHCDCx9ueoJ0gdRirh7P2DVniGhW90P3ovgb86cXiZK",
+	"UAneM14bpCjm+nFB2IF7IufYcfUSYlnfu2rbSLmUqLHjNnHeYNsGp9hEz7rxG85SK7lR/RezIzB3t/+u",
+	"ulCJhEehpQ6vyMLc+06mJuS0E0NsqZ6e4W5UwtqGvA4vo1ZEakejml8ad2fsmal6DQ2pXPKGVVRnvcZy",
+	"xg4tCc1aKs8TlMHuRKSzoWj5mVfC5Ybrh9Sr46fU6NUwsWaQy7GAwg28OfVpszxZr3TIYrQgQ7O+urOi",
+	"m12cZ4H4ri27Ro4Pw13hf094+M109H+mVT8F+aGfkwJ/wXKXap5l6B5kHDjeqgO289HHOxZgN776dC1r",
+	"MIdROLq9vb6twqoa9gP2yJnNWdUXlhh2MMKndGNcwDjv34QFTv5MLCBSfrnKa7IvKrbERqEwQzNyIF69",
+	"9sW39mdYJHyTvV5hOfnSDPLolm8QFlWLR5u92Z2mnK+1Dognv9eSPzc3b+5dOlyphHZAIC2dxYTnv6hI",
+	"Q4ys/LhWBXG9+saM9nLci14e5rKT8jSPmDFbxbLVowGEvhPjlKYxyG5kAXfuG1NVBuSg7cEC/G5YHcvl",
+	"AlutGA8ouyiIepfCRYZVMX5C+Jt4j1RF8POkQgC6xLmOrpIQsiDbJCWMoQRtRLNqjfOVo9OsDlqVkBSX",
+	"i5jIxodYQk5oAXlJJLhl3G0lXeam4D3h8RyY2ePWpBDIA5BBzLkFg5RnZKyhnn+vlGza4Eoa72+1ZTVZ",
+	"sSlPkW1XMAlq22qqOaItD4+wIG3RDxSpOEomjmI1/eMaGCSLNJfBuIc1z4jm2yR0XMhquXv7LccZdMJ6",
+	"bTBYo/dyOIYaa1kxnHb7wZQWFNgehVdK9CVJsW6qWP8sV3LCXnlNjaxSvVIulQmdbSkZDK5UgFUz47Bf",
+	"grnMrHQPYa4jnNtyEb1bJafQRshwskZCoc0wsZy9T3IcK8Mj2rbpSPP+ZaUImt3zdO8JprM51w5EJ/n+",
+	"J05dAmmB0pwqd2wjNFE0ewL6nTCSKfooBkzxV27MZQT20f5Jx3PBQ5qDzFNbKxUZmdGSxyqL+FIRY56k",
+	"SZDL7+wABgEz5+d244lHem+mq6johBuwJNDfCe/a7Nbmh/deyYYnFu7hA8JQpik3lr/M+Vq9bHx1VxYw",
+	"IzdAPRF6LU80n4JZl4uh4A2vYFhuheaokAnYOZnZv2s25yhI8zhbJsqErkgkMihXfaaPTnpGxx5bvJSw",
+	"aQyrsmwFe5blRhbRbDCVQ+BbRbkSR2F/QV9LceQgNMq3/CFlF22lMbzPverG+fpFjnfuGxvE73tK2s7u",
+	"ptdBkcbfZNqhOcIUEmo0XxLFlZUAcncsURSyQf0z3zSr8PxhNKlVOFmXJDzdfdKVnIZdNbqWxUdiQ1pW",
+	"5Qgmq1S0akwaYwgLvxureKoqACkZAczUrk21J0zl6kcy9MRaJ7UN/Gijtz8+1hBhyaqOrVjOZxiuGM6I",
+	"CMKliN1iEXuznCMql7JOPpDGVVuOjejPaZ6gJ9uB8Rk98edLfSYK18QnQOO5JoA+O5SLGweVLSCBFMbU",
+	"WAeijXt4rLFUy7TgmzLtWGRZn/JZp2BG2hKneSadtidRAzN+QWYsJDz8AOUeFHK9LMvFUmR84ek11Ilh",
+	"ZcrW3Be/a3Xi9BetPH1pT5EWhQTOFjAXiSO4taCD7bgkkdKt4AnxJbIjP7hHdF5utd4puyZ1EHqlNjBE",
+	"v05S6+psLNyQPPcOtPkMOJ7PxKWlzSfTxsp1rd0vuR4HTlnratWzJmoaCTr2Q9c+aEiN2HE02bnG2iut",
+	"stS46pzXyjBM8qqXDAvPtOYykF/VYrQWzZMlxmU1FK5VB/8VL/67bhNewQPWrAXT951aptS3GxGuLCmg",
+	"ReYqcYPdUE2Zd81AXp1ToCjrmZSA2njZVuG+/tpIIT73ph97dE4TiKuELLjHCm2Q7mhF5+XnlK4LUpxB",
+	"gGHSAOndYH138irOavDayFBG5PwVO/1X7PRfsdNV0fgrdvrPGDtdGuZa/QV72whFmcTVzu2WZGFqVPtS",
+	"lgSapfCcNoFepdZSj0prC/B8wd1xw9Ojk5OumMYm5PUi1+tUg5LqmUqbWqnEZTjMsjMFNHLQpiSQifYN",
+	"K9TV9dVIZzTn+c4nN6OrWlob2cjHKGUvgGQjaWmYOf1dg/Pp9vpqKk1iTTuY6qUCZP6gqqOFX1LPZmJc",
+	"zXhRcMjIwf7O4TM1favvofmM5gcU28r4uIpR2/cxWS7Mll/6XS/XIzNctSiDlkxQbJQ3gpmbL+b8pLlp",
+	"j2fn/ugYggRi7qDOZIG/nDNLBmDKVpbmUOSuvZt8DD6Pz1VzWbJDZyOtCP5BAh8PCkoO3vWLXS5hdi33",
+	"bvLx3+30XOHUsmKnmp26mQ8QPTw4bhgwAy91Rc80ZIkIBp7+OgoStLzPtEkQ8ryYqui++VTibdY7kuEV",
+	"rRXPmhZUVaWk/nAlgEU5rImjVqv54dORxLrb5vuxDaMKWhdmhR0OC12xUpAhU9kgq5lo/TCpk5Qzzuss",
+	"bxfEGdMUZGVAilOeaAJoYnrZAW8r07UZARvqmUnzSHNoBbnN5Tg5v4TBOAXPhj98nY4vR9d37CycjG7H",
+	"Zxdfr66nX4fXV1ej4dRWeYQNyB7EeXhDSxHBoqhuRK2l/yqNX3V+Cc88GhwUo5tfDvqPZmM9BM9tG556",
+	"1HavdjlHCHObo1df3bocRKRV7eps5K8t769cV/F0GzHqOzPbvs7n2tG7ljtXdDXyrHr0b2RlZYPodIqd",
+	"A9QSL8p86UZuiu5QWEsqCzmMFwi1mP+ambu1ZyUkqC7oitk1E5h0aSDaBLiCwMi85Ag2bvBmVJPLGgs1",
+	"cVqXKdv+MpmeubSsfgEMk+lZsKilrfIJYEgL+zY+vglAkvDS7cp085Q+pEFsIsC4lX53tH/4/sP+4f7h",
+	"YHBwdGzabdPi8TjsLPtNyBPCiSsOQHz1AkUP1aFuEeIqOzmZjM+9phKRB70u0zoSgE8fmdCmhZ1FYpAr",
+	"IShfm1qbdWZy9/eLUkN2eqyWQ9sXMYfJMoNX8JneLnOyUg2gZREjFvkrrJ3C7Cp9D+XwpmrhE6fYsSZ3",
+	"YR61npbb7QolY41l9KwAyKPoMcpHzwV2TYiY8ydPpSBvviDAMF5iNpg5dcnfg+BD8L/Y//oWdPXKm6Km",
+	"rGZNcW9XH7wqxdoWsq1SseIO5WnFYI3L8jeCO1TuBAPoHoUpDyUIt8vcxWZ8UiUuvmzmXxuYVVzjufqs",
+	"k+fAOmfJX5eIBzSYRRtaTcE5fG5bLPvsXGzNL1r+GjyxEkc5Ev1A/rJAGG6kZPRKJapa+GL1mr/MTQe7",
+	"saaQBQKUi6VujkHev1Xp1NV3znfW2qlmAkqjYKpR7FRg1Nh1zeQ/JaOaElrfMFxFVdsOnPUr6ClU+fvj",
+	"1M+6DdfQMwHaRhG9pj9RMzspRouVnlkW4Fm71OjOx243wN4mIe6TjaqweXnCN6stLEI+lAG0HVvGq6XF",
+	"2LdMbmVuBltM3TIJMBNLpT0Ls6tDf/7u/aDTehejBMb22WAeI1EpXDpVi8SsWimsPqLvB5eT72/Ys7NE",
+	"C7GmlKnFZMs+GwjLFmX9AQUfnT517Gtwr8ptdaLvQ5e9Tq3KUY+Enc4yPt2BMYEckCTMXF9gRFGMsjID",
+	"DqhnEOKRckDkwiHLBb+iiuJGWOynCrsXo+/Phn8Po5DPUMWu/tYPv/zMBS0nLv/Wjtnqe9voJ16A9fp8",
+	"5Ze22iHckQE4lwTpprx4BKH05W7ycdC1RWEIklYXDdag4afRmN8oGllTrS0GZB+njffm2/6lNsxedljp",
+	"ef01M4uVMtYzbsOQl2ED4vGfYXW/I/T43aCHEfxDBeQ07wBZgsrfOgRk3MG5BK7x4sHex0QhMLWs/c7Q",
+	"aW/o/8Yjl1Dh3oDY1x4b0KGpbPGFhO0c8JAh0JXjSaaT1geNsWka4GtZr/K3izwuTiv3SHXSuI9E9d7Z",
+	"K6u3Uk8FGuXaVpFjG5baYV1fUSyB7uG7rVHVoiUe9jC0UFS0Wb0oMgOKm99fCIWLcf6AmiiIi+WdPYM8",
+	"w8Lw5i5Yss+ahnwotg3KlAFgBtuyqR2JtOQsEWS3dVwli5SG8WxcuN2/M9M4WwGuU1uFC4RfWhYtGqy3",
+	"7ncivXrvdXNt/pID0HZvkCA2gLv82AbUMb9V8sug40pZsRnoUb2yaFosoYyAUclhVdRX16oBK9FWMo5N",
+	"JHSW9TSDn+T+a9Xw0sxU8AA3/yFeNNgW6ya12r+fXV5U9R3+i2+4mwLOvfNQGV3jiI2RLjgcuQEvZgaw",
+	"eAGASZm8TsQVCw//BxHW5rtFGVWZu2+yq+aM77RhcHarZaoBRNjrZNf1y0Bq+EXUa6eRwUSNMzphipgR",
+	"37E9iZyYaR7Ei/Ls49dv5RfHjXQ8zGngCIM+POnrnPzuqL4BeQbRcNVXZ9NUiRdXKF3rNoJWahWXwVxG",
+	"JLhRvVvE59sq6J6j+NuqXpvSmFUjYAcDOJ03/9P5oIev6Qp8YSO6MaNpFuvDBK3ENnY5C5xzUGgAMUiz",
+	"qHzNYFzLLccZQgXj3weUsZLU2n4tHKbkstTd//r6JozCi/HV6KyWQ05+8jtp7vhWVp43DnbdoeNGwbi1",
+	"U6dj+7cxQRWL/xZyf/Tmcte4z/jvuHcFgZiWJnaN/HrwEohp9hKgnAPNHx8C8WAknh90AjyR167+Ft32",
+	"MsxgTJjh72RPlOPyfCreD87lWAiTIEu/weDX/0lAmr38ykH79X+E8e9w/isXKpARFJBlIXTQfedLc3uO",
+	"pm28LP9t9Sfgzb5+9uC4Db8wMmJy4xK3ow3ccWN9ZNOS0XvVp8ionuHxYUmXGK5Q4GP9TFGOx0HFaTYx",
+	"/0nYzC0PKss0S87li0rjjJsho2Pj66PzWw1g1TAypjMHt0H8M0ipM7i9Iz2Q+t4a4TfweOEzJnLB2Gb9",
+	"+Tl9SF0vWaCzYNyZUS+OUNBp+tKufPVV8Gs2G6G5hle+8zwgOx5vRT3Hs5sxdy+MobxQi00nvBxPwyhc",
+	"4iw8DeeUFuT04AAVMCdoiWO4j/DsQHYiB6wtY/2U8r2nMrLmo3Cwf7g/YO3YMKBIw9Pw3f5gfyAz13LE",
+	"HeiYlNPfw5mteBWzPPI6HLolH1CQcpzIFsPyYwEwWEAKMXHmByibHNyAGeSZATzaTdJ/ibZVCCcIU3NX",
+	"JGo7nKWPMA/4Mbgf3BEY/Lr3K0/XwTqkecCGkcEsfEORjaKy0f1LsFhmNC0yKMYh+8FIMP1p8Oue3H6/",
+	"AhqJTHO/BmdSZxatT/+RB8FewPhE/Es0k//mlBX/Vhu8+KscV/wtL/r6b52vjv/Ct63wlEUB8XNHMhSR",
+	"pl7B0tZ9pY7JT2lGIW7BpQAfkgqmHkQvE1dluxJbP96N7kbn0c3t9XA0mYyvvi+R9QiyJVTIEu3Ev8vG",
+	"4u/J3XA4Gp2rz5/Oxhfq3yKgbnTuxoeEqRUlX6IQS2MXF4mjwUCGC1GZfNbIrH3wTxn4WI7ncQpVnT74",
+	"plGlwpk22muRe43C4w1CUq2tawHhI0gCfcFhO+ZysQD4xbEdiFtZWdGDJ6YprOV/hpyzy9DJxmYiGgz1",
+	"Vyyg+IiSl80RwpyjXGZlo6d4CV8bzHC4aWZoI4L2LC1DB3eIESyUtPDBa1QeMQcFRjHkqbmcp833kFZT",
+	"H7CQIxZHK1IeZy/BPWT7tRwKNhnoe0hlfuQbPZ3JTtsV7k56mnQ8fjs6XiEzHYYbm1UaM2ro2owamytR",
+	"/CBmd+dMvLJadwb+XRC/bcradsF7+RP82JmMWFc+EoDCt5e16RxiKAzHGqB2+kicbYpEBUYzDAnplE52",
+	"xQ1U6wBD+RAlVQURTV1mWN6KLN8oWLcv02qqLtnWCKmu+08k69S2inWY6zcVkOdkpyfD0qOgrqWKYLl0",
+	"kPw3T7PDEzC8WLmlEdu3ffYwJmvf9OW6iGza2GgtrTwxfFDoHGTWvZUn82iiOKr8VGMPfckgwf2Ssj2J",
+	"FbkwGEsm0I4C+BzDonYDUz6Iqi4DD5qTxS1kgmGjpITI4KQX/qIoH4AYI77D8Mkq5a4DmeVrv8EHjdQl",
+	"W1IjnSlSvFTJXeHDtz/kAkkMRsQ05zUja+Lg4FdvcRCs4ZaHW/7dJhC1TB3c1C0ZXacbqnKbGKvBbru/",
+	"57iQ4IHl33UekleB3AzavMjP+e9Gnpz7l2B83sCgaCZX9vFFJDepGpH4fV6WW5LXeTMVSlXWzPu9JRb2",
+	"vFcmGptJwEOJFCj5Q3RIc49Oc7Whc2dV9mIAclk+ooSxwhZOollv+c5zvYvo5WH9Z6D4f8w9sc7HjFUe",
+	"0DK3aYteLOLaN3zvg2ZV2pTKegRGWid1UJjqqegJ80QU06hnK+y6dYiZKwnR/+LPOo/IS/IfZpiqbXSM",
+	"T8XZvbMioxm6qmP0FBuVgd8uNCy7W4fI6BKMGOW8SprQ0+Nvigl5K6GRu56ddQ011p+Z8HGZKq/eR0w6",
+	"59nPcUBBysN2cwgTm+7eKEWwg5K3+ZuEswDDH3OT6OJ2xoN/Sb6/5EupXEvueec9MxFiq4XOzInYvh90",
+	"mVFuyjSD/yFHoD3jpYP+YntzpKD8Sza8FElqxaPXyxJz0SAHcYaWSfebEmsViD5LndapyfysmXT+2OZe",
+	"a0zjwp8F4N15AGxHa0kx9rt4EbbFrAqXVW/6iOZ1Em3hibhOnTc8hLsZQ/qD7DiDdJK2wSMVmZbC7/tS",
+	"3C3XouEbSHZloo69ceel24HeVeTbi1JSwhvE2oKMN+n05qq2p5zvOLN4ELlV1ucAJyxJRaewq4bd0v5Z",
+	"tty+uNdmcpDSAfnuCbwTxStIvCe5RA8LxTYv8zZivZ3Q+7GKkvqdZxkfSrfLPaVFp8x/nk5vPOR9Or15",
+	"A1kvZ3EQzwLt7sm4FaUryLcHaaRsV6mzBbmuEeYNZbqTJZQ87zRrdFG1VY4z1O3RmaFZtxRfoNn2hbic",
+	"xEGwJqi7J8I2dK4gwd1UEY2rhNm8/NZo8nbi28kMSnp3mSk6CNoquywldqfwqrzZ7dJrRGhtkWLGLA6S",
+	"WaDdPQG2onQFCfYgjWhdo87mZbhKmNcdYwFuhVbCTJZxDAl5WGbZy27KsR97MEHmNVr2YpTAdsdtFrQj",
+	"67nwthYB5qAP5de1qOeVTUFP50xGa8He9Q87JsxNvCoymZQRtJpDkNF5K5n4bYo3M/LNPUJso9dnMdw2",
+	"tVs+Qxtydo4eLQhUhBGfJU0KiNNiDjHIyIHIkucRDAseQcqzZtcT6zVDY89U0zKd3lajFhxJA3eddAK1",
+	"LrQqyhnEkuTj2Vz2hINr51uBjGXgrRV38AFs0lVWd9omuSw1pP4MUsax1ohSMIkhyFPmA++UqbKpTYwm",
+	"xtdth5hv07vAnvu9NQy4RMwOxgGbVFNsUP7mEQmss4MgbMlLI6LGYM79A0hn4PCkzM2yDRXXnuDnjUOH",
+	"myn822OHFSp3MXjYyKVjY57KDnLwu/qnb9yBZqK2wAOFTm8/9BKKlb2c/Ora9Ig9KEug1IMP3tjnpwKI",
+	"y+nHTSD7DtISZdBB4u8h/XPRd/Dm20R1e9hFdnFQ2nHaLK0BX0UGYo8tQdzAd55ldupke3uW1WacnTjZ",
+	"dlVspDXJU3LcR+0By/K4h5d5hw5PnVX9Kue8W7VX9QR3TeSi9uru5VIp4jq7IwFRjJY5DavAiRIQpydG",
+	"5rulKK3hXXPobU6RRq1Hp2jWGWB3BURzbaXWXJNjneJCAYXkgJfi3iNPKY3nHqkzFikNRGN9fW6+g7JW",
+	"E95o6waIxlyuV9Em5Dv4LGpDr6afaZfgVQEOVLq+VprpCgJCRh2vX0Z1im3KYzmLi/Wb0O4enawo1XTi",
+	"H6uEwvAeIdoW0s++G2PvWyL1WZOJKq7RfbG6QsFQ4mt3MNhYaAfiCEXFHlxAPIN5/OJGICu+wg06C8RT",
+	"AMtgcR6ymGVsd8zTfKYzJLDPzZQwTdMdG3akZ//TYn1j2LGSipeN2FuAwseqkWUi2blKd21U/7CZN1Q2",
+	"cO9cWNUaFq1JAurzq8WV62m9vetc7TDRafqJmZ3ets2617M5RmnUPXFsteXa7btcN25ab6t8CJn2nbOU",
+	"P+2rmeC3Zg21Je1/4zujL63UndGg2Q7t6QbBO3imsl8ciCJATg1mxD9Xxw0ACUDACgQxizurB8SLCzVY",
+	"SPQ1WMh2J6tdceQlpu1C5kNLoxhS39sNiilkL1AYgkWVdvqCdZ/mwFKeukvGBZZ2h2lstPXimXSheMal",
+	"Slm4MXhK6dzGN2UdKp3+j8Ol0m80+Gq82CG+8tkU12Sp3dsKBQPs6F4o2GNltt4jMchX4m1q1BAiARsm",
+	"F0wNhLsYTGASTIZnV18vrodn0/H1ldLrIl5cLga50GY6OP4TRosJA3I7h7J9sh0/nHeNI3c0WN4mG6LS",
+	"FdCsyxnRS2BEC5ekjHMCMQ2AqGaWy+wubo1TPOmaRee2mbzbVojpbV/h/x30zrMkkQS2kNePgQ5+V4zX",
+	"+iR/CxciCQqbTNdm8727Cm7qfhMwyuT1eRHQouOoIOWoGrhdw/ua/PXGuxcjq9eLv6wyt8bt2HCSrpT/",
+	"EimmyxJlsnwZN+YLirZdkXeaxbZ9de+7i/7H3953UML0a28PCWPbuVF3yu1mUy4tkO27XkN+0lWqtsY7",
+	"aoo/RZBAJwYVfR5VGS8+h3BdF7uRqA11AIr04PEwfP3y+v8GAOMncLdvTQEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,196 @@
+package http
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tbe-team/raybot/internal/handlers/http/gen"
+	"github.com/tbe-team/raybot/internal/services/schedule"
+	"github.com/tbe-team/raybot/pkg/paging"
+	"github.com/tbe-team/raybot/pkg/xerror"
+)
+
+const defaultScheduleNextRunsCount = 5
+
+type scheduleHandler struct {
+	scheduleService schedule.Service
+}
+
+func newScheduleHandler(scheduleService schedule.Service) *scheduleHandler {
+	return &scheduleHandler{
+		scheduleService: scheduleService,
+	}
+}
+
+//nolint:revive
+func (h scheduleHandler) GetScheduleById(ctx context.Context, req gen.GetScheduleByIdRequestObject) (gen.GetScheduleByIdResponseObject, error) {
+	s, err := h.scheduleService.GetScheduleByID(ctx, schedule.GetScheduleByIDParams{
+		ScheduleID: int64(req.ScheduleId),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get schedule by id: %w", err)
+	}
+
+	res, err := h.convertScheduleToResponse(s)
+	if err != nil {
+		return nil, fmt.Errorf("convert schedule to response: %w", err)
+	}
+
+	return gen.GetScheduleById200JSONResponse(res), nil
+}
+
+func (h scheduleHandler) ListSchedules(ctx context.Context, req gen.ListSchedulesRequestObject) (gen.ListSchedulesResponseObject, error) {
+	page := uint(1)
+	pageSize := uint(10)
+	if req.Params.Page != nil {
+		page = *req.Params.Page
+	}
+	if req.Params.PageSize != nil {
+		pageSize = *req.Params.PageSize
+	}
+
+	schedules, err := h.scheduleService.ListSchedules(ctx, schedule.ListSchedulesParams{
+		PagingParams: paging.NewParams(paging.Page(page), paging.PageSize(pageSize)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list schedules: %w", err)
+	}
+
+	res := make([]gen.ScheduleResponse, len(schedules.Items))
+	for i, s := range schedules.Items {
+		r, err := h.convertScheduleToResponse(s)
+		if err != nil {
+			return nil, fmt.Errorf("convert schedule to response: %w", err)
+		}
+		res[i] = r
+	}
+
+	return gen.ListSchedules200JSONResponse{
+		TotalItems: int(schedules.TotalItems),
+		Items:      res,
+	}, nil
+}
+
+func (h scheduleHandler) CreateSchedule(ctx context.Context, req gen.CreateScheduleRequestObject) (gen.CreateScheduleResponseObject, error) {
+	inputs, err := commandHandler{}.convertReqInputsToCommandInputs(req.Body.Type, req.Body.Inputs)
+	if err != nil {
+		return nil, xerror.ValidationFailed(err, "invalid inputs")
+	}
+
+	var priority int64
+	if req.Body.Priority != nil {
+		priority = *req.Body.Priority
+	}
+
+	s, err := h.scheduleService.CreateSchedule(ctx, schedule.CreateScheduleParams{
+		Name:     req.Body.Name,
+		Inputs:   inputs,
+		Priority: priority,
+		RunAt:    req.Body.RunAt,
+		CronExpr: req.Body.CronExpr,
+		Enabled:  req.Body.Enabled,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create schedule: %w", err)
+	}
+
+	res, err := h.convertScheduleToResponse(s)
+	if err != nil {
+		return nil, fmt.Errorf("convert schedule to response: %w", err)
+	}
+
+	return gen.CreateSchedule201JSONResponse(res), nil
+}
+
+//nolint:revive
+func (h scheduleHandler) UpdateScheduleById(ctx context.Context, req gen.UpdateScheduleByIdRequestObject) (gen.UpdateScheduleByIdResponseObject, error) {
+	inputs, err := commandHandler{}.convertReqInputsToCommandInputs(req.Body.Type, req.Body.Inputs)
+	if err != nil {
+		return nil, xerror.ValidationFailed(err, "invalid inputs")
+	}
+
+	var priority int64
+	if req.Body.Priority != nil {
+		priority = *req.Body.Priority
+	}
+
+	s, err := h.scheduleService.UpdateSchedule(ctx, schedule.UpdateScheduleParams{
+		ScheduleID: int64(req.ScheduleId),
+		Name:       req.Body.Name,
+		Inputs:     inputs,
+		Priority:   priority,
+		RunAt:      req.Body.RunAt,
+		CronExpr:   req.Body.CronExpr,
+		Enabled:    req.Body.Enabled,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("update schedule: %w", err)
+	}
+
+	res, err := h.convertScheduleToResponse(s)
+	if err != nil {
+		return nil, fmt.Errorf("convert schedule to response: %w", err)
+	}
+
+	return gen.UpdateScheduleById200JSONResponse(res), nil
+}
+
+//nolint:revive
+func (h scheduleHandler) DeleteScheduleById(ctx context.Context, req gen.DeleteScheduleByIdRequestObject) (gen.DeleteScheduleByIdResponseObject, error) {
+	err := h.scheduleService.DeleteScheduleByID(ctx, schedule.DeleteScheduleByIDParams{
+		ScheduleID: int64(req.ScheduleId),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("delete schedule by id: %w", err)
+	}
+
+	return gen.DeleteScheduleById204Response{}, nil
+}
+
+func (h scheduleHandler) ListScheduleNextRuns(ctx context.Context, req gen.ListScheduleNextRunsRequestObject) (gen.ListScheduleNextRunsResponseObject, error) {
+	count := uint(defaultScheduleNextRunsCount)
+	if req.Params.Count != nil {
+		count = *req.Params.Count
+	}
+
+	runTimes, err := h.scheduleService.ListNextRunTimes(ctx, schedule.ListNextRunTimesParams{
+		ScheduleID: int64(req.ScheduleId),
+		Count:      count,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list next run times: %w", err)
+	}
+
+	return gen.ListScheduleNextRuns200JSONResponse{
+		Items: runTimes,
+	}, nil
+}
+
+func (scheduleHandler) convertScheduleToResponse(s schedule.Schedule) (gen.ScheduleResponse, error) {
+	inputs, err := commandHandler{}.convertInputsToResponse(s.Inputs)
+	if err != nil {
+		return gen.ScheduleResponse{}, fmt.Errorf("convert inputs to response: %w", err)
+	}
+
+	var lastCommandID *int
+	if s.LastCommandID != nil {
+		id := int(*s.LastCommandID)
+		lastCommandID = &id
+	}
+
+	return gen.ScheduleResponse{
+		Id:            int(s.ID),
+		Name:          s.Name,
+		Type:          s.CommandType().String(),
+		Inputs:        inputs,
+		Priority:      s.Priority,
+		RunAt:         s.RunAt,
+		CronExpr:      s.CronExpr,
+		Enabled:       s.Enabled,
+		NextRunAt:     s.NextRunAt,
+		LastRunAt:     s.LastRunAt,
+		LastCommandId: lastCommandID,
+		CreatedAt:     s.CreatedAt,
+		UpdatedAt:     s.UpdatedAt,
+	}, nil
+}
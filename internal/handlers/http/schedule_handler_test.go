@@ -0,0 +1,240 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tbe-team/raybot/internal/handlers/http/gen"
+	"github.com/tbe-team/raybot/internal/services/command"
+	"github.com/tbe-team/raybot/internal/services/schedule"
+	schedulemocks "github.com/tbe-team/raybot/internal/services/schedule/mocks"
+	"github.com/tbe-team/raybot/pkg/paging"
+	"github.com/tbe-team/raybot/pkg/ptr"
+)
+
+func TestScheduleHandler_GetScheduleById(t *testing.T) {
+	t.Run("Should get successfully", func(t *testing.T) {
+		scheduleService := schedulemocks.NewFakeService(t)
+		scheduleService.EXPECT().GetScheduleByID(mock.Anything, schedule.GetScheduleByIDParams{
+			ScheduleID: 12,
+		}).Return(validSchedule, nil)
+
+		h := SetupAPITestHandler(t, func(hs *Service) {
+			hs.scheduleService = scheduleService
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/schedules/12", nil)
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		res := MustDecodeJSON[gen.ScheduleResponse](t, rec.Body)
+		require.Equal(t, "CARGO_LIFT", res.Type)
+		require.Equal(t, validSchedule.CronExpr, res.CronExpr)
+	})
+
+	t.Run("Should return not found if schedule does not exist", func(t *testing.T) {
+		scheduleService := schedulemocks.NewFakeService(t)
+		scheduleService.EXPECT().GetScheduleByID(mock.Anything, mock.Anything).
+			Return(schedule.Schedule{}, schedule.ErrScheduleNotFound)
+
+		h := SetupAPITestHandler(t, func(hs *Service) {
+			hs.scheduleService = scheduleService
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/schedules/12", nil)
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+func TestScheduleHandler_ListSchedules(t *testing.T) {
+	scheduleService := schedulemocks.NewFakeService(t)
+	scheduleService.EXPECT().ListSchedules(mock.Anything,
+		mock.MatchedBy(
+			func(params schedule.ListSchedulesParams) bool {
+				return params.PagingParams.Page == 2 &&
+					params.PagingParams.PageSize == 5
+			},
+		),
+	).Return(paging.List[schedule.Schedule]{
+		Items:      []schedule.Schedule{validSchedule},
+		TotalItems: 1,
+	}, nil)
+
+	h := SetupAPITestHandler(t, func(hs *Service) {
+		hs.scheduleService = scheduleService
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/schedules?page=2&pageSize=5", nil)
+	rec := httptest.NewRecorder()
+
+	h.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	res := MustDecodeJSON[gen.SchedulesListResponse](t, rec.Body)
+	require.Equal(t, 1, res.TotalItems)
+	require.Len(t, res.Items, 1)
+}
+
+func TestScheduleHandler_CreateSchedule(t *testing.T) {
+	t.Run("Should create schedule successfully", func(t *testing.T) {
+		scheduleService := schedulemocks.NewFakeService(t)
+		scheduleService.EXPECT().CreateSchedule(mock.Anything,
+			mock.MatchedBy(
+				func(params schedule.CreateScheduleParams) bool {
+					i, ok := params.Inputs.(*command.CargoLiftInputs)
+					return ok &&
+						i.Position == 12 &&
+						params.Name == "lift" &&
+						params.Priority == 5 &&
+						params.CronExpr != nil && *params.CronExpr == "0 8 * * *" &&
+						params.RunAt == nil &&
+						params.Enabled
+				},
+			),
+		).Return(validSchedule, nil)
+
+		h := SetupAPITestHandler(t, func(hs *Service) {
+			hs.scheduleService = scheduleService
+		})
+
+		i := gen.CommandInputs{}
+		err := i.FromCargoLiftInputs(gen.CargoLiftInputs{
+			Position:   12,
+			MotorSpeed: 12,
+		})
+		require.NoError(t, err)
+
+		body := gen.UpsertScheduleRequest{
+			Name:     "lift",
+			Type:     "CARGO_LIFT",
+			Inputs:   i,
+			Priority: ptr.New(int64(5)),
+			CronExpr: ptr.New("0 8 * * *"),
+			Enabled:  true,
+		}
+		jsonBody, err := json.Marshal(body)
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/schedules", bytes.NewBuffer(jsonBody))
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusCreated, rec.Code)
+	})
+
+	t.Run("Should not able to create schedule if cron expression is invalid", func(t *testing.T) {
+		scheduleService := schedulemocks.NewFakeService(t)
+		scheduleService.EXPECT().CreateSchedule(mock.Anything, mock.Anything).
+			Return(schedule.Schedule{}, schedule.ErrInvalidCronExpression)
+
+		h := SetupAPITestHandler(t, func(hs *Service) {
+			hs.scheduleService = scheduleService
+		})
+
+		i := gen.CommandInputs{}
+		err := i.FromStopInputs(gen.StopInputs{})
+		require.NoError(t, err)
+
+		body := gen.UpsertScheduleRequest{
+			Name:     "stop",
+			Type:     "STOP_MOVEMENT",
+			Inputs:   i,
+			CronExpr: ptr.New("invalid"),
+			Enabled:  true,
+		}
+		jsonBody, err := json.Marshal(body)
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/schedules", bytes.NewBuffer(jsonBody))
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestScheduleHandler_DeleteScheduleById(t *testing.T) {
+	scheduleService := schedulemocks.NewFakeService(t)
+	scheduleService.EXPECT().DeleteScheduleByID(mock.Anything, schedule.DeleteScheduleByIDParams{
+		ScheduleID: 12,
+	}).Return(nil)
+
+	h := SetupAPITestHandler(t, func(hs *Service) {
+		hs.scheduleService = scheduleService
+	})
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/v1/schedules/12", nil)
+	rec := httptest.NewRecorder()
+
+	h.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+}
+
+func TestScheduleHandler_ListScheduleNextRuns(t *testing.T) {
+	t.Run("Should use default count", func(t *testing.T) {
+		now := time.Now()
+		scheduleService := schedulemocks.NewFakeService(t)
+		scheduleService.EXPECT().ListNextRunTimes(mock.Anything, schedule.ListNextRunTimesParams{
+			ScheduleID: 12,
+			Count:      defaultScheduleNextRunsCount,
+		}).Return([]time.Time{now, now.Add(time.Hour)}, nil)
+
+		h := SetupAPITestHandler(t, func(hs *Service) {
+			hs.scheduleService = scheduleService
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/schedules/12/next-runs", nil)
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		res := MustDecodeJSON[gen.ScheduleNextRunsResponse](t, rec.Body)
+		require.Len(t, res.Items, 2)
+	})
+
+	t.Run("Should use given count", func(t *testing.T) {
+		scheduleService := schedulemocks.NewFakeService(t)
+		scheduleService.EXPECT().ListNextRunTimes(mock.Anything, schedule.ListNextRunTimesParams{
+			ScheduleID: 12,
+			Count:      10,
+		}).Return([]time.Time{}, nil)
+
+		h := SetupAPITestHandler(t, func(hs *Service) {
+			hs.scheduleService = scheduleService
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/schedules/12/next-runs?count=10", nil)
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+	})
+}
+
+var validSchedule = schedule.Schedule{
+	ID:   12,
+	Name: "lift",
+	Inputs: &command.CargoLiftInputs{
+		Position:   12,
+		MotorSpeed: 12,
+	},
+	Priority:  5,
+	CronExpr:  ptr.New("0 8 * * *"),
+	Enabled:   true,
+	NextRunAt: ptr.New(time.Now().Add(time.Hour)),
+	CreatedAt: time.Now(),
+	UpdatedAt: time.Now(),
+}
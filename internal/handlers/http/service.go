@@ -19,7 +19,9 @@ import (
 	"github.com/tbe-team/raybot/internal/services/dashboarddata"
 	"github.com/tbe-team/raybot/internal/services/limitswitch"
 	"github.com/tbe-team/raybot/internal/services/peripheral"
+	"github.com/tbe-team/raybot/internal/services/schedule"
 	"github.com/tbe-team/raybot/internal/services/system"
+	"github.com/tbe-team/raybot/internal/services/trackmap"
 )
 
 type Service struct {
@@ -33,6 +35,8 @@ type Service struct {
 	commandService       command.Service
 	apperrorcodeService  apperrorcode.Service
 	limitSwitchService   limitswitch.Service
+	scheduleService      schedule.Service
+	trackMapService      trackmap.Service
 }
 
 type CleanupFunc func(ctx context.Context) error
@@ -47,6 +51,8 @@ func New(
 	commandService command.Service,
 	apperrorcodeService apperrorcode.Service,
 	limitSwitchService limitswitch.Service,
+	scheduleService schedule.Service,
+	trackMapService trackmap.Service,
 ) *Service {
 	return &Service{
 		cfg:                  cfg,
@@ -58,6 +64,8 @@ func New(
 		commandService:       commandService,
 		apperrorcodeService:  apperrorcodeService,
 		limitSwitchService:   limitSwitchService,
+		scheduleService:      scheduleService,
+		trackMapService:      trackMapService,
 	}
 }
 
@@ -132,6 +140,8 @@ type handler struct {
 	*peripheralHandler
 	*commandHandler
 	*stateHandler
+	*scheduleHandler
+	*trackMapHandler
 }
 
 func (s *Service) newHandler() *handler {
@@ -145,5 +155,7 @@ func (s *Service) newHandler() *handler {
 		peripheralHandler:    newPeripheralHandler(s.peripheralService),
 		commandHandler:       newCommandHandler(s.commandService),
 		stateHandler:         newStateHandler(s.limitSwitchService),
+		scheduleHandler:      newScheduleHandler(s.scheduleService),
+		trackMapHandler:      newTrackMapHandler(s.trackMapService),
 	}
 }
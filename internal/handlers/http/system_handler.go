@@ -41,12 +41,28 @@ func (h systemHandler) StopEmergency(ctx context.Context, _ gen.StopEmergencyReq
 	return gen.StopEmergency204Response{}, nil
 }
 
-func (systemHandler) convertSysInfoToResponse(info system.Info) gen.SystemInfo {
+func (h systemHandler) convertSysInfoToResponse(info system.Info) gen.SystemInfo {
 	return gen.SystemInfo{
 		LocalIp:     info.LocalIP,
 		CpuUsage:    float32(info.CPUUsage),
 		MemoryUsage: float32(info.MemoryUsage),
 		TotalMemory: float32(info.TotalMemory),
 		Uptime:      float32(info.Uptime.Seconds()),
+		PicLink:     h.convertLinkStatsToResponse(info.PICLink),
+		EspLink:     h.convertLinkStatsToResponse(info.ESPLink),
+	}
+}
+
+func (systemHandler) convertLinkStatsToResponse(stats system.LinkStats) gen.LinkStats {
+	return gen.LinkStats{
+		CommandsSent:      stats.CommandsSent,
+		CommandsAcked:     stats.CommandsAcked,
+		CommandsLost:      stats.CommandsLost,
+		Resends:           stats.Resends,
+		AvgAckLatencyMs:   float32(stats.AvgACKLatency.Seconds() * 1000),
+		MaxAckLatencyMs:   float32(stats.MaxACKLatency.Seconds() * 1000),
+		FramesReceived:    stats.FramesReceived,
+		FramesRejected:    stats.FramesRejected,
+		FrameSequenceGaps: stats.FrameSequenceGaps,
 	}
 }
@@ -0,0 +1,167 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/tbe-team/raybot/internal/handlers/http/gen"
+	"github.com/tbe-team/raybot/internal/services/trackmap"
+)
+
+// maxTrackMapFileSize is the maximum size of an imported track map file.
+const maxTrackMapFileSize = 1 << 20
+
+type trackMapHandler struct {
+	trackMapService trackmap.Service
+}
+
+func newTrackMapHandler(trackMapService trackmap.Service) *trackMapHandler {
+	return &trackMapHandler{
+		trackMapService: trackMapService,
+	}
+}
+
+func (h trackMapHandler) GetTrackMap(ctx context.Context, _ gen.GetTrackMapRequestObject) (gen.GetTrackMapResponseObject, error) {
+	m, err := h.trackMapService.GetTrackMap(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get track map: %w", err)
+	}
+
+	return gen.GetTrackMap200JSONResponse(h.convertTrackMapToResponse(m)), nil
+}
+
+func (h trackMapHandler) UpdateTrackMap(ctx context.Context, req gen.UpdateTrackMapRequestObject) (gen.UpdateTrackMapResponseObject, error) {
+	tags := make([]trackmap.TagParams, len(req.Body.Tags))
+	for i, t := range req.Body.Tags {
+		tags[i] = h.convertReqTagToParams(t.Location, t.Name, t.DistanceToNext)
+	}
+
+	m, err := h.trackMapService.UpdateTrackMap(ctx, trackmap.UpdateTrackMapParams{
+		Topology: trackmap.Topology(req.Body.Topology),
+		Tags:     tags,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("update track map: %w", err)
+	}
+
+	return gen.UpdateTrackMap200JSONResponse(h.convertTrackMapToResponse(m)), nil
+}
+
+func (h trackMapHandler) DeleteTrackMap(ctx context.Context, _ gen.DeleteTrackMapRequestObject) (gen.DeleteTrackMapResponseObject, error) {
+	if err := h.trackMapService.DeleteTrackMap(ctx); err != nil {
+		return nil, fmt.Errorf("delete track map: %w", err)
+	}
+
+	return gen.DeleteTrackMap204Response{}, nil
+}
+
+func (h trackMapHandler) CreateTrackMapTag(ctx context.Context, req gen.CreateTrackMapTagRequestObject) (gen.CreateTrackMapTagResponseObject, error) {
+	m, err := h.trackMapService.CreateTag(ctx, trackmap.CreateTagParams{
+		Tag:      h.convertReqTagToParams(req.Body.Location, req.Body.Name, req.Body.DistanceToNext),
+		Position: req.Body.Position,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create tag: %w", err)
+	}
+
+	return gen.CreateTrackMapTag201JSONResponse(h.convertTrackMapToResponse(m)), nil
+}
+
+func (h trackMapHandler) UpdateTrackMapTag(ctx context.Context, req gen.UpdateTrackMapTagRequestObject) (gen.UpdateTrackMapTagResponseObject, error) {
+	m, err := h.trackMapService.UpdateTag(ctx, trackmap.UpdateTagParams{
+		Location:       req.Location,
+		Name:           req.Body.Name,
+		DistanceToNext: req.Body.DistanceToNext,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("update tag: %w", err)
+	}
+
+	return gen.UpdateTrackMapTag200JSONResponse(h.convertTrackMapToResponse(m)), nil
+}
+
+func (h trackMapHandler) DeleteTrackMapTag(ctx context.Context, req gen.DeleteTrackMapTagRequestObject) (gen.DeleteTrackMapTagResponseObject, error) {
+	m, err := h.trackMapService.DeleteTag(ctx, trackmap.DeleteTagParams{
+		Location: req.Location,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("delete tag: %w", err)
+	}
+
+	return gen.DeleteTrackMapTag200JSONResponse(h.convertTrackMapToResponse(m)), nil
+}
+
+func (h trackMapHandler) ExportTrackMap(ctx context.Context, req gen.ExportTrackMapRequestObject) (gen.ExportTrackMapResponseObject, error) {
+	data, err := h.trackMapService.ExportTrackMap(ctx, trackmap.ExportTrackMapParams{
+		Format: trackmap.Format(req.Params.Format),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("export track map: %w", err)
+	}
+
+	return gen.ExportTrackMap200ApplicationoctetStreamResponse{
+		Body:          bytes.NewReader(data),
+		ContentLength: int64(len(data)),
+	}, nil
+}
+
+func (h trackMapHandler) ImportTrackMap(ctx context.Context, req gen.ImportTrackMapRequestObject) (gen.ImportTrackMapResponseObject, error) {
+	data, err := io.ReadAll(io.LimitReader(req.Body, maxTrackMapFileSize))
+	if err != nil {
+		return nil, fmt.Errorf("read body: %w", err)
+	}
+
+	m, err := h.trackMapService.ImportTrackMap(ctx, trackmap.ImportTrackMapParams{
+		Format: trackmap.Format(req.Params.Format),
+		Data:   data,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("import track map: %w", err)
+	}
+
+	return gen.ImportTrackMap200JSONResponse(h.convertTrackMapToResponse(m)), nil
+}
+
+func (h trackMapHandler) ImportTrackMapFromScan(ctx context.Context, req gen.ImportTrackMapFromScanRequestObject) (gen.ImportTrackMapFromScanResponseObject, error) {
+	m, err := h.trackMapService.ImportFromScanLocation(ctx, trackmap.ImportFromScanLocationParams{
+		CommandID: int64(req.Body.CommandId),
+		Topology:  trackmap.Topology(req.Body.Topology),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("import track map from scan: %w", err)
+	}
+
+	return gen.ImportTrackMapFromScan200JSONResponse(h.convertTrackMapToResponse(m)), nil
+}
+
+func (trackMapHandler) convertReqTagToParams(location string, name *string, distanceToNext *uint32) trackmap.TagParams {
+	params := trackmap.TagParams{
+		Location: location,
+	}
+	if name != nil {
+		params.Name = *name
+	}
+	if distanceToNext != nil {
+		params.DistanceToNext = *distanceToNext
+	}
+	return params
+}
+
+func (trackMapHandler) convertTrackMapToResponse(m trackmap.TrackMap) gen.TrackMapResponse {
+	tags := make([]gen.TrackMapTag, len(m.Tags))
+	for i, t := range m.Tags {
+		tags[i] = gen.TrackMapTag{
+			Location:       t.Location,
+			Name:           t.Name,
+			DistanceToNext: t.DistanceToNext,
+		}
+	}
+
+	return gen.TrackMapResponse{
+		Topology:  m.Topology.String(),
+		Tags:      tags,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
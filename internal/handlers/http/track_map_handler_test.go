@@ -0,0 +1,190 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tbe-team/raybot/internal/handlers/http/gen"
+	"github.com/tbe-team/raybot/internal/services/trackmap"
+	trackmapmocks "github.com/tbe-team/raybot/internal/services/trackmap/mocks"
+	"github.com/tbe-team/raybot/pkg/ptr"
+)
+
+var validTrackMap = trackmap.TrackMap{
+	Topology: trackmap.TopologyLoop,
+	Tags: []trackmap.Tag{
+		{Location: "A", Name: "dock", DistanceToNext: 120},
+		{Location: "B", DistanceToNext: 80},
+	},
+	UpdatedAt: time.Now(),
+}
+
+func TestTrackMapHandler_GetTrackMap(t *testing.T) {
+	trackMapService := trackmapmocks.NewFakeService(t)
+	trackMapService.EXPECT().GetTrackMap(mock.Anything).Return(validTrackMap, nil)
+
+	h := SetupAPITestHandler(t, func(hs *Service) {
+		hs.trackMapService = trackMapService
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/track-map", nil)
+	rec := httptest.NewRecorder()
+
+	h.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	res := MustDecodeJSON[gen.TrackMapResponse](t, rec.Body)
+	require.Equal(t, "LOOP", res.Topology)
+	require.Len(t, res.Tags, 2)
+	require.Equal(t, "dock", res.Tags[0].Name)
+	require.Equal(t, uint32(120), res.Tags[0].DistanceToNext)
+}
+
+func TestTrackMapHandler_UpdateTrackMap(t *testing.T) {
+	t.Run("Should update successfully", func(t *testing.T) {
+		trackMapService := trackmapmocks.NewFakeService(t)
+		trackMapService.EXPECT().UpdateTrackMap(mock.Anything, trackmap.UpdateTrackMapParams{
+			Topology: trackmap.TopologyLoop,
+			Tags: []trackmap.TagParams{
+				{Location: "A", Name: "dock", DistanceToNext: 120},
+				{Location: "B"},
+			},
+		}).Return(validTrackMap, nil)
+
+		h := SetupAPITestHandler(t, func(hs *Service) {
+			hs.trackMapService = trackMapService
+		})
+
+		body, err := json.Marshal(gen.UpdateTrackMapRequest{
+			Topology: "LOOP",
+			Tags: []gen.TrackMapTagRequest{
+				{Location: "A", Name: ptr.New("dock"), DistanceToNext: ptr.New(uint32(120))},
+				{Location: "B"},
+			},
+		})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPut, "/api/v1/track-map", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("Should return bad request on duplicate tag", func(t *testing.T) {
+		trackMapService := trackmapmocks.NewFakeService(t)
+		trackMapService.EXPECT().UpdateTrackMap(mock.Anything, mock.Anything).
+			Return(trackmap.TrackMap{}, trackmap.ErrDuplicateTag)
+
+		h := SetupAPITestHandler(t, func(hs *Service) {
+			hs.trackMapService = trackMapService
+		})
+
+		body, err := json.Marshal(gen.UpdateTrackMapRequest{
+			Topology: "LOOP",
+			Tags:     []gen.TrackMapTagRequest{{Location: "A"}, {Location: "A"}},
+		})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPut, "/api/v1/track-map", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestTrackMapHandler_DeleteTrackMapTag(t *testing.T) {
+	trackMapService := trackmapmocks.NewFakeService(t)
+	trackMapService.EXPECT().DeleteTag(mock.Anything, trackmap.DeleteTagParams{Location: "C"}).
+		Return(trackmap.TrackMap{}, trackmap.ErrTagNotFound)
+
+	h := SetupAPITestHandler(t, func(hs *Service) {
+		hs.trackMapService = trackMapService
+	})
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/v1/track-map/tags/C", nil)
+	rec := httptest.NewRecorder()
+
+	h.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestTrackMapHandler_ExportImportTrackMap(t *testing.T) {
+	data := []byte("topology: LOOP\ntags:\n  - location: A\n    distance_to_next: 0\n")
+
+	t.Run("Should export file", func(t *testing.T) {
+		trackMapService := trackmapmocks.NewFakeService(t)
+		trackMapService.EXPECT().ExportTrackMap(mock.Anything, trackmap.ExportTrackMapParams{
+			Format: trackmap.FormatYAML,
+		}).Return(data, nil)
+
+		h := SetupAPITestHandler(t, func(hs *Service) {
+			hs.trackMapService = trackMapService
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/track-map/export?format=YAML", nil)
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		body, err := io.ReadAll(rec.Body)
+		require.NoError(t, err)
+		require.Equal(t, data, body)
+	})
+
+	t.Run("Should import file", func(t *testing.T) {
+		trackMapService := trackmapmocks.NewFakeService(t)
+		trackMapService.EXPECT().ImportTrackMap(mock.Anything, trackmap.ImportTrackMapParams{
+			Format: trackmap.FormatYAML,
+			Data:   data,
+		}).Return(validTrackMap, nil)
+
+		h := SetupAPITestHandler(t, func(hs *Service) {
+			hs.trackMapService = trackMapService
+		})
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/track-map/import?format=YAML", bytes.NewReader(data))
+		req.Header.Set("Content-Type", "application/octet-stream")
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+	})
+}
+
+func TestTrackMapHandler_ImportTrackMapFromScan(t *testing.T) {
+	trackMapService := trackmapmocks.NewFakeService(t)
+	trackMapService.EXPECT().ImportFromScanLocation(mock.Anything, trackmap.ImportFromScanLocationParams{
+		CommandID: 7,
+		Topology:  trackmap.TopologyLinear,
+	}).Return(validTrackMap, nil)
+
+	h := SetupAPITestHandler(t, func(hs *Service) {
+		hs.trackMapService = trackMapService
+	})
+
+	body, err := json.Marshal(gen.ImportTrackMapFromScanRequest{
+		CommandId: 7,
+		Topology:  "LINEAR",
+	})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/track-map/import-scan", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	h.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+}
@@ -0,0 +1,50 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/tbe-team/raybot/internal/services/schedule"
+)
+
+const (
+	runScheduleInterval = 1 * time.Second
+)
+
+type runScheduleHandler struct {
+	log             *slog.Logger
+	scheduleService schedule.Service
+}
+
+func newRunScheduleHandler(
+	log *slog.Logger,
+	scheduleService schedule.Service,
+) *runScheduleHandler {
+	return &runScheduleHandler{
+		log:             log.With("service", "run_schedule_handler"),
+		scheduleService: scheduleService,
+	}
+}
+
+func (h *runScheduleHandler) Run(ctx context.Context) func() {
+	ctx, cancel := context.WithCancel(ctx)
+
+	go h.run(ctx)
+
+	return cancel
+}
+
+func (h *runScheduleHandler) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-time.After(runScheduleInterval):
+			if err := h.scheduleService.RunDueSchedules(ctx); err != nil {
+				h.log.Error("failed to run due schedules", slog.Any("error", err))
+			}
+		}
+	}
+}
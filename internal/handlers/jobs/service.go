@@ -6,6 +6,7 @@ import (
 
 	"github.com/tbe-team/raybot/internal/config"
 	"github.com/tbe-team/raybot/internal/services/command"
+	"github.com/tbe-team/raybot/internal/services/schedule"
 	"github.com/tbe-team/raybot/pkg/eventbus"
 )
 
@@ -13,8 +14,9 @@ type Service struct {
 	cronCfg config.Cron
 	log     *slog.Logger
 
-	subscriber     eventbus.Subscriber
-	commandService command.Service
+	subscriber      eventbus.Subscriber
+	commandService  command.Service
+	scheduleService schedule.Service
 }
 
 type CleanupFunc func(context.Context) error
@@ -24,25 +26,30 @@ func New(
 	log *slog.Logger,
 	subscriber eventbus.Subscriber,
 	commandService command.Service,
+	scheduleService schedule.Service,
 ) *Service {
 	return &Service{
-		cronCfg:        cronCfg,
-		log:            log.With("service", "jobs"),
-		subscriber:     subscriber,
-		commandService: commandService,
+		cronCfg:         cronCfg,
+		log:             log.With("service", "jobs"),
+		subscriber:      subscriber,
+		commandService:  commandService,
+		scheduleService: scheduleService,
 	}
 }
 
 func (s *Service) Run(ctx context.Context) (CleanupFunc, error) {
 	deleteOldCommandHandler := newDeleteOldCommandHandler(s.cronCfg.DeleteOldCommand, s.log, s.commandService)
 	executeCommandHandler := newExecuteCommandHandler(s.log, s.commandService, s.subscriber)
+	runScheduleHandler := newRunScheduleHandler(s.log, s.scheduleService)
 
 	cancelDeleteOldCommand := deleteOldCommandHandler.Run(ctx)
 	cancelExecuteCommand := executeCommandHandler.Run(ctx)
+	cancelRunSchedule := runScheduleHandler.Run(ctx)
 
 	cleanup := func(_ context.Context) error {
 		cancelDeleteOldCommand()
 		cancelExecuteCommand()
+		cancelRunSchedule()
 
 		return nil
 	}
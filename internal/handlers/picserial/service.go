@@ -7,10 +7,12 @@ import (
 	"fmt"
 	"log/slog"
 	"strconv"
+	"time"
 
 	"github.com/tbe-team/raybot/internal/config"
 	"github.com/tbe-team/raybot/internal/events"
 	"github.com/tbe-team/raybot/internal/hardware/picserial"
+	"github.com/tbe-team/raybot/internal/hardware/serialcodec"
 	"github.com/tbe-team/raybot/internal/services/appstate"
 	"github.com/tbe-team/raybot/internal/services/battery"
 	"github.com/tbe-team/raybot/internal/services/distancesensor"
@@ -66,43 +68,111 @@ func New(
 }
 
 func (s *Service) Run(ctx context.Context) (CleanupFunc, error) {
-	if !s.client.Connected() {
-		return func(_ context.Context) error { return nil }, nil
-	}
-
 	ctx, cancel := context.WithCancel(ctx)
-	go s.readLoop(ctx)
+	doneCh := make(chan struct{})
+	go func() {
+		defer close(doneCh)
+		s.supervise(ctx)
+	}()
 
 	cleanup := func(_ context.Context) error {
-		// Cancel read loop before closing the serial client
+		// Stop the supervisor before closing the serial client
 		cancel()
+		<-doneCh
 		return nil
 	}
 
 	return cleanup, nil
 }
 
-func (s *Service) readLoop(ctx context.Context) {
+// supervise runs the read loop while the serial client is connected. When the link
+// is lost, or was not connected at start, it reopens the port and runs the read loop again.
+func (s *Service) supervise(ctx context.Context) {
+	var reconnectCount uint32
 	for {
-		select {
-		case <-ctx.Done():
-			return
-		default:
-			msg, err := s.client.Read(ctx)
-			if err != nil {
-				s.log.Error("failed to read from serial client", slog.Any("error", err))
-				if errors.Is(err, picserial.ErrPICSerialNotConnected) {
-					s.publisher.Publish(
-						events.PICSerialDisconnectedTopic,
-						eventbus.NewMessage(events.PICSerialDisconnectedEvent{
-							Error: err,
-						}),
-					)
-				}
+		if !s.client.Connected() {
+			if !s.reconnect(ctx) {
 				return
 			}
-			s.routeMessage(ctx, msg)
+
+			reconnectCount++
+			s.log.Info("pic serial reconnected", slog.Int("reconnect_count", int(reconnectCount)))
+			s.publisher.Publish(
+				events.PICSerialConnectedTopic,
+				eventbus.NewMessage(events.PICSerialConnectedEvent{
+					ReconnectCount: reconnectCount,
+				}),
+			)
+		}
+
+		err := s.readLoop(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+
+		s.log.Error("pic serial link lost", slog.Any("error", err))
+		if err := s.client.Close(); err != nil && !errors.Is(err, picserial.ErrPICSerialNotConnected) {
+			s.log.Error("failed to close serial client", slog.Any("error", err))
 		}
+		s.publisher.Publish(
+			events.PICSerialDisconnectedTopic,
+			eventbus.NewMessage(events.PICSerialDisconnectedEvent{
+				Error: err,
+			}),
+		)
+	}
+}
+
+// reconnect opens the serial client until it succeeds, doubling the wait after each
+// failed attempt. It returns false if the context is done before the client is opened.
+func (s *Service) reconnect(ctx context.Context) bool {
+	backoff := s.cfg.Serial.ReconnectMinBackoff
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(backoff):
+		}
+
+		err := s.client.Open()
+		if err == nil {
+			return true
+		}
+
+		backoff = min(backoff*2, s.cfg.Serial.ReconnectMaxBackoff)
+		s.log.Warn("failed to reopen serial client", slog.Any("error", err), slog.Duration("retry_in", backoff))
+
+		// The disconnected event keeps the connection error of the app state current.
+		s.publisher.Publish(
+			events.PICSerialDisconnectedTopic,
+			eventbus.NewMessage(events.PICSerialDisconnectedEvent{
+				Error: err,
+			}),
+		)
+	}
+}
+
+// readLoop routes the messages read from the serial client until a read fails.
+func (s *Service) readLoop(ctx context.Context) error {
+	rejected := s.client.FrameStats().Rejected
+	for {
+		msg, err := s.client.Read(ctx)
+		if errors.Is(err, serialcodec.ErrInvalidMessage) {
+			s.log.Error("failed to decode message", slog.Any("error", err))
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if stats := s.client.FrameStats(); stats.Rejected > rejected {
+			s.log.Warn("rejected corrupted frames",
+				slog.Uint64("count", stats.Rejected-rejected),
+				slog.Uint64("total", stats.Rejected))
+			rejected = stats.Rejected
+		}
+
+		s.routeMessage(ctx, msg)
 	}
 }
 
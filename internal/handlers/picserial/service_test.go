@@ -0,0 +1,139 @@
+package picserial
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tbe-team/raybot/internal/config"
+	"github.com/tbe-team/raybot/internal/events"
+	"github.com/tbe-team/raybot/internal/hardware/picserial"
+	"github.com/tbe-team/raybot/internal/hardware/serialframe"
+	"github.com/tbe-team/raybot/internal/logging"
+	"github.com/tbe-team/raybot/pkg/eventbus"
+	eventbusmocks "github.com/tbe-team/raybot/pkg/eventbus/mocks"
+)
+
+// fakeClient fails the first opens and reads with the given errors.
+type fakeClient struct {
+	mu        sync.Mutex
+	connected bool
+	openErrs  []error
+	readErrs  []error
+}
+
+func (c *fakeClient) Open() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.openErrs) > 0 {
+		err := c.openErrs[0]
+		c.openErrs = c.openErrs[1:]
+		return err
+	}
+	c.connected = true
+	return nil
+}
+
+func (c *fakeClient) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.connected {
+		return picserial.ErrPICSerialNotConnected
+	}
+	c.connected = false
+	return nil
+}
+
+func (c *fakeClient) Connected() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.connected
+}
+
+func (c *fakeClient) Write(_ context.Context, _ []byte) error {
+	return nil
+}
+
+func (c *fakeClient) FrameStats() serialframe.Stats {
+	return serialframe.Stats{}
+}
+
+func (c *fakeClient) Read(ctx context.Context) ([]byte, error) {
+	c.mu.Lock()
+	if len(c.readErrs) > 0 {
+		err := c.readErrs[0]
+		c.readErrs = c.readErrs[1:]
+		c.mu.Unlock()
+		return nil, err
+	}
+	c.mu.Unlock()
+
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestService_Run(t *testing.T) {
+	cfg := config.PIC{
+		Serial: config.Serial{
+			ReconnectMinBackoff: time.Millisecond,
+			ReconnectMaxBackoff: 4 * time.Millisecond,
+		},
+	}
+
+	t.Run("Should reconnect when not connected at start and after the link is lost", func(t *testing.T) {
+		client := &fakeClient{
+			openErrs: []error{errors.New("no such file"), errors.New("no such file")},
+			readErrs: []error{errors.New("input/output error")},
+		}
+
+		publisher := eventbusmocks.NewFakePublisher(t)
+		connectedCh := make(chan events.PICSerialConnectedEvent, 2)
+		publisher.EXPECT().Publish(events.PICSerialConnectedTopic, mock.Anything).Run(func(_ string, msg *eventbus.Message) {
+			connectedCh <- msg.Payload.(events.PICSerialConnectedEvent)
+		}).Times(2)
+		// Two failed opens and the lost link.
+		publisher.EXPECT().Publish(events.PICSerialDisconnectedTopic, mock.Anything).Times(3)
+
+		s := New(cfg, logging.NewNoopLogger(), client, publisher, nil, nil, nil, nil, nil, nil)
+		cleanup, err := s.Run(context.Background())
+		require.NoError(t, err)
+
+		for _, want := range []uint32{1, 2} {
+			select {
+			case ev := <-connectedCh:
+				require.Equal(t, want, ev.ReconnectCount)
+			case <-time.After(time.Second):
+				t.Fatal("timeout waiting for the connected event")
+			}
+		}
+
+		require.NoError(t, cleanup(context.Background()))
+		require.True(t, client.Connected())
+	})
+
+	t.Run("Should stop reconnecting when the context is done", func(t *testing.T) {
+		client := &fakeClient{}
+		for range 1000 {
+			client.openErrs = append(client.openErrs, errors.New("no such file"))
+		}
+
+		publisher := eventbusmocks.NewFakePublisher(t)
+		publisher.EXPECT().Publish(events.PICSerialDisconnectedTopic, mock.Anything).Maybe()
+
+		s := New(cfg, logging.NewNoopLogger(), client, publisher, nil, nil, nil, nil, nil, nil)
+		cleanup, err := s.Run(context.Background())
+		require.NoError(t, err)
+
+		time.Sleep(20 * time.Millisecond)
+		require.NoError(t, cleanup(context.Background()))
+		require.False(t, client.Connected())
+	})
+}
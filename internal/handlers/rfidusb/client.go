@@ -32,6 +32,13 @@ var hidKeyMap = map[byte]byte{
 	0x27: '0',
 }
 
+// reader reads the tags of an RFID reader.
+type reader interface {
+	Open() error
+	Read() (string, error)
+	Close() error
+}
+
 type client struct {
 	device *hid.Device
 }
@@ -0,0 +1,58 @@
+package rfidusb
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"go.bug.st/serial"
+)
+
+// serialClient reads the tags of a reader sending each tag as a line ending with CR LF.
+type serialClient struct {
+	portName string
+	port     serial.Port
+	reader   *bufio.Reader
+}
+
+func newSerialClient(portName string) *serialClient {
+	return &serialClient{
+		portName: portName,
+	}
+}
+
+func (c *serialClient) Open() error {
+	port, err := serial.Open(c.portName, &serial.Mode{BaudRate: 9600})
+	if err != nil {
+		return fmt.Errorf("failed to open RFID serial port: %w", err)
+	}
+
+	c.port = port
+	c.reader = bufio.NewReader(port)
+	return nil
+}
+
+func (c *serialClient) Read() (string, error) {
+	if c.port == nil {
+		return "", ErrRFIDUSBNotConnected
+	}
+
+	for {
+		line, err := c.reader.ReadString('\n')
+		if err != nil {
+			return "", fmt.Errorf("failed to read from serial port: %w", err)
+		}
+
+		if tag := strings.TrimSpace(line); tag != "" {
+			return tag, nil
+		}
+	}
+}
+
+func (c *serialClient) Close() error {
+	if c.port == nil {
+		return nil
+	}
+
+	return c.port.Close()
+}
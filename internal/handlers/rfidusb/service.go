@@ -4,6 +4,7 @@ import (
 	"context"
 	"log/slog"
 
+	"github.com/tbe-team/raybot/internal/config"
 	"github.com/tbe-team/raybot/internal/events"
 	"github.com/tbe-team/raybot/internal/services/location"
 	"github.com/tbe-team/raybot/pkg/eventbus"
@@ -11,7 +12,7 @@ import (
 
 type Service struct {
 	log    *slog.Logger
-	client *client
+	client reader
 
 	publisher       eventbus.Publisher
 	locationService location.Service
@@ -20,14 +21,20 @@ type Service struct {
 type CleanupFunc func(context.Context) error
 
 func New(
+	cfg config.RFID,
 	log *slog.Logger,
 	publisher eventbus.Publisher,
 	locationService location.Service,
 ) *Service {
+	var client reader = newClient()
+	if cfg.SerialPort != "" {
+		client = newSerialClient(cfg.SerialPort)
+	}
+
 	return &Service{
 		log:             log.With("service", "rfidusb"),
 		publisher:       publisher,
-		client:          newClient(),
+		client:          client,
 		locationService: locationService,
 	}
 }
@@ -0,0 +1,60 @@
+package controller
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tbe-team/raybot/internal/config"
+	"github.com/tbe-team/raybot/internal/hardware/serialcodec"
+)
+
+func TestCommandsCodecRoundTrip(t *testing.T) {
+	commands := map[string]any{
+		"PIC battery charge": picCommand{
+			ID:   "abc",
+			Type: picCommandTypeBatteryCharge,
+			Data: picCommandBatteryChargeData{CurrentLimit: 1000, Enable: true},
+		},
+		"PIC battery discharge": picCommand{
+			ID:   "abc",
+			Type: picCommandTypeBatteryDischarge,
+			Data: picCommandBatteryDischargeData{CurrentLimit: 2000, Enable: false},
+		},
+		"PIC lift motor": picCommand{
+			ID:   "abc",
+			Type: picCommandTypeLiftMotor,
+			Data: picCommandLiftMotorData{TargetPosition: 120, Speed: 80, Enable: true},
+		},
+		"PIC drive motor": picCommand{
+			ID:   "abc",
+			Type: picCommandTypeDriveMotor,
+			Data: picCommandDriveMotorData{Direction: moveDirectionBackward, Speed: 50, Enable: true},
+		},
+		"ESP cargo door motor": espCommand{
+			ID:   "abc",
+			Type: espCommandTypeCargoDoorMotor,
+			Data: espCargoDoorMotorData{Direction: doorDirectionOpen, Speed: 60, Enable: true},
+		},
+	}
+
+	for _, codec := range []config.SerialCodec{config.SerialCodecJSON, config.SerialCodecMsgpack} {
+		for name, cmd := range commands {
+			t.Run(string(codec)+" "+name, func(t *testing.T) {
+				c := serialcodec.New(codec)
+
+				msg, err := json.Marshal(cmd)
+				require.NoError(t, err)
+
+				data, err := c.Encode(msg)
+				require.NoError(t, err)
+
+				back, err := c.Decode(data)
+				require.NoError(t, err)
+				assert.JSONEq(t, string(msg), string(back))
+			})
+		}
+	}
+}
@@ -24,6 +24,7 @@ type Controller interface {
 	DriveMotorController
 	BatteryController
 	CargoDoorController
+	LinkStatsController
 }
 
 type controller struct {
@@ -33,6 +34,9 @@ type controller struct {
 	picSerialClient picserial.Client
 	espSerialClient espserial.Client
 
+	picStats ackStats
+	espStats ackStats
+
 	genIDFunc func() string
 }
 
@@ -57,6 +61,14 @@ func New(
 		opt(c)
 	}
 
+	// The board firmware does not ignore a repeated command ID yet.
+	if cfg.PIC.CommandACKRetries > 0 || cfg.ESP.CommandACKRetries > 0 {
+		log.Warn("command ack retries are experimental, a resent command may run twice on the board",
+			slog.Int("pic_retries", int(cfg.PIC.CommandACKRetries)),
+			slog.Int("esp_retries", int(cfg.ESP.CommandACKRetries)),
+		)
+	}
+
 	return c
 }
 
@@ -81,36 +93,55 @@ func (c *controller) writePICCommand(ctx context.Context, cmd picCommand) error
 	return nil
 }
 
+// writePICCommandWithACK writes the command and waits for its ACK, sending the
+// command again with the same ID on each ACK timeout until the retries run out.
+// A resend runs the command once only if the PIC firmware ignores an ID it already ran,
+// otherwise the command may run twice, which is why the retries default to 0.
 func (c *controller) writePICCommandWithACK(ctx context.Context, cmd picCommand) error {
 	ctx, cancel := context.WithCancel(ctx)
-	defer cancel() // ensure cleanup other goroutines
+	defer cancel() // stop tracking the ACK
 
-	errCh := make(chan error, 1)
-	go func() {
-		errCh <- c.trackingPICCommandACK(ctx, cmd.ID)
-	}()
+	log := c.log.With(slog.String("id", cmd.ID))
 
-	if err := c.writePICCommand(ctx, cmd); err != nil {
-		return fmt.Errorf("write command: %w", err)
-	}
+	// Subscribe before the first write, so the ACK of any attempt is not missed.
+	ackCh := c.trackingPICCommandACK(ctx, cmd.ID)
+
+	c.picStats.recordSent()
+	start := time.Now()
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			c.picStats.recordResend()
+			log.Warn("resending PIC command", slog.Int("attempt", attempt+1))
+		}
 
-	select {
-	case err := <-errCh:
-		if err != nil {
-			return fmt.Errorf("tracking PIC command ack: %w", err)
+		if err := c.writePICCommand(ctx, cmd); err != nil {
+			return fmt.Errorf("write command: %w", err)
 		}
-		return nil
 
-	case <-ctx.Done():
-		return ctx.Err()
+		select {
+		case <-ackCh:
+			c.picStats.recordACK(time.Since(start))
+			return nil
+
+		case <-time.After(c.cfg.PIC.CommandACKTimeout):
+			if attempt >= int(c.cfg.PIC.CommandACKRetries) {
+				c.picStats.recordLost()
+				log.Error("PIC command ack timeout", slog.Int("attempts", attempt+1))
+				return fmt.Errorf("tracking PIC command ack: %w", ErrCommandACKTimeout)
+			}
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
 	}
 }
 
-func (c *controller) trackingPICCommandACK(ctx context.Context, id string) error {
+// trackingPICCommandACK returns a channel receiving once the ACK of the command arrives.
+func (c *controller) trackingPICCommandACK(ctx context.Context, id string) <-chan struct{} {
 	log := c.log.With(slog.String("id", id))
-	log.Info("start tracking PIC command ack")
 
-	doneCh := make(chan struct{})
+	// A resent command can be acknowledged more than once, only the first ACK is kept.
+	ackCh := make(chan struct{}, 1)
 	c.subscriber.Subscribe(ctx, events.PICCmdAckTopic, func(msg *eventbus.Message) {
 		ev, ok := msg.Payload.(events.PICCmdAckEvent)
 		if !ok {
@@ -124,22 +155,14 @@ func (c *controller) trackingPICCommandACK(ctx context.Context, id string) error
 			} else {
 				log.Error("PIC command ack failed")
 			}
-			close(doneCh)
+			select {
+			case ackCh <- struct{}{}:
+			default:
+			}
 		}
 	})
 
-	select {
-	case <-doneCh:
-		log.Info("stop tracking PIC command ack")
-		return nil
-
-	case <-time.After(c.cfg.PIC.CommandACKTimeout):
-		log.Error("PIC command ack timeout")
-		return ErrCommandACKTimeout
-
-	case <-ctx.Done():
-		return ctx.Err()
-	}
+	return ackCh
 }
 
 func (c *controller) createESPCommand(ctx context.Context, cmd espCommand) error {
@@ -163,36 +186,55 @@ func (c *controller) writeESPCommand(ctx context.Context, cmd espCommand) error
 	return nil
 }
 
+// writeESPCommandWithACK writes the command and waits for its ACK, sending the
+// command again with the same ID on each ACK timeout until the retries run out.
+// A resend runs the command once only if the ESP firmware ignores an ID it already ran,
+// otherwise the command may run twice, which is why the retries default to 0.
 func (c *controller) writeESPCommandWithACK(ctx context.Context, cmd espCommand) error {
 	ctx, cancel := context.WithCancel(ctx)
-	defer cancel() // ensure cleanup other goroutines
+	defer cancel() // stop tracking the ACK
 
-	errCh := make(chan error, 1)
-	go func() {
-		errCh <- c.trackingESPCommandACK(ctx, cmd.ID)
-	}()
+	log := c.log.With(slog.String("id", cmd.ID))
 
-	if err := c.writeESPCommand(ctx, cmd); err != nil {
-		return fmt.Errorf("write command: %w", err)
-	}
+	// Subscribe before the first write, so the ACK of any attempt is not missed.
+	ackCh := c.trackingESPCommandACK(ctx, cmd.ID)
 
-	select {
-	case err := <-errCh:
-		if err != nil {
-			return fmt.Errorf("tracking ESP command ack: %w", err)
+	c.espStats.recordSent()
+	start := time.Now()
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			c.espStats.recordResend()
+			log.Warn("resending ESP command", slog.Int("attempt", attempt+1))
 		}
-		return nil
 
-	case <-ctx.Done():
-		return ctx.Err()
+		if err := c.writeESPCommand(ctx, cmd); err != nil {
+			return fmt.Errorf("write command: %w", err)
+		}
+
+		select {
+		case <-ackCh:
+			c.espStats.recordACK(time.Since(start))
+			return nil
+
+		case <-time.After(c.cfg.ESP.CommandACKTimeout):
+			if attempt >= int(c.cfg.ESP.CommandACKRetries) {
+				c.espStats.recordLost()
+				log.Error("ESP command ack timeout", slog.Int("attempts", attempt+1))
+				return fmt.Errorf("tracking ESP command ack: %w", ErrCommandACKTimeout)
+			}
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
 	}
 }
 
-func (c *controller) trackingESPCommandACK(ctx context.Context, id string) error {
+// trackingESPCommandACK returns a channel receiving once the ACK of the command arrives.
+func (c *controller) trackingESPCommandACK(ctx context.Context, id string) <-chan struct{} {
 	log := c.log.With(slog.String("id", id))
-	log.Info("start tracking ESP command ack")
 
-	doneCh := make(chan struct{})
+	// A resent command can be acknowledged more than once, only the first ACK is kept.
+	ackCh := make(chan struct{}, 1)
 	c.subscriber.Subscribe(ctx, events.ESPCmdAckTopic, func(msg *eventbus.Message) {
 		ev, ok := msg.Payload.(events.ESPCmdAckEvent)
 		if !ok {
@@ -206,20 +248,12 @@ func (c *controller) trackingESPCommandACK(ctx context.Context, id string) error
 			} else {
 				log.Error("ESP command ack failed")
 			}
-			close(doneCh)
+			select {
+			case ackCh <- struct{}{}:
+			default:
+			}
 		}
 	})
 
-	select {
-	case <-doneCh:
-		log.Info("stop tracking ESP command ack")
-		return nil
-
-	case <-time.After(c.cfg.ESP.CommandACKTimeout):
-		log.Error("ESP command ack timeout")
-		return ErrCommandACKTimeout
-
-	case <-ctx.Done():
-		return ctx.Err()
-	}
+	return ackCh
 }
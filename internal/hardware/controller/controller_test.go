@@ -0,0 +1,108 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tbe-team/raybot/internal/config"
+	"github.com/tbe-team/raybot/internal/events"
+	"github.com/tbe-team/raybot/internal/hardware/picserial"
+	"github.com/tbe-team/raybot/internal/logging"
+	"github.com/tbe-team/raybot/pkg/eventbus"
+)
+
+// ackingPort acknowledges the command written to it starting from the ackOnWrite-th write.
+// An ackOnWrite of 0 never acknowledges.
+type ackingPort struct {
+	*picserial.FakeSerialPort
+	ackOnWrite int
+	writes     int
+	handler    eventbus.HandlerFunc
+}
+
+func (p *ackingPort) Subscribe(_ context.Context, _ string, handler eventbus.HandlerFunc) {
+	p.handler = handler
+}
+
+func (p *ackingPort) Write(b []byte) (int, error) {
+	p.writes++
+	n, err := p.FakeSerialPort.Write(b)
+	if p.ackOnWrite > 0 && p.writes >= p.ackOnWrite && p.handler != nil {
+		p.handler(&eventbus.Message{Payload: events.PICCmdAckEvent{ID: "abc", Success: true}})
+	}
+	return n, err
+}
+
+func TestController_WritePICCommandWithACK(t *testing.T) {
+	newController := func(port *ackingPort, retries uint8) *controller {
+		return &controller{
+			cfg: config.Hardware{
+				PIC: config.PIC{
+					EnableACK:         true,
+					CommandACKTimeout: 10 * time.Millisecond,
+					CommandACKRetries: retries,
+				},
+			},
+			log:             logging.NewNoopLogger(),
+			subscriber:      port,
+			picSerialClient: picserial.NewClientWithPort(port),
+			genIDFunc:       func() string { return "abc" },
+		}
+	}
+
+	t.Run("Should resend the command with the same ID until it is acknowledged", func(t *testing.T) {
+		port := &ackingPort{FakeSerialPort: &picserial.FakeSerialPort{}, ackOnWrite: 3}
+		c := newController(port, 2)
+
+		err := c.ConfigBatteryCharge(context.Background(), 10, true)
+		require.NoError(t, err)
+
+		assert.Equal(t, 3, port.writes)
+		lines := bytes.SplitAfter(port.WriteBuffer.Bytes(), []byte("\r\n"))
+		require.Len(t, lines, 4) // the last one is empty
+		for _, line := range lines[:3] {
+			assert.Equal(t, lines[0], line, "a resend must be the same command")
+			assert.Contains(t, string(removeMarkers(line)), `"id":"abc"`)
+		}
+
+		stats := c.GetPICLinkStats()
+		assert.Equal(t, uint64(1), stats.CommandsSent)
+		assert.Equal(t, uint64(1), stats.CommandsAcked)
+		assert.Equal(t, uint64(0), stats.CommandsLost)
+		assert.Equal(t, uint64(2), stats.Resends)
+		assert.GreaterOrEqual(t, stats.MaxACKLatency, 20*time.Millisecond)
+		assert.Equal(t, stats.MaxACKLatency, stats.AvgACKLatency)
+	})
+
+	t.Run("Should fail and count the command as lost when the retries run out", func(t *testing.T) {
+		port := &ackingPort{FakeSerialPort: &picserial.FakeSerialPort{}}
+		c := newController(port, 1)
+
+		err := c.ConfigBatteryCharge(context.Background(), 10, true)
+		require.ErrorIs(t, err, ErrCommandACKTimeout)
+
+		assert.Equal(t, 2, port.writes)
+		stats := c.GetPICLinkStats()
+		assert.Equal(t, uint64(1), stats.CommandsSent)
+		assert.Equal(t, uint64(0), stats.CommandsAcked)
+		assert.Equal(t, uint64(1), stats.CommandsLost)
+		assert.Equal(t, uint64(1), stats.Resends)
+		assert.Zero(t, stats.AvgACKLatency)
+	})
+
+	t.Run("Should not resend an acknowledged command", func(t *testing.T) {
+		port := &ackingPort{FakeSerialPort: &picserial.FakeSerialPort{}, ackOnWrite: 1}
+		c := newController(port, 2)
+
+		err := c.ConfigBatteryCharge(context.Background(), 10, true)
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, port.writes)
+		assert.Equal(t, uint64(0), c.GetPICLinkStats().Resends)
+	})
+}
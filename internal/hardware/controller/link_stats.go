@@ -0,0 +1,103 @@
+package controller
+
+import (
+	"sync"
+	"time"
+
+	"github.com/tbe-team/raybot/internal/hardware/serialframe"
+)
+
+// LinkStats are the command delivery statistics of a board link.
+// The ACK counters only cover the commands sent while the ACK is enabled.
+type LinkStats struct {
+	// CommandsSent is the number of commands sent, not counting the resends.
+	CommandsSent uint64
+	// CommandsAcked is the number of commands acknowledged by the board.
+	CommandsAcked uint64
+	// CommandsLost is the number of commands not acknowledged after all the resends.
+	CommandsLost uint64
+	// Resends is the number of times a command was sent again after an ACK timeout.
+	Resends uint64
+	// AvgACKLatency is the average time from the first send of a command to its ACK.
+	AvgACKLatency time.Duration
+	// MaxACKLatency is the longest time from the first send of a command to its ACK.
+	MaxACKLatency time.Duration
+	// Frames are the counters of the frames received from the board.
+	Frames serialframe.Stats
+}
+
+type LinkStatsController interface {
+	GetPICLinkStats() LinkStats
+	GetESPLinkStats() LinkStats
+}
+
+func (c *controller) GetPICLinkStats() LinkStats {
+	stats := c.picStats.snapshot()
+	if c.picSerialClient != nil {
+		stats.Frames = c.picSerialClient.FrameStats()
+	}
+	return stats
+}
+
+func (c *controller) GetESPLinkStats() LinkStats {
+	stats := c.espStats.snapshot()
+	if c.espSerialClient != nil {
+		stats.Frames = c.espSerialClient.FrameStats()
+	}
+	return stats
+}
+
+// ackStats tracks the ACKs of the commands sent to a board.
+// The zero value is ready to use.
+type ackStats struct {
+	mu           sync.Mutex
+	sent         uint64
+	acked        uint64
+	lost         uint64
+	resends      uint64
+	totalLatency time.Duration
+	maxLatency   time.Duration
+}
+
+func (s *ackStats) recordSent() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sent++
+}
+
+func (s *ackStats) recordResend() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.resends++
+}
+
+func (s *ackStats) recordACK(latency time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.acked++
+	s.totalLatency += latency
+	s.maxLatency = max(s.maxLatency, latency)
+}
+
+func (s *ackStats) recordLost() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lost++
+}
+
+func (s *ackStats) snapshot() LinkStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats := LinkStats{
+		CommandsSent:  s.sent,
+		CommandsAcked: s.acked,
+		CommandsLost:  s.lost,
+		Resends:       s.resends,
+		MaxACKLatency: s.maxLatency,
+	}
+	if s.acked > 0 {
+		stats.AvgACKLatency = s.totalLatency / time.Duration(s.acked)
+	}
+	return stats
+}
@@ -8,6 +8,8 @@ import (
 	"go.bug.st/serial"
 
 	"github.com/tbe-team/raybot/internal/config"
+	"github.com/tbe-team/raybot/internal/hardware/serialcodec"
+	"github.com/tbe-team/raybot/internal/hardware/serialframe"
 	"github.com/tbe-team/raybot/pkg/xerror"
 )
 
@@ -21,6 +23,8 @@ type Client interface {
 	Connected() bool
 	Write(ctx context.Context, data []byte) error
 	Read(ctx context.Context) ([]byte, error)
+	// FrameStats returns the counters of the CRC16 frames received.
+	FrameStats() serialframe.Stats
 }
 
 type DefaultClient struct {
@@ -29,7 +33,13 @@ type DefaultClient struct {
 	port serial.Port
 	mode serial.Mode
 
+	// portMu guards the port, which is reopened after the link is lost.
+	portMu  sync.RWMutex
 	writeMu sync.Mutex
+
+	codec   serialcodec.Codec
+	encoder *serialframe.Encoder
+	decoder *serialframe.Decoder
 }
 
 func NewClient(cfg config.Serial) *DefaultClient {
@@ -57,14 +67,20 @@ func NewClient(cfg config.Serial) *DefaultClient {
 	}
 
 	return &DefaultClient{
-		cfg:  cfg,
-		mode: mode,
+		cfg:     cfg,
+		mode:    mode,
+		codec:   serialcodec.New(cfg.Codec),
+		encoder: serialframe.NewEncoder(),
+		decoder: serialframe.NewDecoder(),
 	}
 }
 
 func NewClientWithPort(port serial.Port) *DefaultClient {
 	return &DefaultClient{
-		port: port,
+		port:    port,
+		codec:   serialcodec.New(""),
+		encoder: serialframe.NewEncoder(),
+		decoder: serialframe.NewDecoder(),
 	}
 }
 
@@ -75,22 +91,38 @@ func (c *DefaultClient) Open() error {
 	}
 
 	if err := port.SetReadTimeout(c.cfg.ReadTimeout); err != nil {
+		port.Close()
 		return fmt.Errorf("failed to set read timeout: %w", err)
 	}
 
+	// Bytes left from the lost link are not part of the frames of the new one.
+	c.decoder.Reset()
+
+	c.portMu.Lock()
 	c.port = port
+	c.portMu.Unlock()
 	return nil
 }
 
+// Close closes the port. The client is not connected
+// afterwards, until the port is opened again.
 func (c *DefaultClient) Close() error {
+	c.portMu.Lock()
+	defer c.portMu.Unlock()
+
 	if c.port == nil {
 		return ErrESPSerialNotConnected
 	}
 
-	return c.port.Close()
+	err := c.port.Close()
+	c.port = nil
+	return err
 }
 
 func (c *DefaultClient) Connected() bool {
+	c.portMu.RLock()
+	defer c.portMu.RUnlock()
+
 	return c.port != nil
 }
 
@@ -101,33 +133,97 @@ func (c *DefaultClient) Write(ctx context.Context, data []byte) error {
 	default:
 	}
 
-	if c.port == nil {
+	c.portMu.RLock()
+	port := c.port
+	c.portMu.RUnlock()
+
+	if port == nil {
 		return ErrESPSerialNotConnected
 	}
 
-	data = append([]byte(">"), data...)
-	data = append(data, '\r', '\n')
+	data, err := c.codec.Encode(data)
+	if err != nil {
+		return fmt.Errorf("failed to encode message: %w", err)
+	}
 
 	c.writeMu.Lock()
-	_, err := c.port.Write(data)
-	c.writeMu.Unlock()
+	defer c.writeMu.Unlock()
+
+	if c.cfg.Framing == config.SerialFramingCRC16 {
+		frame, err := c.encoder.Encode(data)
+		if err != nil {
+			return fmt.Errorf("failed to encode frame: %w", err)
+		}
+
+		_, err = port.Write(frame)
+		return err
+	}
 
+	data = append([]byte(">"), data...)
+	data = append(data, '\r', '\n')
+
+	_, err = port.Write(data)
 	return err
 }
 
-// Read reads data from the serial port.
+// Read reads a message from the serial port. A message the codec can not decode
+// is returned with an error wrapping serialcodec.ErrInvalidMessage.
 func (c *DefaultClient) Read(ctx context.Context) ([]byte, error) {
-	if c.port == nil {
+	c.portMu.RLock()
+	port := c.port
+	c.portMu.RUnlock()
+
+	if port == nil {
 		return nil, ErrESPSerialNotConnected
 	}
 
-	return c.read(ctx)
+	var (
+		msg []byte
+		err error
+	)
+	if c.cfg.Framing == config.SerialFramingCRC16 {
+		msg, err = c.readCRC16Frame(ctx, port)
+	} else {
+		msg, err = c.read(ctx, port)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return c.codec.Decode(msg)
+}
+
+func (c *DefaultClient) FrameStats() serialframe.Stats {
+	return c.decoder.Stats()
+}
+
+// readCRC16Frame reads from the port until a valid CRC16 frame is received.
+// The payload of the frame is returned, corrupted frames are dropped and counted.
+func (c *DefaultClient) readCRC16Frame(ctx context.Context, port serial.Port) ([]byte, error) {
+	buf := make([]byte, readBufferSize)
+	for {
+		if payload, ok := c.decoder.Next(); ok {
+			return payload, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+
+		n, err := port.Read(buf)
+		if err != nil {
+			return nil, err
+		}
+		c.decoder.Write(buf[:n])
+	}
 }
 
 // read continuously reads from the port until a complete message is received.
 // A complete message starts with '>' and ends with CR LF (\r\n).
 // The message is returned without the prefix and suffix
-func (c *DefaultClient) read(ctx context.Context) ([]byte, error) {
+func (c *DefaultClient) read(ctx context.Context, port serial.Port) ([]byte, error) {
 	var msg []byte
 	isMsgStarted := false
 
@@ -138,7 +234,7 @@ func (c *DefaultClient) read(ctx context.Context) ([]byte, error) {
 
 		default:
 			buf := make([]byte, readBufferSize)
-			n, err := c.port.Read(buf)
+			n, err := port.Read(buf)
 			if err != nil {
 				return nil, err
 			}
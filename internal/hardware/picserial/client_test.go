@@ -2,12 +2,15 @@ package picserial
 
 import (
 	"context"
+	"encoding/binary"
 	"io"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/tbe-team/raybot/internal/config"
+	"github.com/tbe-team/raybot/internal/hardware/serialcodec"
+	"github.com/tbe-team/raybot/internal/hardware/serialframe"
 )
 
 func TestClientWrite(t *testing.T) {
@@ -201,3 +204,95 @@ func TestClientRead(t *testing.T) {
 		assert.Nil(t, res)
 	})
 }
+
+func TestClientCRC16Framing(t *testing.T) {
+	cfg := config.Serial{Framing: config.SerialFramingCRC16}
+
+	t.Run("Should write and read back a CRC16 frame", func(t *testing.T) {
+		mockPort := &FakeSerialPort{}
+		client := NewClient(cfg)
+		client.port = mockPort
+
+		data := []byte(`{"cmd":"test"}`)
+		assert.NoError(t, client.Write(context.Background(), data))
+
+		frame := mockPort.WriteBuffer.Bytes()
+		assert.Equal(t, serialframe.Checksum(frame[1:len(frame)-2]), binary.BigEndian.Uint16(frame[len(frame)-2:]))
+
+		mockPort.ReadBuffer.Write(frame)
+		res, err := client.Read(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, data, res)
+		assert.Equal(t, serialframe.Stats{Received: 1}, client.FrameStats())
+	})
+
+	t.Run("Should drop and count a corrupted frame", func(t *testing.T) {
+		mockPort := &FakeSerialPort{}
+		client := NewClient(cfg)
+		client.port = mockPort
+
+		encoder := serialframe.NewEncoder()
+		corrupted, err := encoder.Encode([]byte(`{"speed":10}`))
+		assert.NoError(t, err)
+		corrupted[7] ^= 0x01
+		valid, err := encoder.Encode([]byte(`{"speed":20}`))
+		assert.NoError(t, err)
+
+		mockPort.ReadBuffer.Write(corrupted)
+		mockPort.ReadBuffer.Write(valid)
+
+		res, err := client.Read(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, []byte(`{"speed":20}`), res)
+		assert.Equal(t, serialframe.Stats{Received: 1, Rejected: 1}, client.FrameStats())
+	})
+
+	t.Run("Should return EOF error on an incomplete frame", func(t *testing.T) {
+		mockPort := &FakeSerialPort{}
+		client := NewClient(cfg)
+		client.port = mockPort
+
+		frame, err := serialframe.NewEncoder().Encode([]byte("data"))
+		assert.NoError(t, err)
+		mockPort.ReadBuffer.Write(frame[:len(frame)-1])
+
+		res, err := client.Read(context.Background())
+		assert.Equal(t, io.EOF, err)
+		assert.Nil(t, res)
+	})
+}
+
+func TestClientMsgpackCodec(t *testing.T) {
+	cfg := config.Serial{Framing: config.SerialFramingCRC16, Codec: config.SerialCodecMsgpack}
+
+	t.Run("Should write MessagePack and read back JSON", func(t *testing.T) {
+		mockPort := &FakeSerialPort{}
+		client := NewClient(cfg)
+		client.port = mockPort
+
+		data := []byte(`{"id":"abc","type":3,"data":{"direction":0,"speed":10,"enable":1}}`)
+		assert.NoError(t, client.Write(context.Background(), data))
+
+		frame := mockPort.WriteBuffer.Bytes()
+		assert.Less(t, len(frame), len(data))
+
+		mockPort.ReadBuffer.Write(frame)
+		res, err := client.Read(context.Background())
+		assert.NoError(t, err)
+		assert.JSONEq(t, string(data), string(res))
+	})
+
+	t.Run("Should return invalid message error on an undecodable message", func(t *testing.T) {
+		mockPort := &FakeSerialPort{}
+		client := NewClient(cfg)
+		client.port = mockPort
+
+		frame, err := serialframe.NewEncoder().Encode([]byte{0xc4, 0x01, 0x00})
+		assert.NoError(t, err)
+		mockPort.ReadBuffer.Write(frame)
+
+		res, err := client.Read(context.Background())
+		assert.ErrorIs(t, err, serialcodec.ErrInvalidMessage)
+		assert.Nil(t, res)
+	})
+}
@@ -0,0 +1,151 @@
+package serialcodec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tbe-team/raybot/internal/config"
+)
+
+func TestMsgpackCodec_Encode(t *testing.T) {
+	codec := New(config.SerialCodecMsgpack)
+
+	tests := []struct {
+		name string
+		msg  string
+		want []byte
+	}{
+		{name: "nil", msg: `null`, want: []byte{0xc0}},
+		{name: "bool", msg: `[true,false]`, want: []byte{0x92, 0xc3, 0xc2}},
+		{name: "positive fixint", msg: `127`, want: []byte{0x7f}},
+		{name: "uint8", msg: `200`, want: []byte{0xcc, 0xc8}},
+		{name: "uint16", msg: `4200`, want: []byte{0xcd, 0x10, 0x68}},
+		{name: "uint32", msg: `70000`, want: []byte{0xce, 0x00, 0x01, 0x11, 0x70}},
+		{name: "uint64", msg: `18446744073709551615`, want: []byte{0xcf, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
+		{name: "negative fixint", msg: `-32`, want: []byte{0xe0}},
+		{name: "int8", msg: `-100`, want: []byte{0xd0, 0x9c}},
+		{name: "int16", msg: `-1000`, want: []byte{0xd1, 0xfc, 0x18}},
+		{name: "int32", msg: `-70000`, want: []byte{0xd2, 0xff, 0xfe, 0xee, 0x90}},
+		{name: "float", msg: `1.5`, want: []byte{0xcb, 0x3f, 0xf8, 0, 0, 0, 0, 0, 0}},
+		{name: "fixstr", msg: `"ab"`, want: []byte{0xa2, 'a', 'b'}},
+		{name: "sorted fixmap", msg: `{"b":1,"a":2}`, want: []byte{0x82, 0xa1, 'a', 0x02, 0xa1, 'b', 0x01}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := codec.Encode([]byte(tc.msg))
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, data)
+		})
+	}
+
+	t.Run("Should use the longer formats of strings, arrays and maps", func(t *testing.T) {
+		long := make([]byte, 300)
+		for i := range long {
+			long[i] = 'x'
+		}
+
+		data, err := codec.Encode([]byte(`"` + string(long[:40]) + `"`))
+		require.NoError(t, err)
+		assert.Equal(t, []byte{0xd9, 40}, data[:2])
+
+		data, err = codec.Encode([]byte(`"` + string(long) + `"`))
+		require.NoError(t, err)
+		assert.Equal(t, []byte{0xda, 0x01, 0x2c}, data[:3])
+
+		data, err = codec.Encode([]byte(`[0,1,2,3,4,5,6,7,8,9,10,11,12,13,14,15,16]`))
+		require.NoError(t, err)
+		assert.Equal(t, []byte{0xdc, 0x00, 0x11}, data[:3])
+
+		back, err := codec.Decode(data)
+		require.NoError(t, err)
+		assert.JSONEq(t, `[0,1,2,3,4,5,6,7,8,9,10,11,12,13,14,15,16]`, string(back))
+	})
+
+	t.Run("Should return error on invalid JSON", func(t *testing.T) {
+		_, err := codec.Encode([]byte(`{"a":`))
+		require.Error(t, err)
+	})
+}
+
+func TestMsgpackCodec_Decode(t *testing.T) {
+	codec := New(config.SerialCodecMsgpack)
+
+	t.Run("Should decode the signed integers and the float32", func(t *testing.T) {
+		msg, err := codec.Decode([]byte{0x93, 0xd0, 0x9c, 0xd1, 0xfc, 0x18, 0xca, 0x3f, 0xc0, 0x00, 0x00})
+		require.NoError(t, err)
+		assert.JSONEq(t, `[-100,-1000,1.5]`, string(msg))
+	})
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{name: "truncated string", data: []byte{0xa3, 'a'}},
+		{name: "truncated map", data: []byte{0x81, 0xa1, 'a'}},
+		{name: "non string key", data: []byte{0x81, 0x01, 0x01}},
+		{name: "unsupported type", data: []byte{0xc4, 0x01, 0x00}},
+		{name: "trailing bytes", data: []byte{0x01, 0x02}},
+		{name: "array longer than the message", data: []byte{0xdd, 0xff, 0xff, 0xff, 0xff}},
+	}
+	for _, tc := range tests {
+		t.Run("Should reject "+tc.name, func(t *testing.T) {
+			_, err := codec.Decode(tc.data)
+			require.ErrorIs(t, err, ErrInvalidMessage)
+		})
+	}
+}
+
+// TestMsgpackCodec_RoundTrip covers every command, sync state and ACK message of the PIC and ESP protocols.
+func TestMsgpackCodec_RoundTrip(t *testing.T) {
+	codec := New(config.SerialCodecMsgpack)
+
+	messages := map[string]string{
+		"PIC battery charge command":    `{"id":"a1b2","type":0,"data":{"current_limit":1000,"enable":1}}`,
+		"PIC battery discharge command": `{"id":"a1b2","type":1,"data":{"current_limit":2000,"enable":0}}`,
+		"PIC lift motor command":        `{"id":"a1b2","type":2,"data":{"target_position":120,"max_output":80,"enable":1}}`,
+		"PIC drive motor command":       `{"id":"a1b2","type":3,"data":{"direction":1,"speed":50,"enable":1}}`,
+		"ESP cargo door motor command":  `{"id":"a1b2","type":0,"data":{"state":1,"speed":60,"enable":1}}`,
+
+		"PIC battery state": `{"type":0,"state_type":0,"data":{"current":1500,"temp":31,"voltage":16400,` +
+			`"cell_voltages":[4100,4100,4099,4101],"percent":87,"fault":0,"health":100}}`,
+		"PIC charge state":          `{"type":0,"state_type":1,"data":{"current_limit":1000,"enabled":1}}`,
+		"PIC discharge state":       `{"type":0,"state_type":2,"data":{"current_limit":2000,"enabled":0}}`,
+		"PIC distance sensor state": `{"type":0,"state_type":3,"data":{"front":300,"back":65535,"down":42}}`,
+		"PIC lift motor state":      `{"type":0,"state_type":4,"data":{"current_position":42,"target_position":120,"is_running":1,"enabled":1}}`,
+		"PIC drive motor state":     `{"type":0,"state_type":5,"data":{"direction":0,"speed":50,"is_running":1,"enabled":1}}`,
+		"PIC limit switch state":    `{"type":0,"state_type":6,"data":{"state":1}}`,
+		"ESP door state":            `{"type":0,"state_type":0,"data":{"is_open":true}}`,
+		"ESP motor state":           `{"type":0,"state_type":1,"data":{"state":0,"enabled":1,"speed":60,"is_running":0}}`,
+		"ESP QR scanner state":      `{"type":0,"state_type":2,"data":{"code":"ORDER-0042"}}`,
+		"ESP bottom distance state": `{"type":0,"state_type":3,"data":{"under":25}}`,
+		"ACK":                       `{"type":1,"id":"a1b2","status":1}`,
+	}
+
+	for name, msg := range messages {
+		t.Run(name, func(t *testing.T) {
+			data, err := codec.Encode([]byte(msg))
+			require.NoError(t, err)
+			assert.Less(t, len(data), len(msg))
+
+			back, err := codec.Decode(data)
+			require.NoError(t, err)
+			assert.JSONEq(t, msg, string(back))
+		})
+	}
+}
+
+func TestJSONCodec(t *testing.T) {
+	codec := New(config.SerialCodecJSON)
+	msg := []byte(`{"type":1,"id":"a1b2","status":1}`)
+
+	data, err := codec.Encode(msg)
+	require.NoError(t, err)
+	assert.Equal(t, msg, data)
+
+	back, err := codec.Decode(data)
+	require.NoError(t, err)
+	assert.Equal(t, msg, back)
+}
@@ -0,0 +1,41 @@
+// Package serialcodec implements the encodings of the messages of the PIC and ESP serial links.
+//
+// The controller and the handlers build and parse JSON messages. A codec converts them
+// to the encoding of the link before framing, and back after a frame is received.
+package serialcodec
+
+import (
+	"errors"
+
+	"github.com/tbe-team/raybot/internal/config"
+)
+
+// ErrInvalidMessage is returned when a received message can not be decoded.
+var ErrInvalidMessage = errors.New("invalid message")
+
+// Codec converts the JSON messages to and from the encoding of a serial link.
+type Codec interface {
+	// Encode encodes a JSON message for the link.
+	Encode(msg []byte) ([]byte, error)
+	// Decode decodes a message received from the link to JSON.
+	Decode(data []byte) ([]byte, error)
+}
+
+// New returns the codec of the config, JSON if not set.
+func New(codec config.SerialCodec) Codec {
+	if codec == config.SerialCodecMsgpack {
+		return msgpackCodec{}
+	}
+	return jsonCodec{}
+}
+
+// jsonCodec sends the JSON messages as they are.
+type jsonCodec struct{}
+
+func (jsonCodec) Encode(msg []byte) ([]byte, error) {
+	return msg, nil
+}
+
+func (jsonCodec) Decode(data []byte) ([]byte, error) {
+	return data, nil
+}
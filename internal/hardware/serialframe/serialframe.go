@@ -0,0 +1,181 @@
+// Package serialframe implements the checksummed frame format of the PIC and ESP serial links.
+//
+// A frame is laid out as:
+//
+//	STX (0x02) | version (1) | sequence (1) | payload length (2, big endian) | payload | CRC16 (2, big endian)
+//
+// The CRC16 is CRC-16/CCITT-FALSE over the version, the sequence, the length and the payload.
+// The sequence number increments with every frame sent and wraps around after 255.
+package serialframe
+
+import (
+	"encoding/binary"
+	"errors"
+	"sync/atomic"
+)
+
+const (
+	// Version is the protocol version of the frames.
+	Version byte = 1
+
+	// MaxPayloadSize is the maximum size of a frame payload.
+	MaxPayloadSize = 1024
+
+	startByte  byte = 0x02
+	headerSize      = 4
+	crcSize         = 2
+)
+
+var ErrPayloadTooLarge = errors.New("frame payload too large")
+
+// Stats are the counters of the received frames.
+type Stats struct {
+	// Received is the number of valid frames.
+	Received uint64
+	// Rejected is the number of frames failing the version, length or CRC check.
+	Rejected uint64
+	// SequenceGaps is the number of frames missing between the sequence numbers of valid frames.
+	SequenceGaps uint64
+}
+
+// Encoder encodes payloads into frames. It is not safe for concurrent use.
+type Encoder struct {
+	seq byte
+}
+
+func NewEncoder() *Encoder {
+	return &Encoder{}
+}
+
+// Encode returns the frame of the payload with the next sequence number.
+func (e *Encoder) Encode(payload []byte) ([]byte, error) {
+	if len(payload) > MaxPayloadSize {
+		return nil, ErrPayloadTooLarge
+	}
+
+	frame := make([]byte, 0, 1+headerSize+len(payload)+crcSize)
+	frame = append(frame, startByte, Version, e.seq)
+	frame = binary.BigEndian.AppendUint16(frame, uint16(len(payload)))
+	frame = append(frame, payload...)
+	frame = binary.BigEndian.AppendUint16(frame, Checksum(frame[1:]))
+
+	e.seq++
+	return frame, nil
+}
+
+// Decoder extracts the valid frames from the received bytes. Write and Next
+// are not safe for concurrent use, Stats is.
+type Decoder struct {
+	buf     []byte
+	lastSeq byte
+	hasSeq  bool
+
+	received     atomic.Uint64
+	rejected     atomic.Uint64
+	sequenceGaps atomic.Uint64
+}
+
+func NewDecoder() *Decoder {
+	return &Decoder{}
+}
+
+// Write appends received bytes to the decoder.
+func (d *Decoder) Write(p []byte) {
+	d.buf = append(d.buf, p...)
+}
+
+// Next returns the payload of the next valid frame, or false if no complete
+// frame was received yet. A frame failing a check is counted as rejected and
+// the search starts again at the byte following its start byte.
+func (d *Decoder) Next() ([]byte, bool) {
+	for {
+		start := -1
+		for i, b := range d.buf {
+			if b == startByte {
+				start = i
+				break
+			}
+		}
+		if start < 0 {
+			d.buf = d.buf[:0]
+			return nil, false
+		}
+		d.buf = d.buf[start:]
+
+		if len(d.buf) < 1+headerSize {
+			return nil, false
+		}
+
+		version := d.buf[1]
+		length := int(binary.BigEndian.Uint16(d.buf[3:5]))
+		if version != Version || length > MaxPayloadSize {
+			d.reject()
+			continue
+		}
+
+		frameSize := 1 + headerSize + length + crcSize
+		if len(d.buf) < frameSize {
+			return nil, false
+		}
+
+		body := d.buf[1 : 1+headerSize+length]
+		if Checksum(body) != binary.BigEndian.Uint16(d.buf[1+headerSize+length:frameSize]) {
+			d.reject()
+			continue
+		}
+
+		d.trackSequence(d.buf[2])
+		payload := make([]byte, length)
+		copy(payload, d.buf[1+headerSize:1+headerSize+length])
+		d.buf = d.buf[frameSize:]
+		d.received.Add(1)
+		return payload, true
+	}
+}
+
+// Reset drops the pending bytes and the last sequence number,
+// for example when the port is opened again. The stats are kept.
+func (d *Decoder) Reset() {
+	d.buf = d.buf[:0]
+	d.hasSeq = false
+}
+
+func (d *Decoder) Stats() Stats {
+	return Stats{
+		Received:     d.received.Load(),
+		Rejected:     d.rejected.Load(),
+		SequenceGaps: d.sequenceGaps.Load(),
+	}
+}
+
+func (d *Decoder) reject() {
+	d.rejected.Add(1)
+	d.buf = d.buf[1:]
+}
+
+func (d *Decoder) trackSequence(seq byte) {
+	if d.hasSeq {
+		// The byte arithmetic handles the wrap around after 255.
+		if gap := seq - d.lastSeq - 1; gap != 0 {
+			d.sequenceGaps.Add(uint64(gap))
+		}
+	}
+	d.lastSeq = seq
+	d.hasSeq = true
+}
+
+// Checksum returns the CRC-16/CCITT-FALSE of the data.
+func Checksum(data []byte) uint16 {
+	crc := uint16(0xFFFF)
+	for _, b := range data {
+		crc ^= uint16(b) << 8
+		for range 8 {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
@@ -0,0 +1,107 @@
+package serialframe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChecksum(t *testing.T) {
+	// The check value of CRC-16/CCITT-FALSE.
+	assert.Equal(t, uint16(0x29B1), Checksum([]byte("123456789")))
+}
+
+func TestEncoder_Encode(t *testing.T) {
+	e := NewEncoder()
+
+	frame, err := e.Encode([]byte(`{"a":1}`))
+	require.NoError(t, err)
+	assert.Equal(t, []byte{startByte, Version, 0, 0, 7}, frame[:5])
+	assert.Equal(t, `{"a":1}`, string(frame[5:12]))
+	assert.Len(t, frame, 14)
+
+	frame, err = e.Encode(nil)
+	require.NoError(t, err)
+	assert.Equal(t, byte(1), frame[2])
+
+	_, err = e.Encode(make([]byte, MaxPayloadSize+1))
+	require.ErrorIs(t, err, ErrPayloadTooLarge)
+}
+
+func TestDecoder_Next(t *testing.T) {
+	encode := func(e *Encoder, payload string) []byte {
+		frame, err := e.Encode([]byte(payload))
+		require.NoError(t, err)
+		return frame
+	}
+
+	t.Run("Should decode frames split across writes", func(t *testing.T) {
+		e := NewEncoder()
+		d := NewDecoder()
+
+		data := append(encode(e, "first"), encode(e, "second")...)
+		d.Write(data[:4])
+		_, ok := d.Next()
+		require.False(t, ok)
+
+		d.Write(data[4:])
+		payload, ok := d.Next()
+		require.True(t, ok)
+		assert.Equal(t, "first", string(payload))
+
+		payload, ok = d.Next()
+		require.True(t, ok)
+		assert.Equal(t, "second", string(payload))
+
+		_, ok = d.Next()
+		require.False(t, ok)
+		assert.Equal(t, Stats{Received: 2}, d.Stats())
+	})
+
+	t.Run("Should reject a corrupted frame and decode the next one", func(t *testing.T) {
+		e := NewEncoder()
+		d := NewDecoder()
+
+		corrupted := encode(e, `{"speed":10}`)
+		corrupted[8] ^= 0x01
+		d.Write(append([]byte("noise"), corrupted...))
+		d.Write(encode(e, "valid"))
+
+		payload, ok := d.Next()
+		require.True(t, ok)
+		assert.Equal(t, "valid", string(payload))
+		assert.Equal(t, Stats{Received: 1, Rejected: 1}, d.Stats())
+	})
+
+	t.Run("Should reject a frame of an unknown version", func(t *testing.T) {
+		e := NewEncoder()
+		d := NewDecoder()
+
+		frame := encode(e, "future")
+		frame[1] = 9
+		d.Write(frame)
+
+		_, ok := d.Next()
+		require.False(t, ok)
+		assert.Equal(t, uint64(1), d.Stats().Rejected)
+	})
+
+	t.Run("Should count the sequence gaps across the wrap around", func(t *testing.T) {
+		e := NewEncoder()
+		d := NewDecoder()
+
+		for i := range 258 {
+			frame := encode(e, "x")
+			// Drop the frames 100, 101 and 256.
+			if i == 100 || i == 101 || i == 256 {
+				continue
+			}
+			d.Write(frame)
+			_, ok := d.Next()
+			require.True(t, ok)
+		}
+
+		assert.Equal(t, Stats{Received: 255, SequenceGaps: 3}, d.Stats())
+	})
+}
@@ -0,0 +1,295 @@
+package simulator
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/rand/v2"
+	"time"
+
+	"github.com/tbe-team/raybot/internal/config"
+	"github.com/tbe-team/raybot/internal/hardware/serialcodec"
+	"github.com/tbe-team/raybot/internal/hardware/serialframe"
+)
+
+const (
+	messageTypeSyncState uint8 = 0
+	messageTypeACK       uint8 = 1
+
+	ackStatusError   uint8 = 0
+	ackStatusSuccess uint8 = 1
+
+	// recentCommandsSize is the number of command IDs a board remembers.
+	recentCommandsSize = 64
+)
+
+// boardHandler handles the commands of a board and reports its states.
+type boardHandler interface {
+	handleCommand(cmdType uint8, data json.RawMessage) error
+	syncStates() []syncState
+}
+
+type syncState struct {
+	stateType uint8
+	data      any
+}
+
+type commandMessage struct {
+	ID   string          `json:"id"`
+	Type uint8           `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+type syncStateMessage struct {
+	Type      uint8 `json:"type"`
+	StateType uint8 `json:"state_type"`
+	Data      any   `json:"data"`
+}
+
+type ackMessage struct {
+	Type   uint8  `json:"type"`
+	ID     string `json:"id"`
+	Status uint8  `json:"status"`
+}
+
+// board reads the command frames of a serial link and writes back the ACKs
+// and the sync states. A legacy frame starts with '>' and ends with CR LF.
+type board struct {
+	name         string
+	log          *slog.Logger
+	rw           io.ReadWriter
+	handler      boardHandler
+	syncInterval time.Duration
+	framing      config.SerialFraming
+	codec        serialcodec.Codec
+	encoder      *serialframe.Encoder
+	ackDropRate  float64
+	recent       *recentCommands
+}
+
+func newBoard(name string, log *slog.Logger, rw io.ReadWriter, handler boardHandler, cfg Config) *board {
+	return &board{
+		name:         name,
+		log:          log.With("board", name),
+		rw:           rw,
+		handler:      handler,
+		syncInterval: cfg.SyncInterval,
+		framing:      cfg.Framing,
+		codec:        serialcodec.New(cfg.Codec),
+		encoder:      serialframe.NewEncoder(),
+		ackDropRate:  cfg.ACKDropRate,
+		recent:       newRecentCommands(recentCommandsSize),
+	}
+}
+
+func (b *board) run(ctx context.Context) error {
+	frameCh := make(chan []byte)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- b.readFrames(ctx, frameCh)
+	}()
+
+	ticker := time.NewTicker(b.syncInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case err := <-errCh:
+			return fmt.Errorf("%s: failed to read frame: %w", b.name, err)
+
+		case frame := <-frameCh:
+			if err := b.handleFrame(frame); err != nil {
+				return err
+			}
+
+		case <-ticker.C:
+			if err := b.writeSyncStates(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (b *board) readFrames(ctx context.Context, frameCh chan<- []byte) error {
+	if b.framing == config.SerialFramingCRC16 {
+		return b.readCRC16Frames(ctx, frameCh)
+	}
+
+	reader := bufio.NewReader(b.rw)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err != nil {
+			return err
+		}
+
+		start := bytes.IndexByte(line, '>')
+		if start < 0 {
+			continue
+		}
+
+		frame := bytes.TrimRight(line[start+1:], "\r\n")
+		select {
+		case frameCh <- frame:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// readCRC16Frames reads the CRC16 frames, the corrupted frames are dropped.
+func (b *board) readCRC16Frames(ctx context.Context, frameCh chan<- []byte) error {
+	decoder := serialframe.NewDecoder()
+	buf := make([]byte, 256)
+	for {
+		n, err := b.rw.Read(buf)
+		if err != nil {
+			return err
+		}
+		decoder.Write(buf[:n])
+
+		for {
+			frame, ok := decoder.Next()
+			if !ok {
+				break
+			}
+
+			select {
+			case frameCh <- frame:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+}
+
+// handleFrame runs the command of the frame and ACKs it. A frame that can not
+// be decoded has no ID to ACK, so it is dropped.
+func (b *board) handleFrame(frame []byte) error {
+	msg, err := b.codec.Decode(frame)
+	if err != nil {
+		b.log.Warn("invalid command frame", slog.Any("error", err))
+		return nil
+	}
+
+	var cmd commandMessage
+	if err := json.Unmarshal(msg, &cmd); err != nil {
+		b.log.Warn("invalid command frame", slog.String("frame", string(frame)), slog.Any("error", err))
+		return nil
+	}
+
+	// The host resends a command with the same ID when its ACK is lost,
+	// the command already ran so only the ACK is sent again.
+	status, ok := b.recent.get(cmd.ID)
+	if ok {
+		b.log.Debug("duplicate command", slog.String("id", cmd.ID))
+	} else {
+		status = b.handleCommand(cmd)
+		b.recent.add(cmd.ID, status)
+	}
+
+	if b.ackDropRate > 0 && rand.Float64() < b.ackDropRate {
+		b.log.Debug("ACK dropped", slog.String("id", cmd.ID))
+	} else if err := b.write(ackMessage{Type: messageTypeACK, ID: cmd.ID, Status: status}); err != nil {
+		return err
+	}
+
+	// Sync the states right away, so the command takes effect without waiting for the ticker.
+	return b.writeSyncStates()
+}
+
+func (b *board) handleCommand(cmd commandMessage) uint8 {
+	if err := b.handler.handleCommand(cmd.Type, cmd.Data); err != nil {
+		b.log.Warn("command failed", slog.String("id", cmd.ID), slog.Any("error", err))
+		return ackStatusError
+	}
+
+	b.log.Debug("command handled", slog.String("id", cmd.ID), slog.Int("type", int(cmd.Type)))
+	return ackStatusSuccess
+}
+
+func (b *board) writeSyncStates() error {
+	for _, state := range b.handler.syncStates() {
+		if err := b.write(syncStateMessage{
+			Type:      messageTypeSyncState,
+			StateType: state.stateType,
+			Data:      state.data,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *board) write(msg any) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("%s: failed to marshal message: %w", b.name, err)
+	}
+
+	data, err = b.codec.Encode(data)
+	if err != nil {
+		return fmt.Errorf("%s: failed to encode message: %w", b.name, err)
+	}
+
+	var frame []byte
+	if b.framing == config.SerialFramingCRC16 {
+		frame, err = b.encoder.Encode(data)
+		if err != nil {
+			return fmt.Errorf("%s: failed to encode frame: %w", b.name, err)
+		}
+	} else {
+		frame = make([]byte, 0, len(data)+3)
+		frame = append(frame, '>')
+		frame = append(frame, data...)
+		frame = append(frame, '\r', '\n')
+	}
+
+	if _, err := b.rw.Write(frame); err != nil {
+		return fmt.Errorf("%s: failed to write frame: %w", b.name, err)
+	}
+	return nil
+}
+
+// recentCommands remembers the ACK status of the last command IDs,
+// the oldest ID is forgotten first.
+type recentCommands struct {
+	ids    []string
+	next   int
+	status map[string]uint8
+}
+
+func newRecentCommands(size int) *recentCommands {
+	return &recentCommands{
+		ids:    make([]string, size),
+		status: make(map[string]uint8, size),
+	}
+}
+
+// get returns the status of the command. A command without ID is never a duplicate.
+func (r *recentCommands) get(id string) (uint8, bool) {
+	if id == "" {
+		return 0, false
+	}
+	status, ok := r.status[id]
+	return status, ok
+}
+
+func (r *recentCommands) add(id string, status uint8) {
+	if id == "" {
+		return
+	}
+
+	if old := r.ids[r.next]; old != "" {
+		delete(r.status, old)
+	}
+	r.ids[r.next] = id
+	r.status[id] = status
+	r.next = (r.next + 1) % len(r.ids)
+}
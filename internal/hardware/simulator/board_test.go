@@ -0,0 +1,108 @@
+package simulator
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tbe-team/raybot/internal/logging"
+)
+
+type countingHandler struct {
+	calls int
+	err   error
+}
+
+func (h *countingHandler) handleCommand(uint8, json.RawMessage) error {
+	h.calls++
+	return h.err
+}
+
+func (h *countingHandler) syncStates() []syncState {
+	return nil
+}
+
+func TestBoard_HandleFrame(t *testing.T) {
+	readACKs := func(t *testing.T, buf *bytes.Buffer) []testMessage {
+		t.Helper()
+
+		// The handler has no states to sync, so every frame written is an ACK.
+		var acks []testMessage
+		r := bufio.NewReader(buf)
+		for {
+			if _, err := r.Peek(1); err != nil {
+				return acks
+			}
+			acks = append(acks, readMessage(t, r, func(testMessage) bool { return true }))
+		}
+	}
+
+	t.Run("Should run a resent command once and ACK every copy", func(t *testing.T) {
+		var buf bytes.Buffer
+		handler := &countingHandler{}
+		b := newBoard("pic", logging.NewNoopLogger(), &buf, handler, DefaultConfig())
+
+		frame := []byte(`{"id":"abc","type":3,"data":{}}`)
+		require.NoError(t, b.handleFrame(frame))
+		require.NoError(t, b.handleFrame(frame))
+
+		assert.Equal(t, 1, handler.calls)
+		acks := readACKs(t, &buf)
+		require.Len(t, acks, 2)
+		for _, ack := range acks {
+			assert.Equal(t, "abc", ack.ID)
+			assert.Equal(t, ackStatusSuccess, ack.Status)
+		}
+	})
+
+	t.Run("Should ACK a resent failed command with the error status", func(t *testing.T) {
+		var buf bytes.Buffer
+		handler := &countingHandler{err: errors.New("failed")}
+		b := newBoard("pic", logging.NewNoopLogger(), &buf, handler, DefaultConfig())
+
+		frame := []byte(`{"id":"abc","type":3,"data":{}}`)
+		require.NoError(t, b.handleFrame(frame))
+		require.NoError(t, b.handleFrame(frame))
+
+		assert.Equal(t, 1, handler.calls)
+		for _, ack := range readACKs(t, &buf) {
+			assert.Equal(t, ackStatusError, ack.Status)
+		}
+	})
+
+	t.Run("Should run the commands with different IDs", func(t *testing.T) {
+		var buf bytes.Buffer
+		handler := &countingHandler{}
+		b := newBoard("pic", logging.NewNoopLogger(), &buf, handler, DefaultConfig())
+
+		require.NoError(t, b.handleFrame([]byte(`{"id":"a","type":3,"data":{}}`)))
+		require.NoError(t, b.handleFrame([]byte(`{"id":"b","type":3,"data":{}}`)))
+
+		assert.Equal(t, 2, handler.calls)
+	})
+}
+
+func TestRecentCommands(t *testing.T) {
+	r := newRecentCommands(2)
+	r.add("a", ackStatusSuccess)
+	r.add("b", ackStatusError)
+
+	status, ok := r.get("b")
+	assert.True(t, ok)
+	assert.Equal(t, ackStatusError, status)
+
+	r.add("c", ackStatusSuccess)
+	_, ok = r.get("a")
+	assert.False(t, ok, "the oldest ID is forgotten")
+	_, ok = r.get("b")
+	assert.True(t, ok)
+
+	r.add("", ackStatusSuccess)
+	_, ok = r.get("")
+	assert.False(t, ok, "a command without ID is never a duplicate")
+}
@@ -0,0 +1,77 @@
+package simulator
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const espCommandTypeCargoDoorMotor uint8 = 0
+
+const (
+	espSyncStateTypeDoor                 uint8 = 0
+	espSyncStateTypeMotor                uint8 = 1
+	espSyncStateTypeQRScanner            uint8 = 2
+	espSyncStateTypeBottomDistanceSensor uint8 = 3
+)
+
+// espHandler simulates the ESP board: the cargo door, the QR scanner
+// and the bottom distance sensor of the cargo.
+type espHandler struct {
+	world *world
+}
+
+func (h espHandler) handleCommand(cmdType uint8, data json.RawMessage) error {
+	if cmdType != espCommandTypeCargoDoorMotor {
+		return fmt.Errorf("invalid ESP command type: %d", cmdType)
+	}
+
+	var temp struct {
+		State  uint8 `json:"state"`
+		Speed  uint8 `json:"speed"`
+		Enable uint8 `json:"enable"`
+	}
+	if err := json.Unmarshal(data, &temp); err != nil {
+		return fmt.Errorf("failed to unmarshal cargo door motor: %w", err)
+	}
+	if temp.State > 1 {
+		return fmt.Errorf("invalid cargo door motor state: %d", temp.State)
+	}
+	if temp.Speed > 100 {
+		return fmt.Errorf("invalid cargo door motor speed: %d", temp.Speed)
+	}
+
+	w := h.world
+	w.mu.Lock()
+	w.door = doorMotor{
+		state:   temp.State,
+		speed:   temp.Speed,
+		enabled: temp.Enable == 1,
+	}
+	w.mu.Unlock()
+
+	return nil
+}
+
+func (h espHandler) syncStates() []syncState {
+	w := h.world
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return []syncState{
+		{stateType: espSyncStateTypeDoor, data: map[string]any{
+			"is_open": w.doorOpen,
+		}},
+		{stateType: espSyncStateTypeMotor, data: map[string]any{
+			"state":      w.door.state,
+			"enabled":    boolToUint8(w.door.enabled),
+			"speed":      w.door.speed,
+			"is_running": boolToUint8(w.doorRunning()),
+		}},
+		{stateType: espSyncStateTypeQRScanner, data: map[string]any{
+			"code": w.cfg.QRCode,
+		}},
+		{stateType: espSyncStateTypeBottomDistanceSensor, data: map[string]any{
+			"under": w.bottomDistance(),
+		}},
+	}
+}
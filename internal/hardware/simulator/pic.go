@@ -0,0 +1,161 @@
+package simulator
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	picCommandTypeBatteryCharge    uint8 = 0
+	picCommandTypeBatteryDischarge uint8 = 1
+	picCommandTypeLiftMotor        uint8 = 2
+	picCommandTypeDriveMotor       uint8 = 3
+)
+
+const (
+	picSyncStateTypeBattery        uint8 = 0
+	picSyncStateTypeCharge         uint8 = 1
+	picSyncStateTypeDischarge      uint8 = 2
+	picSyncStateTypeDistanceSensor uint8 = 3
+	picSyncStateTypeLiftMotor      uint8 = 4
+	picSyncStateTypeDriveMotor     uint8 = 5
+	picSyncStateTypeLimitSwitch1   uint8 = 6
+)
+
+// picHandler simulates the PIC board: the battery, the distance sensors,
+// the lift and drive motors and the limit switch.
+type picHandler struct {
+	world *world
+}
+
+func (h picHandler) handleCommand(cmdType uint8, data json.RawMessage) error {
+	w := h.world
+
+	switch cmdType {
+	case picCommandTypeBatteryCharge, picCommandTypeBatteryDischarge:
+		var temp struct {
+			CurrentLimit uint16 `json:"current_limit"`
+			Enable       uint8  `json:"enable"`
+		}
+		if err := json.Unmarshal(data, &temp); err != nil {
+			return fmt.Errorf("failed to unmarshal battery setting: %w", err)
+		}
+
+		setting := batterySetting{currentLimit: temp.CurrentLimit, enabled: temp.Enable == 1}
+		w.mu.Lock()
+		if cmdType == picCommandTypeBatteryCharge {
+			w.charge = setting
+		} else {
+			w.discharge = setting
+		}
+		w.mu.Unlock()
+
+	case picCommandTypeLiftMotor:
+		var temp struct {
+			TargetPosition uint16 `json:"target_position"`
+			MaxOutput      uint16 `json:"max_output"`
+			Enable         uint8  `json:"enable"`
+		}
+		if err := json.Unmarshal(data, &temp); err != nil {
+			return fmt.Errorf("failed to unmarshal lift motor: %w", err)
+		}
+		if temp.MaxOutput > 100 {
+			return fmt.Errorf("invalid lift motor max output: %d", temp.MaxOutput)
+		}
+
+		w.mu.Lock()
+		w.lift = liftMotor{
+			targetPosition: temp.TargetPosition,
+			maxOutput:      uint8(temp.MaxOutput),
+			enabled:        temp.Enable == 1,
+		}
+		w.mu.Unlock()
+
+	case picCommandTypeDriveMotor:
+		var temp struct {
+			Direction uint8 `json:"direction"`
+			Speed     uint8 `json:"speed"`
+			Enable    uint8 `json:"enable"`
+		}
+		if err := json.Unmarshal(data, &temp); err != nil {
+			return fmt.Errorf("failed to unmarshal drive motor: %w", err)
+		}
+		if temp.Direction > 1 {
+			return fmt.Errorf("invalid drive motor direction: %d", temp.Direction)
+		}
+		if temp.Speed > 100 {
+			return fmt.Errorf("invalid drive motor speed: %d", temp.Speed)
+		}
+
+		w.mu.Lock()
+		w.drive = driveMotor{
+			direction: temp.Direction,
+			speed:     temp.Speed,
+			enabled:   temp.Enable == 1,
+		}
+		w.mu.Unlock()
+
+	default:
+		return fmt.Errorf("invalid PIC command type: %d", cmdType)
+	}
+
+	return nil
+}
+
+func (h picHandler) syncStates() []syncState {
+	w := h.world
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	cellVoltages := make([]uint16, w.cfg.CellCount)
+	for i := range cellVoltages {
+		cellVoltages[i] = w.cellVoltage()
+	}
+
+	return []syncState{
+		{stateType: picSyncStateTypeBattery, data: map[string]any{
+			"current":       w.batteryCurrent(),
+			"temp":          30,
+			"voltage":       uint16(w.cfg.CellCount) * w.cellVoltage(),
+			"cell_voltages": cellVoltages,
+			"percent":       uint8(w.percent),
+			"fault":         0,
+			"health":        100,
+		}},
+		{stateType: picSyncStateTypeCharge, data: map[string]any{
+			"current_limit": w.charge.currentLimit,
+			"enabled":       boolToUint8(w.charge.enabled),
+		}},
+		{stateType: picSyncStateTypeDischarge, data: map[string]any{
+			"current_limit": w.discharge.currentLimit,
+			"enabled":       boolToUint8(w.discharge.enabled),
+		}},
+		{stateType: picSyncStateTypeDistanceSensor, data: map[string]any{
+			"front": w.cfg.FreeDistance,
+			"back":  w.cfg.FreeDistance,
+			"down":  uint16(w.down),
+		}},
+		{stateType: picSyncStateTypeLiftMotor, data: map[string]any{
+			"current_position": uint16(w.down),
+			"target_position":  w.lift.targetPosition,
+			"is_running":       boolToUint8(w.liftRunning()),
+			"enabled":          boolToUint8(w.lift.enabled),
+		}},
+		{stateType: picSyncStateTypeDriveMotor, data: map[string]any{
+			"direction":  w.drive.direction,
+			"speed":      w.drive.speed,
+			"is_running": boolToUint8(w.driveRunning()),
+			"enabled":    boolToUint8(w.drive.enabled),
+		}},
+		{stateType: picSyncStateTypeLimitSwitch1, data: map[string]any{
+			"state": boolToUint8(w.limitSwitchPressed()),
+		}},
+	}
+}
+
+func boolToUint8(b bool) uint8 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,94 @@
+//go:build linux
+
+package simulator
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+// PTY is a pseudo-terminal pair. The simulator uses the master end,
+// raybot opens the slave path as its serial port.
+type PTY struct {
+	Master    *os.File
+	SlavePath string
+
+	// slave is kept open, so reading the master does not fail
+	// while raybot has not opened the slave yet.
+	slave *os.File
+}
+
+// OpenPTY opens a pseudo-terminal pair in raw mode.
+func OpenPTY() (*PTY, error) {
+	master, err := os.OpenFile("/dev/ptmx", os.O_RDWR|syscall.O_NOCTTY, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open ptmx: %w", err)
+	}
+
+	var n uint32
+	if err := ioctl(master.Fd(), syscall.TIOCGPTN, uintptr(unsafe.Pointer(&n))); err != nil {
+		master.Close()
+		return nil, fmt.Errorf("failed to get pty number: %w", err)
+	}
+
+	var unlock int32
+	if err := ioctl(master.Fd(), syscall.TIOCSPTLCK, uintptr(unsafe.Pointer(&unlock))); err != nil {
+		master.Close()
+		return nil, fmt.Errorf("failed to unlock pty: %w", err)
+	}
+
+	slavePath := fmt.Sprintf("/dev/pts/%d", n)
+	slave, err := os.OpenFile(slavePath, os.O_RDWR|syscall.O_NOCTTY, 0)
+	if err != nil {
+		master.Close()
+		return nil, fmt.Errorf("failed to open pty slave: %w", err)
+	}
+
+	if err := makeRaw(slave.Fd()); err != nil {
+		slave.Close()
+		master.Close()
+		return nil, fmt.Errorf("failed to set pty raw mode: %w", err)
+	}
+
+	return &PTY{
+		Master:    master,
+		SlavePath: slavePath,
+		slave:     slave,
+	}, nil
+}
+
+func (p *PTY) Close() error {
+	slaveErr := p.slave.Close()
+	if err := p.Master.Close(); err != nil {
+		return err
+	}
+	return slaveErr
+}
+
+// makeRaw disables the line editing, the echo and the CR LF translation of the terminal.
+func makeRaw(fd uintptr) error {
+	var termios syscall.Termios
+	if err := ioctl(fd, syscall.TCGETS, uintptr(unsafe.Pointer(&termios))); err != nil {
+		return err
+	}
+
+	termios.Iflag &^= syscall.IGNBRK | syscall.BRKINT | syscall.PARMRK | syscall.ISTRIP |
+		syscall.INLCR | syscall.IGNCR | syscall.ICRNL | syscall.IXON
+	termios.Oflag &^= syscall.OPOST
+	termios.Lflag &^= syscall.ECHO | syscall.ECHONL | syscall.ICANON | syscall.ISIG | syscall.IEXTEN
+	termios.Cflag &^= syscall.CSIZE | syscall.PARENB
+	termios.Cflag |= syscall.CS8
+	termios.Cc[syscall.VMIN] = 1
+	termios.Cc[syscall.VTIME] = 0
+
+	return ioctl(fd, syscall.TCSETS, uintptr(unsafe.Pointer(&termios)))
+}
+
+func ioctl(fd, req, arg uintptr) error {
+	if _, _, errno := syscall.Syscall(syscall.SYS_IOCTL, fd, req, arg); errno != 0 {
+		return errno
+	}
+	return nil
+}
@@ -0,0 +1,24 @@
+//go:build !linux
+
+package simulator
+
+import (
+	"errors"
+	"os"
+)
+
+// PTY is a pseudo-terminal pair. The simulator uses the master end,
+// raybot opens the slave path as its serial port.
+type PTY struct {
+	Master    *os.File
+	SlavePath string
+}
+
+// OpenPTY is only supported on Linux.
+func OpenPTY() (*PTY, error) {
+	return nil, errors.New("pseudo-terminals are only supported on linux")
+}
+
+func (p *PTY) Close() error {
+	return p.Master.Close()
+}
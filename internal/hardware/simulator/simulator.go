@@ -0,0 +1,241 @@
+// Package simulator simulates the PIC and ESP boards of the robot. It speaks the same
+// UART protocol as the boards, so raybot can run without the hardware: the commands
+// are answered with ACKs and the states are synced from a simple physics model.
+package simulator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/tbe-team/raybot/internal/config"
+)
+
+// Tag is an RFID tag placed along the track.
+type Tag struct {
+	Location string
+	// Position is the position of the tag on the track in cm.
+	Position float64
+}
+
+type Config struct {
+	Tags []Tag
+	// StartPosition is the position of the robot on the track in cm.
+	StartPosition float64
+	// DriveSpeed is the speed of the robot in cm/s at the drive motor speed 100.
+	DriveSpeed float64
+
+	// LiftSpeed is the speed of the lift in cm/s at the max output 100.
+	LiftSpeed float64
+	// LiftTop is the down distance at the top limit switch. The lift can not go higher.
+	LiftTop uint16
+	// LiftBottom is the lowest down distance of the lift.
+	LiftBottom uint16
+	// GroundDistance is the down distance of the ground. The bottom distance
+	// sensor of the cargo reads the ground distance minus the down distance.
+	GroundDistance uint16
+	// FreeDistance is the distance read by the front and back distance sensors.
+	FreeDistance uint16
+
+	// DoorTravelTime is the time to open or close the cargo door at the speed 100.
+	DoorTravelTime time.Duration
+	QRCode         string
+
+	CellCount int
+	// BatteryPercent is the initial battery percent.
+	BatteryPercent float64
+	// DrainRate is the battery percent used per minute while a motor is running.
+	DrainRate float64
+	// ChargeRate is the battery percent charged per minute while charging.
+	ChargeRate float64
+
+	// TickInterval is the interval of the physics steps.
+	TickInterval time.Duration
+	// SyncInterval is the interval of the sync state messages.
+	SyncInterval time.Duration
+	// Framing is the frame format of the PIC and ESP links.
+	Framing config.SerialFraming
+	// Codec is the encoding of the messages of the PIC and ESP links.
+	Codec config.SerialCodec
+	// ACKDropRate is the fraction of the ACKs dropped, to exercise the command resends
+	// of the host. A resent command is not run again, only acknowledged.
+	ACKDropRate float64
+}
+
+// DefaultConfig returns a config of a short track with three tags.
+func DefaultConfig() Config {
+	return Config{
+		Tags: []Tag{
+			{Location: "A", Position: 0},
+			{Location: "B", Position: 100},
+			{Location: "C", Position: 200},
+		},
+		StartPosition:  0,
+		DriveSpeed:     50,
+		LiftSpeed:      20,
+		LiftTop:        10,
+		LiftBottom:     160,
+		GroundDistance: 200,
+		FreeDistance:   300,
+		DoorTravelTime: 2 * time.Second,
+		QRCode:         "SIM-QR",
+		CellCount:      4,
+		BatteryPercent: 80,
+		DrainRate:      1,
+		ChargeRate:     5,
+		TickInterval:   50 * time.Millisecond,
+		SyncInterval:   200 * time.Millisecond,
+		Framing:        config.SerialFramingLegacy,
+		Codec:          config.SerialCodecJSON,
+	}
+}
+
+func (c Config) Validate() error {
+	seen := make(map[string]struct{}, len(c.Tags))
+	for _, tag := range c.Tags {
+		if tag.Location == "" {
+			return errors.New("tag location is required")
+		}
+		if _, ok := seen[tag.Location]; ok {
+			return fmt.Errorf("duplicate tag location: %s", tag.Location)
+		}
+		seen[tag.Location] = struct{}{}
+	}
+
+	if c.DriveSpeed <= 0 || c.LiftSpeed <= 0 {
+		return errors.New("drive speed and lift speed must be greater than 0")
+	}
+
+	if c.LiftTop >= c.LiftBottom || c.LiftBottom > c.GroundDistance {
+		return errors.New("lift top must be less than lift bottom and lift bottom must not exceed ground distance")
+	}
+
+	if c.DoorTravelTime <= 0 {
+		return errors.New("door travel time must be greater than 0")
+	}
+
+	if c.CellCount <= 0 {
+		return errors.New("cell count must be greater than 0")
+	}
+
+	if c.BatteryPercent < 0 || c.BatteryPercent > 100 {
+		return errors.New("battery percent must be between 0 and 100")
+	}
+
+	if c.TickInterval <= 0 || c.SyncInterval <= 0 {
+		return errors.New("tick interval and sync interval must be greater than 0")
+	}
+
+	if c.Framing != config.SerialFramingLegacy && c.Framing != config.SerialFramingCRC16 {
+		return fmt.Errorf("invalid framing: %s", c.Framing)
+	}
+
+	if c.Codec != config.SerialCodecJSON && c.Codec != config.SerialCodecMsgpack {
+		return fmt.Errorf("invalid codec: %s", c.Codec)
+	}
+	if c.Codec == config.SerialCodecMsgpack && c.Framing != config.SerialFramingCRC16 {
+		return fmt.Errorf("codec %s requires the %s framing", c.Codec, config.SerialFramingCRC16)
+	}
+
+	if c.ACKDropRate < 0 || c.ACKDropRate >= 1 {
+		return errors.New("ack drop rate must be at least 0 and less than 1")
+	}
+
+	return nil
+}
+
+// Ports are the ends of the serial links the simulator uses.
+// A nil port disables its board.
+type Ports struct {
+	PIC io.ReadWriter
+	ESP io.ReadWriter
+	// RFID receives the location of every tag the robot passes, one tag per line.
+	// It is optional.
+	RFID io.Writer
+}
+
+type Simulator struct {
+	cfg   Config
+	log   *slog.Logger
+	world *world
+}
+
+func New(cfg Config, log *slog.Logger) (*Simulator, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("validate config: %w", err)
+	}
+
+	return &Simulator{
+		cfg:   cfg,
+		log:   log.With("service", "simulator"),
+		world: newWorld(cfg),
+	}, nil
+}
+
+// Run runs the boards and the physics until the context is done.
+// The reads of the ports are not interrupted, the caller closes
+// the ports after Run returns.
+func (s *Simulator) Run(ctx context.Context, ports Ports) error {
+	g, ctx := errgroup.WithContext(ctx)
+
+	if ports.PIC != nil {
+		pic := newBoard("pic", s.log, ports.PIC, picHandler{world: s.world}, s.cfg)
+		g.Go(func() error { return pic.run(ctx) })
+	}
+	if ports.ESP != nil {
+		esp := newBoard("esp", s.log, ports.ESP, espHandler{world: s.world}, s.cfg)
+		g.Go(func() error { return esp.run(ctx) })
+	}
+	g.Go(func() error { return s.runPhysics(ctx, ports.RFID) })
+
+	if err := g.Wait(); err != nil && !errors.Is(err, context.Canceled) {
+		return err
+	}
+	return nil
+}
+
+func (s *Simulator) runPhysics(ctx context.Context, rfid io.Writer) error {
+	if tag, ok := s.world.tagAtPosition(); ok {
+		if err := s.writeTag(rfid, tag); err != nil {
+			return err
+		}
+	}
+
+	ticker := time.NewTicker(s.cfg.TickInterval)
+	defer ticker.Stop()
+
+	last := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case now := <-ticker.C:
+			tags := s.world.step(now.Sub(last))
+			last = now
+
+			for _, tag := range tags {
+				if err := s.writeTag(rfid, tag); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+func (s *Simulator) writeTag(rfid io.Writer, tag string) error {
+	s.log.Debug("tag passed", slog.String("location", tag))
+	if rfid == nil {
+		return nil
+	}
+
+	if _, err := io.WriteString(rfid, tag+"\r\n"); err != nil {
+		return fmt.Errorf("failed to write RFID tag: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,199 @@
+package simulator
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tbe-team/raybot/internal/config"
+	"github.com/tbe-team/raybot/internal/hardware/picserial"
+	"github.com/tbe-team/raybot/internal/logging"
+)
+
+type testMessage struct {
+	Type      uint8           `json:"type"`
+	ID        string          `json:"id"`
+	Status    uint8           `json:"status"`
+	StateType uint8           `json:"state_type"`
+	Data      json.RawMessage `json:"data"`
+}
+
+func newTestSimulator(t *testing.T) *Simulator {
+	cfg := DefaultConfig()
+	cfg.Tags = []Tag{
+		{Location: "A", Position: 0},
+		{Location: "B", Position: 5},
+	}
+	cfg.TickInterval = 10 * time.Millisecond
+	cfg.SyncInterval = 50 * time.Millisecond
+
+	s, err := New(cfg, logging.NewNoopLogger())
+	require.NoError(t, err)
+	return s
+}
+
+// readMessage reads the frames until one matches.
+func readMessage(t *testing.T, r *bufio.Reader, match func(testMessage) bool) testMessage {
+	t.Helper()
+
+	for {
+		line, err := r.ReadString('\n')
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(line, ">"))
+		require.True(t, strings.HasSuffix(line, "\r\n"))
+
+		var msg testMessage
+		require.NoError(t, json.Unmarshal([]byte(strings.TrimSpace(line[1:])), &msg))
+		if match(msg) {
+			return msg
+		}
+	}
+}
+
+func TestSimulator_Run(t *testing.T) {
+	t.Run("Should ACK the commands and pass the tags", func(t *testing.T) {
+		s := newTestSimulator(t)
+		ctx, cancel := context.WithCancel(context.Background())
+
+		picApp, picSim := net.Pipe()
+		rfidApp, rfidSim := net.Pipe()
+		doneCh := make(chan error, 1)
+		go func() {
+			doneCh <- s.Run(ctx, Ports{PIC: picSim, RFID: rfidSim})
+		}()
+		t.Cleanup(func() {
+			cancel()
+			require.NoError(t, <-doneCh)
+			picApp.Close()
+			rfidApp.Close()
+		})
+
+		picReader := bufio.NewReader(picApp)
+		rfidReader := bufio.NewReader(rfidApp)
+
+		// The robot starts at the tag A.
+		tag, err := rfidReader.ReadString('\n')
+		require.NoError(t, err)
+		assert.Equal(t, "A\r\n", tag)
+
+		go func() {
+			_, _ = picApp.Write([]byte(`>{"id":"1","type":3,"data":{"direction":0,"speed":100,"enable":1}}` + "\r\n"))
+		}()
+
+		ack := readMessage(t, picReader, func(m testMessage) bool { return m.Type == messageTypeACK })
+		assert.Equal(t, "1", ack.ID)
+		assert.Equal(t, ackStatusSuccess, ack.Status)
+
+		drive := readMessage(t, picReader, func(m testMessage) bool {
+			return m.Type == messageTypeSyncState && m.StateType == picSyncStateTypeDriveMotor
+		})
+		assert.JSONEq(t, `{"direction":0,"speed":100,"is_running":1,"enabled":1}`, string(drive.Data))
+
+		go func() { _, _ = io.Copy(io.Discard, picReader) }()
+
+		tag, err = rfidReader.ReadString('\n')
+		require.NoError(t, err)
+		assert.Equal(t, "B\r\n", tag)
+
+		go func() { _, _ = io.Copy(io.Discard, rfidReader) }()
+	})
+
+	t.Run("Should NACK an invalid command", func(t *testing.T) {
+		s := newTestSimulator(t)
+		ctx, cancel := context.WithCancel(context.Background())
+
+		picApp, picSim := net.Pipe()
+		doneCh := make(chan error, 1)
+		go func() {
+			doneCh <- s.Run(ctx, Ports{PIC: picSim})
+		}()
+		t.Cleanup(func() {
+			cancel()
+			require.NoError(t, <-doneCh)
+			picApp.Close()
+		})
+
+		go func() {
+			_, _ = picApp.Write([]byte(`>{"id":"2","type":9,"data":{}}` + "\r\n"))
+		}()
+
+		picReader := bufio.NewReader(picApp)
+		ack := readMessage(t, picReader, func(m testMessage) bool { return m.Type == messageTypeACK })
+		assert.Equal(t, "2", ack.ID)
+		assert.Equal(t, ackStatusError, ack.Status)
+
+		go func() { _, _ = io.Copy(io.Discard, picReader) }()
+	})
+}
+
+func TestSimulator_PTY(t *testing.T) {
+	links := []struct {
+		framing config.SerialFraming
+		codec   config.SerialCodec
+	}{
+		{framing: config.SerialFramingLegacy, codec: config.SerialCodecJSON},
+		{framing: config.SerialFramingCRC16, codec: config.SerialCodecJSON},
+		{framing: config.SerialFramingCRC16, codec: config.SerialCodecMsgpack},
+	}
+
+	for _, link := range links {
+		t.Run(string(link.framing)+" "+string(link.codec), func(t *testing.T) {
+			pty, err := OpenPTY()
+			if err != nil {
+				t.Skipf("pseudo-terminal not available: %v", err)
+			}
+			t.Cleanup(func() { pty.Close() })
+
+			s := newTestSimulator(t)
+			s.cfg.Framing = link.framing
+			s.cfg.Codec = link.codec
+			ctx, cancel := context.WithCancel(context.Background())
+			doneCh := make(chan error, 1)
+			go func() {
+				doneCh <- s.Run(ctx, Ports{PIC: pty.Master})
+			}()
+			t.Cleanup(func() {
+				cancel()
+				require.NoError(t, <-doneCh)
+			})
+
+			client := picserial.NewClient(config.Serial{
+				Port:        pty.SlavePath,
+				BaudRate:    9600,
+				DataBits:    8,
+				StopBits:    1,
+				Parity:      "NONE",
+				ReadTimeout: time.Second,
+				Framing:     link.framing,
+				Codec:       link.codec,
+			})
+			require.NoError(t, client.Open())
+			t.Cleanup(func() { client.Close() })
+
+			readCtx, readCancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer readCancel()
+
+			require.NoError(t, client.Write(readCtx, []byte(`{"id":"3","type":2,"data":{"target_position":50,"max_output":100,"enable":1}}`)))
+
+			for {
+				data, err := client.Read(readCtx)
+				require.NoError(t, err)
+
+				var msg testMessage
+				require.NoError(t, json.Unmarshal(data, &msg))
+				if msg.Type == messageTypeACK && msg.ID == "3" {
+					assert.Equal(t, ackStatusSuccess, msg.Status)
+					return
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package simulator
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// runningCurrent is the battery current in mA while a motor is running.
+	runningCurrent = 1500
+	// idleCurrent is the battery current in mA while no motor is running.
+	idleCurrent = 200
+)
+
+type driveMotor struct {
+	direction uint8 // 0: forward, 1: backward
+	speed     uint8
+	enabled   bool
+}
+
+type liftMotor struct {
+	targetPosition uint16
+	maxOutput      uint8
+	enabled        bool
+}
+
+type doorMotor struct {
+	state   uint8 // 0: close, 1: open
+	speed   uint8
+	enabled bool
+}
+
+type batterySetting struct {
+	currentLimit uint16
+	enabled      bool
+}
+
+// world is the physics model of the robot shared by the boards.
+type world struct {
+	cfg  Config
+	tags []Tag
+
+	mu        sync.Mutex
+	drive     driveMotor
+	position  float64
+	lift      liftMotor
+	down      float64
+	door      doorMotor
+	doorOpen  bool
+	doorTrip  float64 // 0: closed, 1: open
+	percent   float64
+	charge    batterySetting
+	discharge batterySetting
+}
+
+func newWorld(cfg Config) *world {
+	tags := make([]Tag, len(cfg.Tags))
+	copy(tags, cfg.Tags)
+	sort.Slice(tags, func(i, j int) bool { return tags[i].Position < tags[j].Position })
+
+	return &world{
+		cfg:      cfg,
+		tags:     tags,
+		position: cfg.StartPosition,
+		down:     float64(cfg.LiftTop),
+		percent:  cfg.BatteryPercent,
+	}
+}
+
+// step advances the world by dt and returns the locations
+// of the tags passed in the direction of travel.
+func (w *world) step(dt time.Duration) []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	seconds := dt.Seconds()
+	var passed []string
+
+	if w.driveRunning() {
+		prev := w.position
+		distance := w.cfg.DriveSpeed * float64(w.drive.speed) / 100 * seconds
+		if w.drive.direction == 0 {
+			w.position += distance
+			for _, tag := range w.tags {
+				if tag.Position > prev && tag.Position <= w.position {
+					passed = append(passed, tag.Location)
+				}
+			}
+		} else {
+			w.position -= distance
+			for i := len(w.tags) - 1; i >= 0; i-- {
+				if w.tags[i].Position < prev && w.tags[i].Position >= w.position {
+					passed = append(passed, w.tags[i].Location)
+				}
+			}
+		}
+	}
+
+	if w.liftRunning() {
+		target := w.liftTarget()
+		distance := w.cfg.LiftSpeed * float64(w.lift.maxOutput) / 100 * seconds
+		if w.down < target {
+			w.down = math.Min(w.down+distance, target)
+		} else {
+			w.down = math.Max(w.down-distance, target)
+		}
+	}
+
+	if w.doorRunning() {
+		distance := float64(w.door.speed) / 100 * seconds / w.cfg.DoorTravelTime.Seconds()
+		if w.door.state == 1 {
+			w.doorTrip = math.Min(w.doorTrip+distance, 1)
+			if w.doorTrip == 1 {
+				w.doorOpen = true
+			}
+		} else {
+			w.doorTrip = math.Max(w.doorTrip-distance, 0)
+			if w.doorTrip == 0 {
+				w.doorOpen = false
+			}
+		}
+	}
+
+	if w.driveRunning() || w.liftRunning() || w.doorRunning() {
+		w.percent = math.Max(w.percent-w.cfg.DrainRate*seconds/60, 0)
+	}
+	if w.charge.enabled {
+		w.percent = math.Min(w.percent+w.cfg.ChargeRate*seconds/60, 100)
+	}
+
+	return passed
+}
+
+// tagAtPosition returns the location of the tag at the robot position.
+func (w *world) tagAtPosition() (string, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for _, tag := range w.tags {
+		if tag.Position == w.position {
+			return tag.Location, true
+		}
+	}
+	return "", false
+}
+
+func (w *world) driveRunning() bool {
+	return w.drive.enabled && w.drive.speed > 0
+}
+
+// liftTarget returns the target down distance clamped to the travel of the lift.
+func (w *world) liftTarget() float64 {
+	target := max(w.lift.targetPosition, w.cfg.LiftTop)
+	target = min(target, w.cfg.LiftBottom)
+	return float64(target)
+}
+
+func (w *world) liftRunning() bool {
+	return w.lift.enabled && w.lift.maxOutput > 0 && w.down != w.liftTarget()
+}
+
+func (w *world) doorRunning() bool {
+	if !w.door.enabled || w.door.speed == 0 {
+		return false
+	}
+	if w.door.state == 1 {
+		return w.doorTrip < 1
+	}
+	return w.doorTrip > 0
+}
+
+func (w *world) limitSwitchPressed() bool {
+	return w.down <= float64(w.cfg.LiftTop)
+}
+
+func (w *world) bottomDistance() uint16 {
+	return uint16(math.Max(float64(w.cfg.GroundDistance)-w.down, 0))
+}
+
+// cellVoltage returns the voltage of a cell in mV, from 3300 mV when empty to 4200 mV when full.
+func (w *world) cellVoltage() uint16 {
+	return uint16(3300 + w.percent*9)
+}
+
+func (w *world) batteryCurrent() uint16 {
+	switch {
+	case w.charge.enabled:
+		return w.charge.currentLimit
+	case w.driveRunning() || w.liftRunning() || w.doorRunning():
+		return runningCurrent
+	default:
+		return idleCurrent
+	}
+}
@@ -0,0 +1,99 @@
+package simulator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorld_Step(t *testing.T) {
+	t.Run("Should pass the tags in the direction of travel", func(t *testing.T) {
+		w := newWorld(DefaultConfig())
+		w.drive = driveMotor{direction: 0, speed: 100, enabled: true}
+
+		// 50 cm/s for 3 seconds
+		passed := w.step(3 * time.Second)
+		assert.Equal(t, []string{"B"}, passed)
+		assert.InDelta(t, 150, w.position, 0.001)
+
+		w.drive.direction = 1
+		passed = w.step(3 * time.Second)
+		assert.Equal(t, []string{"B", "A"}, passed)
+		assert.InDelta(t, 0, w.position, 0.001)
+	})
+
+	t.Run("Should not move when the drive motor is disabled", func(t *testing.T) {
+		w := newWorld(DefaultConfig())
+		w.drive = driveMotor{direction: 0, speed: 100, enabled: false}
+
+		passed := w.step(time.Second)
+		assert.Empty(t, passed)
+		assert.Zero(t, w.position)
+	})
+
+	t.Run("Should move the lift to the clamped target", func(t *testing.T) {
+		cfg := DefaultConfig()
+		w := newWorld(cfg)
+		w.lift = liftMotor{targetPosition: 1000, maxOutput: 100, enabled: true}
+
+		for range 20 {
+			w.step(time.Second)
+		}
+		assert.Equal(t, float64(cfg.LiftBottom), w.down)
+		assert.False(t, w.liftRunning())
+		assert.False(t, w.limitSwitchPressed())
+		assert.Equal(t, cfg.GroundDistance-cfg.LiftBottom, w.bottomDistance())
+
+		w.lift.targetPosition = 0
+		for range 20 {
+			w.step(time.Second)
+		}
+		assert.Equal(t, float64(cfg.LiftTop), w.down)
+		assert.True(t, w.limitSwitchPressed())
+	})
+
+	t.Run("Should report the door open at the end of its travel", func(t *testing.T) {
+		w := newWorld(DefaultConfig())
+		w.door = doorMotor{state: 1, speed: 100, enabled: true}
+
+		w.step(time.Second)
+		assert.False(t, w.doorOpen)
+		w.step(time.Second)
+		assert.True(t, w.doorOpen)
+
+		w.door.state = 0
+		w.step(time.Second)
+		assert.True(t, w.doorOpen)
+		w.step(time.Second)
+		assert.False(t, w.doorOpen)
+	})
+
+	t.Run("Should drain the battery while running and charge it while charging", func(t *testing.T) {
+		cfg := DefaultConfig()
+		w := newWorld(cfg)
+		w.drive = driveMotor{speed: 10, enabled: true}
+
+		w.step(time.Minute)
+		assert.InDelta(t, cfg.BatteryPercent-cfg.DrainRate, w.percent, 0.001)
+
+		w.drive.enabled = false
+		w.charge = batterySetting{currentLimit: 1000, enabled: true}
+		w.step(time.Minute)
+		assert.InDelta(t, cfg.BatteryPercent-cfg.DrainRate+cfg.ChargeRate, w.percent, 0.001)
+		assert.Equal(t, uint16(1000), w.batteryCurrent())
+	})
+}
+
+func TestConfig_Validate(t *testing.T) {
+	require.NoError(t, DefaultConfig().Validate())
+
+	cfg := DefaultConfig()
+	cfg.Tags = append(cfg.Tags, Tag{Location: "A", Position: 300})
+	require.Error(t, cfg.Validate())
+
+	cfg = DefaultConfig()
+	cfg.LiftBottom = cfg.LiftTop
+	require.Error(t, cfg.Validate())
+}
@@ -2,7 +2,12 @@ package apperrorcodeimpl
 
 import (
 	"github.com/tbe-team/raybot/internal/services/apperrorcode"
+	"github.com/tbe-team/raybot/internal/services/battery"
+	"github.com/tbe-team/raybot/internal/services/batterypolicy"
 	"github.com/tbe-team/raybot/internal/services/command"
+	configservice "github.com/tbe-team/raybot/internal/services/config"
+	"github.com/tbe-team/raybot/internal/services/schedule"
+	"github.com/tbe-team/raybot/internal/services/trackmap"
 	"github.com/tbe-team/raybot/pkg/xerror"
 )
 
@@ -11,6 +16,32 @@ func init() {
 	register(command.ErrNoNextExecutableCommand)
 	register(command.ErrNoCommandBeingProcessed)
 	register(command.ErrCommandInProcessingCanNotBeDeleted)
+	register(command.ErrCommandDeadlineInThePast)
+	register(command.ErrCommandTimedOut)
+	register(command.ErrInvalidRetryPolicy)
+	register(command.ErrCommandNotQueued)
+	register(command.ErrMoveToOvershoot)
+
+	register(schedule.ErrScheduleNotFound)
+	register(schedule.ErrInvalidCronExpression)
+	register(schedule.ErrRunAtInThePast)
+
+	register(trackmap.ErrTrackMapEmpty)
+	register(trackmap.ErrTagNotFound)
+	register(trackmap.ErrDuplicateTag)
+	register(trackmap.ErrDuplicateStationName)
+	register(trackmap.ErrInvalidTrackMapFile)
+	register(trackmap.ErrInvalidScanCommand)
+	register(trackmap.ErrScanCommandHasNoResult)
+	register(trackmap.ErrChargerTagRemoved)
+
+	register(battery.ErrCanNotControlBattery)
+	register(battery.ErrCurrentLimitExceeded)
+	register(battery.ErrSettingNotConfirmed)
+
+	register(batterypolicy.ErrBatteryCritical)
+
+	register(configservice.ErrChargerLocationNotInTrackMap)
 }
 
 var errorCodes = []apperrorcode.ErrorCode{}
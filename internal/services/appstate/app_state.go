@@ -21,6 +21,8 @@ type UpdateESPSerialConnectionParams struct {
 	SetLastConnectedAt bool
 	Error              *string
 	SetError           bool
+	ReconnectCount     uint32
+	SetReconnectCount  bool
 }
 
 type UpdatePICSerialConnectionParams struct {
@@ -30,6 +32,8 @@ type UpdatePICSerialConnectionParams struct {
 	SetLastConnectedAt bool
 	Error              *string
 	SetError           bool
+	ReconnectCount     uint32
+	SetReconnectCount  bool
 }
 
 type UpdateRFIDUSBConnectionParams struct {
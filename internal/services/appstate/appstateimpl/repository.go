@@ -76,6 +76,9 @@ func (r *repository) UpdateESPSerialConnection(_ context.Context, params appstat
 	if params.SetError {
 		espSerialConnection.Error = params.Error
 	}
+	if params.SetReconnectCount {
+		espSerialConnection.ReconnectCount = params.ReconnectCount
+	}
 
 	r.mu.Lock()
 	r.appState.ESPSerialConnection = espSerialConnection
@@ -100,6 +103,9 @@ func (r *repository) UpdatePICSerialConnection(_ context.Context, params appstat
 	if params.SetError {
 		picSerialConnection.Error = params.Error
 	}
+	if params.SetReconnectCount {
+		picSerialConnection.ReconnectCount = params.ReconnectCount
+	}
 
 	r.mu.Lock()
 	r.appState.PICSerialConnection = picSerialConnection
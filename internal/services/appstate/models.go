@@ -23,6 +23,8 @@ type ESPSerialConnection struct {
 	Connected       bool
 	LastConnectedAt *time.Time
 	Error           *string
+	// ReconnectCount is the number of times the lost link was opened again.
+	ReconnectCount uint32
 }
 
 func (c ESPSerialConnection) ServiceInitialized() bool {
@@ -33,6 +35,8 @@ type PICSerialConnection struct {
 	Connected       bool
 	LastConnectedAt *time.Time
 	Error           *string
+	// ReconnectCount is the number of times the lost link was opened again.
+	ReconnectCount uint32
 }
 
 func (c PICSerialConnection) ServiceInitialized() bool {
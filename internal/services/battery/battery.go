@@ -1,6 +1,21 @@
 package battery
 
-import "context"
+import (
+	"context"
+
+	"github.com/tbe-team/raybot/internal/hardware/picserial"
+	"github.com/tbe-team/raybot/pkg/xerror"
+)
+
+var (
+	ErrCanNotControlBattery = xerror.BadRequest(picserial.ErrPICSerialNotConnected, "battery.canNotControl", "can not control battery")
+
+	// ErrCurrentLimitExceeded is the error of a current limit above the maximum of the command config.
+	ErrCurrentLimitExceeded = xerror.BadRequest(nil, "battery.currentLimitExceeded", "current limit exceeded")
+
+	// ErrSettingNotConfirmed is the error of a setting that the PIC did not report in its sync state in time.
+	ErrSettingNotConfirmed = xerror.Timeout(nil, "battery.settingNotConfirmed", "battery setting not confirmed")
+)
 
 type UpdateBatteryStateParams struct {
 	Current      uint16 `validate:"min=0"`
@@ -22,16 +37,39 @@ type UpdateDischargeSettingParams struct {
 	Enabled      bool
 }
 
+type SetChargeSettingParams struct {
+	CurrentLimit uint16 `validate:"required_if=Enabled true"`
+	Enabled      bool
+}
+
+type SetDischargeSettingParams struct {
+	CurrentLimit uint16 `validate:"required_if=Enabled true"`
+	Enabled      bool
+}
+
 type Service interface {
+	// UpdateBatteryState, UpdateChargeSetting and UpdateDischargeSetting update the state
+	// reported by the PIC. They do not directly interact with the hardware.
+	// UpdateBatteryState also diagnoses the battery and raises an alert on new faults
+	// and cells outside the configured bounds.
 	UpdateBatteryState(ctx context.Context, params UpdateBatteryStateParams) error
 	UpdateChargeSetting(ctx context.Context, params UpdateChargeSettingParams) error
 	UpdateDischargeSetting(ctx context.Context, params UpdateDischargeSettingParams) error
+
+	// SetChargeSetting sends the charge setting to the hardware.
+	// The setting is updated once the PIC reports it in its sync state.
+	SetChargeSetting(ctx context.Context, params SetChargeSettingParams) error
+
+	// SetDischargeSetting sends the discharge setting to the hardware.
+	// The setting is updated once the PIC reports it in its sync state.
+	SetDischargeSetting(ctx context.Context, params SetDischargeSettingParams) error
 }
 
 //nolint:revive
 type BatteryStateRepository interface {
 	GetBatteryState(ctx context.Context) (BatteryState, error)
 	UpdateBatteryState(ctx context.Context, params UpdateBatteryStateParams) error
+	UpdateDiagnostics(ctx context.Context, diagnostics Diagnostics) error
 }
 
 type SettingRepository interface {
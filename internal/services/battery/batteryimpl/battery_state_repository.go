@@ -17,6 +17,10 @@ func NewBatteryStateRepository() battery.BatteryStateRepository {
 	return &batteryStateRepository{
 		battery: battery.BatteryState{
 			CellVoltages: []uint16{0, 0, 0, 0}, // avoid nil
+			Diagnostics: battery.Diagnostics{
+				Faults:           []battery.Fault{},
+				OutOfBoundsCells: []int{},
+			},
 		},
 	}
 }
@@ -40,7 +44,16 @@ func (r *batteryStateRepository) UpdateBatteryState(_ context.Context, params ba
 		Percent:      params.Percent,
 		Fault:        params.Fault,
 		Health:       params.Health,
+		Diagnostics:  r.battery.Diagnostics,
 		UpdatedAt:    time.Now(),
 	}
 	return nil
 }
+
+func (r *batteryStateRepository) UpdateDiagnostics(_ context.Context, diagnostics battery.Diagnostics) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.battery.Diagnostics = diagnostics
+	return nil
+}
@@ -2,28 +2,49 @@ package batteryimpl
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
 
+	"github.com/tbe-team/raybot/internal/events"
+	"github.com/tbe-team/raybot/internal/hardware/controller"
+	"github.com/tbe-team/raybot/internal/hardware/picserial"
 	"github.com/tbe-team/raybot/internal/services/battery"
+	configservice "github.com/tbe-team/raybot/internal/services/config"
+	"github.com/tbe-team/raybot/pkg/eventbus"
 	"github.com/tbe-team/raybot/pkg/validator"
 )
 
 type service struct {
-	validator validator.Validator
+	log           *slog.Logger
+	validator     validator.Validator
+	publisher     eventbus.Publisher
+	configService configservice.Service
 
-	batteryStateRepo battery.BatteryStateRepository
-	settingRepo      battery.SettingRepository
+	batteryStateRepo  battery.BatteryStateRepository
+	settingRepo       battery.SettingRepository
+	batteryController controller.BatteryController
 }
 
 func NewService(
+	log *slog.Logger,
 	validator validator.Validator,
+	publisher eventbus.Publisher,
+	configService configservice.Service,
 	repo battery.BatteryStateRepository,
 	settingRepo battery.SettingRepository,
+	batteryController controller.BatteryController,
 ) battery.Service {
 	return &service{
-		validator:        validator,
-		batteryStateRepo: repo,
-		settingRepo:      settingRepo,
+		log:               log.With("service", "battery"),
+		validator:         validator,
+		publisher:         publisher,
+		configService:     configService,
+		batteryStateRepo:  repo,
+		settingRepo:       settingRepo,
+		batteryController: batteryController,
 	}
 }
 
@@ -32,7 +53,36 @@ func (s service) UpdateBatteryState(ctx context.Context, params battery.UpdateBa
 		return fmt.Errorf("validate params: %w", err)
 	}
 
-	return s.batteryStateRepo.UpdateBatteryState(ctx, params)
+	prev, err := s.batteryStateRepo.GetBatteryState(ctx)
+	if err != nil {
+		return fmt.Errorf("get battery state: %w", err)
+	}
+
+	if err := s.batteryStateRepo.UpdateBatteryState(ctx, params); err != nil {
+		return fmt.Errorf("update battery state: %w", err)
+	}
+
+	hardwareCfg, err := s.configService.GetHardwareConfig(ctx)
+	if err != nil {
+		return fmt.Errorf("get hardware config: %w", err)
+	}
+
+	diagnostics := battery.Diagnose(params.Fault, params.CellVoltages, hardwareCfg.BatteryCells)
+	if err := s.batteryStateRepo.UpdateDiagnostics(ctx, diagnostics); err != nil {
+		return fmt.Errorf("update battery diagnostics: %w", err)
+	}
+
+	s.publishDiagnosticsAlerts(prev.Diagnostics, diagnostics, params.Percent)
+
+	s.publisher.Publish(events.BatteryStateUpdatedTopic, eventbus.NewMessage(
+		events.BatteryStateUpdatedEvent{
+			Percent: params.Percent,
+			Voltage: params.Voltage,
+			Current: params.Current,
+		},
+	))
+
+	return nil
 }
 
 func (s service) UpdateChargeSetting(ctx context.Context, params battery.UpdateChargeSettingParams) error {
@@ -40,7 +90,18 @@ func (s service) UpdateChargeSetting(ctx context.Context, params battery.UpdateC
 		return fmt.Errorf("validate params: %w", err)
 	}
 
-	return s.settingRepo.UpdateChargeSetting(ctx, params)
+	if err := s.settingRepo.UpdateChargeSetting(ctx, params); err != nil {
+		return fmt.Errorf("update charge setting: %w", err)
+	}
+
+	s.publisher.Publish(events.BatteryChargeSettingUpdatedTopic, eventbus.NewMessage(
+		events.BatteryChargeSettingUpdatedEvent{
+			CurrentLimit: params.CurrentLimit,
+			Enabled:      params.Enabled,
+		},
+	))
+
+	return nil
 }
 
 func (s service) UpdateDischargeSetting(ctx context.Context, params battery.UpdateDischargeSettingParams) error {
@@ -48,5 +109,89 @@ func (s service) UpdateDischargeSetting(ctx context.Context, params battery.Upda
 		return fmt.Errorf("validate params: %w", err)
 	}
 
-	return s.settingRepo.UpdateDischargeSetting(ctx, params)
+	if err := s.settingRepo.UpdateDischargeSetting(ctx, params); err != nil {
+		return fmt.Errorf("update discharge setting: %w", err)
+	}
+
+	s.publisher.Publish(events.BatteryDischargeSettingUpdatedTopic, eventbus.NewMessage(
+		events.BatteryDischargeSettingUpdatedEvent{
+			CurrentLimit: params.CurrentLimit,
+			Enabled:      params.Enabled,
+		},
+	))
+
+	return nil
+}
+
+func (s service) SetChargeSetting(ctx context.Context, params battery.SetChargeSettingParams) error {
+	if err := s.validator.Validate(params); err != nil {
+		return fmt.Errorf("validate params: %w", err)
+	}
+
+	if err := s.batteryController.ConfigBatteryCharge(ctx, params.CurrentLimit, params.Enabled); err != nil {
+		if errors.Is(err, picserial.ErrPICSerialNotConnected) {
+			return battery.ErrCanNotControlBattery
+		}
+		return fmt.Errorf("config battery charge: %w", err)
+	}
+
+	return nil
+}
+
+func (s service) SetDischargeSetting(ctx context.Context, params battery.SetDischargeSettingParams) error {
+	if err := s.validator.Validate(params); err != nil {
+		return fmt.Errorf("validate params: %w", err)
+	}
+
+	if err := s.batteryController.ConfigBatteryDischarge(ctx, params.CurrentLimit, params.Enabled); err != nil {
+		if errors.Is(err, picserial.ErrPICSerialNotConnected) {
+			return battery.ErrCanNotControlBattery
+		}
+		return fmt.Errorf("config battery discharge: %w", err)
+	}
+
+	return nil
+}
+
+// publishDiagnosticsAlerts publishes an alert for each condition that was not in the previous diagnostics,
+// so a condition lasting over several updates is reported once.
+func (s service) publishDiagnosticsAlerts(prev, cur battery.Diagnostics, percent uint8) {
+	newFaults := []string{}
+	for _, f := range cur.Faults {
+		if !slices.Contains(prev.Faults, f) {
+			newFaults = append(newFaults, f.String())
+		}
+	}
+	if len(newFaults) > 0 {
+		s.publishAlert(events.BatteryAlertLevelCritical, percent,
+			fmt.Sprintf("battery fault: %s", strings.Join(newFaults, ", ")))
+	}
+
+	if cur.ImbalanceExceeded && !prev.ImbalanceExceeded {
+		s.publishAlert(events.BatteryAlertLevelWarning, percent,
+			fmt.Sprintf("battery cell imbalance of %d mV", cur.CellImbalance))
+	}
+
+	newCells := []string{}
+	for _, i := range cur.OutOfBoundsCells {
+		if !slices.Contains(prev.OutOfBoundsCells, i) {
+			newCells = append(newCells, fmt.Sprintf("%d", i))
+		}
+	}
+	if len(newCells) > 0 {
+		s.publishAlert(events.BatteryAlertLevelWarning, percent,
+			fmt.Sprintf("battery cells out of bounds: %s", strings.Join(newCells, ", ")))
+	}
+}
+
+func (s service) publishAlert(level string, percent uint8, message string) {
+	s.log.Warn(message, slog.String("level", level), slog.Int("percent", int(percent)))
+
+	s.publisher.Publish(events.BatteryAlertTopic, eventbus.NewMessage(
+		events.BatteryAlertEvent{
+			Level:   level,
+			Percent: percent,
+			Message: message,
+		},
+	))
 }
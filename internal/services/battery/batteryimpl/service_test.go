@@ -0,0 +1,78 @@
+package batteryimpl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tbe-team/raybot/internal/config"
+	"github.com/tbe-team/raybot/internal/events"
+	"github.com/tbe-team/raybot/internal/logging"
+	"github.com/tbe-team/raybot/internal/services/battery"
+	configmocks "github.com/tbe-team/raybot/internal/services/config/mocks"
+	"github.com/tbe-team/raybot/pkg/eventbus"
+	eventbusmocks "github.com/tbe-team/raybot/pkg/eventbus/mocks"
+	"github.com/tbe-team/raybot/pkg/validator"
+)
+
+func TestService_UpdateBatteryState(t *testing.T) {
+	newService := func(t *testing.T) (battery.Service, battery.BatteryStateRepository, *eventbusmocks.FakePublisher) {
+		publisher := eventbusmocks.NewFakePublisher(t)
+		configService := configmocks.NewFakeService(t)
+		configService.EXPECT().GetHardwareConfig(mock.Anything).Return(config.Hardware{
+			BatteryCells: config.BatteryCells{
+				MinVoltage:   3000,
+				MaxVoltage:   4200,
+				MaxImbalance: 100,
+			},
+		}, nil)
+		repo := NewBatteryStateRepository()
+
+		s := NewService(logging.NewNoopLogger(), validator.New(), publisher, configService, repo, nil, nil)
+		return s, repo, publisher
+	}
+
+	isAlert := func(level string) any {
+		return mock.MatchedBy(func(msg *eventbus.Message) bool {
+			ev, ok := msg.Payload.(events.BatteryAlertEvent)
+			return ok && ev.Level == level
+		})
+	}
+
+	t.Run("Should store diagnostics and alert on new faults and cells out of bounds", func(t *testing.T) {
+		s, repo, publisher := newService(t)
+		publisher.EXPECT().Publish(events.BatteryStateUpdatedTopic, mock.Anything).Once()
+		publisher.EXPECT().Publish(events.BatteryAlertTopic, isAlert(events.BatteryAlertLevelCritical)).Once()
+		publisher.EXPECT().Publish(events.BatteryAlertTopic, isAlert(events.BatteryAlertLevelWarning)).Twice()
+
+		err := s.UpdateBatteryState(context.Background(), battery.UpdateBatteryStateParams{
+			CellVoltages: []uint16{2900, 4000, 4000, 4000},
+			Percent:      50,
+			Fault:        uint8(battery.FaultOverTemp),
+		})
+		require.NoError(t, err)
+
+		state, err := repo.GetBatteryState(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, []battery.Fault{battery.FaultOverTemp}, state.Diagnostics.Faults)
+		require.Equal(t, uint16(1100), state.Diagnostics.CellImbalance)
+		require.True(t, state.Diagnostics.ImbalanceExceeded)
+		require.Equal(t, []int{0}, state.Diagnostics.OutOfBoundsCells)
+	})
+
+	t.Run("Should not alert again on the same conditions", func(t *testing.T) {
+		s, _, publisher := newService(t)
+		publisher.EXPECT().Publish(events.BatteryStateUpdatedTopic, mock.Anything).Twice()
+		publisher.EXPECT().Publish(events.BatteryAlertTopic, mock.Anything).Once()
+
+		params := battery.UpdateBatteryStateParams{
+			CellVoltages: []uint16{4000, 4000, 4000, 4000},
+			Percent:      50,
+			Fault:        uint8(battery.FaultOverCurrent),
+		}
+		require.NoError(t, s.UpdateBatteryState(context.Background(), params))
+		require.NoError(t, s.UpdateBatteryState(context.Background(), params))
+	})
+}
@@ -1,6 +1,12 @@
 package battery
 
-import "time"
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/tbe-team/raybot/internal/config"
+)
 
 //nolint:revive
 type BatteryState struct {
@@ -11,7 +17,9 @@ type BatteryState struct {
 	Percent      uint8
 	Fault        uint8
 	Health       uint8
-	UpdatedAt    time.Time
+	// Diagnostics is computed from the fault and the cell voltages on each update.
+	Diagnostics Diagnostics
+	UpdatedAt   time.Time
 }
 
 type ChargeSetting struct {
@@ -25,3 +33,99 @@ type DischargeSetting struct {
 	Enabled      bool
 	UpdatedAt    time.Time
 }
+
+// Fault is a condition of the fault bitfield reported by the PIC.
+// The PIC protocol (docs/vi/pic_response.md) does not define the bits yet, so this
+// layout is unconfirmed until the firmware publishes it. The raw Fault of the
+// battery state stays the reference.
+type Fault uint8
+
+const (
+	FaultOverVoltage Fault = 1 << iota
+	FaultUnderVoltage
+	FaultOverCurrent
+	FaultOverTemp
+	FaultUnderTemp
+	FaultShortCircuit
+	FaultCellImbalance
+	FaultCommunication
+)
+
+var faults = []Fault{
+	FaultOverVoltage,
+	FaultUnderVoltage,
+	FaultOverCurrent,
+	FaultOverTemp,
+	FaultUnderTemp,
+	FaultShortCircuit,
+	FaultCellImbalance,
+	FaultCommunication,
+}
+
+func (f Fault) String() string {
+	switch f {
+	case FaultOverVoltage:
+		return "OVER_VOLTAGE"
+	case FaultUnderVoltage:
+		return "UNDER_VOLTAGE"
+	case FaultOverCurrent:
+		return "OVER_CURRENT"
+	case FaultOverTemp:
+		return "OVER_TEMP"
+	case FaultUnderTemp:
+		return "UNDER_TEMP"
+	case FaultShortCircuit:
+		return "SHORT_CIRCUIT"
+	case FaultCellImbalance:
+		return "CELL_IMBALANCE"
+	case FaultCommunication:
+		return "COMMUNICATION"
+	}
+	return fmt.Sprintf("UNKNOWN(%d)", uint8(f))
+}
+
+// DecodeFaults returns the conditions set in the fault bitfield, in bit order.
+func DecodeFaults(fault uint8) []Fault {
+	ret := []Fault{}
+	for _, f := range faults {
+		if fault&uint8(f) != 0 {
+			ret = append(ret, f)
+		}
+	}
+	return ret
+}
+
+// Diagnostics is the decoded view of the battery state.
+type Diagnostics struct {
+	Faults []Fault
+	// CellImbalance is the difference between the highest and the lowest cell voltage in mV.
+	CellImbalance uint16
+	// ImbalanceExceeded is true if the cell imbalance is above the configured maximum.
+	ImbalanceExceeded bool
+	// OutOfBoundsCells are the indexes of the cells outside the configured voltage bounds.
+	OutOfBoundsCells []int
+}
+
+// Diagnose decodes the fault bitfield and checks the cell voltages against the bounds.
+func Diagnose(fault uint8, cellVoltages []uint16, bounds config.BatteryCells) Diagnostics {
+	d := Diagnostics{
+		Faults:           DecodeFaults(fault),
+		OutOfBoundsCells: []int{},
+	}
+
+	if len(cellVoltages) == 0 {
+		return d
+	}
+
+	d.CellImbalance = slices.Max(cellVoltages) - slices.Min(cellVoltages)
+	d.ImbalanceExceeded = bounds.MaxImbalance > 0 && d.CellImbalance > bounds.MaxImbalance
+
+	for i, v := range cellVoltages {
+		if (bounds.MinVoltage > 0 && v < bounds.MinVoltage) ||
+			(bounds.MaxVoltage > 0 && v > bounds.MaxVoltage) {
+			d.OutOfBoundsCells = append(d.OutOfBoundsCells, i)
+		}
+	}
+
+	return d
+}
@@ -0,0 +1,60 @@
+package battery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tbe-team/raybot/internal/config"
+)
+
+func TestDecodeFaults(t *testing.T) {
+	require.Empty(t, DecodeFaults(0))
+	require.Equal(t, []Fault{FaultOverVoltage, FaultOverTemp}, DecodeFaults(0b0000_1001))
+	require.Len(t, DecodeFaults(0xFF), 8)
+}
+
+func TestDiagnose(t *testing.T) {
+	bounds := config.BatteryCells{
+		MinVoltage:   3000,
+		MaxVoltage:   4200,
+		MaxImbalance: 100,
+	}
+
+	tests := []struct {
+		name         string
+		cellVoltages []uint16
+		bounds       config.BatteryCells
+		want         Diagnostics
+	}{
+		{
+			name:         "balanced cells in bounds",
+			cellVoltages: []uint16{4000, 4010, 4020, 4000},
+			bounds:       bounds,
+			want:         Diagnostics{Faults: []Fault{}, CellImbalance: 20, OutOfBoundsCells: []int{}},
+		},
+		{
+			name:         "imbalanced cells out of bounds",
+			cellVoltages: []uint16{2900, 4000, 4300, 4000},
+			bounds:       bounds,
+			want:         Diagnostics{Faults: []Fault{}, CellImbalance: 1400, ImbalanceExceeded: true, OutOfBoundsCells: []int{0, 2}},
+		},
+		{
+			name:         "checks disabled",
+			cellVoltages: []uint16{2900, 4000, 4300, 4000},
+			bounds:       config.BatteryCells{},
+			want:         Diagnostics{Faults: []Fault{}, CellImbalance: 1400, OutOfBoundsCells: []int{}},
+		},
+		{
+			name:   "no cells",
+			bounds: bounds,
+			want:   Diagnostics{Faults: []Fault{}, OutOfBoundsCells: []int{}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, Diagnose(0, tc.cellVoltages, tc.bounds))
+		})
+	}
+}
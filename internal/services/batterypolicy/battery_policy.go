@@ -0,0 +1,34 @@
+package batterypolicy
+
+import (
+	"context"
+
+	"github.com/tbe-team/raybot/pkg/xerror"
+)
+
+var (
+	// ErrBatteryCritical is the error of a new command rejected while the battery is critical.
+	ErrBatteryCritical = xerror.Conflict(nil, "batteryPolicy.batteryCritical", "battery is critical, only charging commands are accepted")
+)
+
+type HandleBatteryUpdateParams struct {
+	Percent uint8 `validate:"min=0,max=100"`
+}
+
+type Service interface {
+	GetState(ctx context.Context) (State, error)
+
+	// HandleBatteryUpdate updates the level from the battery percent reported by the PIC.
+	// Entering the WARNING level raises an alert. Entering the CRITICAL level also cancels
+	// the running commands and sends the robot to the charger.
+	HandleBatteryUpdate(ctx context.Context, params HandleBatteryUpdateParams) error
+
+	// CheckChargerLocation returns an error if the return to the charger is enabled and the
+	// charger location is not in the track map, which MOVE_TO needs to move to the charger.
+	CheckChargerLocation(ctx context.Context) error
+}
+
+type Repository interface {
+	GetState(ctx context.Context) (State, error)
+	UpdateState(ctx context.Context, state State) error
+}
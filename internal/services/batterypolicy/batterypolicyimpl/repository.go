@@ -0,0 +1,38 @@
+package batterypolicyimpl
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/tbe-team/raybot/internal/services/batterypolicy"
+)
+
+type repository struct {
+	state batterypolicy.State
+	mu    sync.RWMutex
+}
+
+func NewRepository() batterypolicy.Repository {
+	return &repository{
+		state: batterypolicy.State{
+			Level:     batterypolicy.LevelNormal,
+			UpdatedAt: time.Now(),
+		},
+	}
+}
+
+func (r *repository) GetState(_ context.Context) (batterypolicy.State, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.state, nil
+}
+
+func (r *repository) UpdateState(_ context.Context, state batterypolicy.State) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.state = state
+	return nil
+}
@@ -0,0 +1,199 @@
+package batterypolicyimpl
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/tbe-team/raybot/internal/config"
+	"github.com/tbe-team/raybot/internal/events"
+	"github.com/tbe-team/raybot/internal/services/batterypolicy"
+	"github.com/tbe-team/raybot/internal/services/command"
+	configservice "github.com/tbe-team/raybot/internal/services/config"
+	"github.com/tbe-team/raybot/internal/services/trackmap"
+	"github.com/tbe-team/raybot/pkg/eventbus"
+	"github.com/tbe-team/raybot/pkg/validator"
+)
+
+type service struct {
+	log       *slog.Logger
+	validator validator.Validator
+	publisher eventbus.Publisher
+
+	configService   configservice.Service
+	commandService  command.Service
+	trackMapService trackmap.Service
+	repo            batterypolicy.Repository
+
+	// mu serializes the battery updates, so a level change is handled once.
+	mu sync.Mutex
+}
+
+func NewService(
+	log *slog.Logger,
+	validator validator.Validator,
+	publisher eventbus.Publisher,
+	configService configservice.Service,
+	commandService command.Service,
+	trackMapService trackmap.Service,
+	repo batterypolicy.Repository,
+) batterypolicy.Service {
+	return &service{
+		log:             log.With("service", "batterypolicy"),
+		validator:       validator,
+		publisher:       publisher,
+		configService:   configService,
+		commandService:  commandService,
+		trackMapService: trackMapService,
+		repo:            repo,
+	}
+}
+
+func (s *service) GetState(ctx context.Context) (batterypolicy.State, error) {
+	return s.repo.GetState(ctx)
+}
+
+func (s *service) HandleBatteryUpdate(ctx context.Context, params batterypolicy.HandleBatteryUpdateParams) error {
+	if err := s.validator.Validate(params); err != nil {
+		return fmt.Errorf("validate params: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cfg, err := s.configService.GetCommandConfig(ctx)
+	if err != nil {
+		return fmt.Errorf("get command config: %w", err)
+	}
+
+	cur, err := s.repo.GetState(ctx)
+	if err != nil {
+		return fmt.Errorf("get battery policy state: %w", err)
+	}
+
+	level := nextLevel(cur.Level, params.Percent, cfg.LowBattery)
+	if err := s.repo.UpdateState(ctx, batterypolicy.State{
+		Level:     level,
+		Percent:   params.Percent,
+		UpdatedAt: time.Now(),
+	}); err != nil {
+		return fmt.Errorf("update battery policy state: %w", err)
+	}
+
+	if level == cur.Level {
+		return nil
+	}
+
+	s.log.Info("battery level changed",
+		slog.String("from", cur.Level.String()),
+		slog.String("to", level.String()),
+		slog.Int("percent", int(params.Percent)))
+
+	switch level {
+	case batterypolicy.LevelWarning:
+		// Going back from CRITICAL to WARNING is not an alert.
+		if cur.Level == batterypolicy.LevelNormal {
+			s.publishAlert(level, params.Percent, "battery is low")
+		}
+
+	case batterypolicy.LevelCritical:
+		s.publishAlert(level, params.Percent, "battery is critical, returning to the charger")
+		if err := s.returnToCharger(ctx, cfg.LowBattery); err != nil {
+			return fmt.Errorf("return to charger: %w", err)
+		}
+
+	case batterypolicy.LevelNormal:
+	}
+
+	return nil
+}
+
+func (s *service) CheckChargerLocation(ctx context.Context) error {
+	cfg, err := s.configService.GetCommandConfig(ctx)
+	if err != nil {
+		return fmt.Errorf("get command config: %w", err)
+	}
+
+	// The return to the charger is disabled.
+	if cfg.LowBattery.CriticalPercent == 0 {
+		return nil
+	}
+
+	trackMap, err := s.trackMapService.GetTrackMap(ctx)
+	if err != nil {
+		return fmt.Errorf("get track map: %w", err)
+	}
+
+	if len(trackMap.Tags) == 0 {
+		return fmt.Errorf("%w: charger location %s", trackmap.ErrTrackMapEmpty, cfg.LowBattery.ChargerLocation)
+	}
+	if trackMap.IndexOf(cfg.LowBattery.ChargerLocation) < 0 {
+		return fmt.Errorf("%w: charger location %s", trackmap.ErrTagNotFound, cfg.LowBattery.ChargerLocation)
+	}
+
+	return nil
+}
+
+// returnToCharger cancels the running commands and queues a mission
+// moving to the charger and enabling the charge.
+func (s *service) returnToCharger(ctx context.Context, cfg config.LowBattery) error {
+	if err := s.commandService.CancelAllRunningCommands(ctx); err != nil {
+		return fmt.Errorf("cancel all running commands: %w", err)
+	}
+
+	cmd, err := s.commandService.CreateCommand(ctx, command.CreateCommandParams{
+		Source:   command.SourceBatteryPolicy,
+		Priority: command.PriorityMax,
+		Inputs: &command.MissionInputs{
+			Steps: []command.MissionStep{
+				{Inputs: &command.MoveToInputs{
+					Location:   cfg.ChargerLocation,
+					Direction:  command.MoveDirectionAuto,
+					MotorSpeed: cfg.MoveSpeed,
+				}},
+				{Inputs: &command.BatterySetChargeInputs{
+					CurrentLimit: cfg.ChargeCurrentLimit,
+					Enabled:      true,
+				}},
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("create command: %w", err)
+	}
+
+	s.log.Info("returning to the charger",
+		slog.Int64("command_id", cmd.ID),
+		slog.String("charger_location", cfg.ChargerLocation))
+
+	return nil
+}
+
+func (s *service) publishAlert(level batterypolicy.Level, percent uint8, message string) {
+	s.log.Warn(message, slog.Int("percent", int(percent)))
+
+	s.publisher.Publish(events.BatteryAlertTopic, eventbus.NewMessage(
+		events.BatteryAlertEvent{
+			Level:   level.String(),
+			Percent: percent,
+			Message: message,
+		},
+	))
+}
+
+// nextLevel returns the level for the battery percent. A level is left only once the
+// percent is above the warning percent, so the level does not flap around a threshold.
+func nextLevel(cur batterypolicy.Level, percent uint8, cfg config.LowBattery) batterypolicy.Level {
+	switch {
+	case cfg.CriticalPercent > 0 && percent <= cfg.CriticalPercent:
+		return batterypolicy.LevelCritical
+	case cur == batterypolicy.LevelCritical && percent <= cfg.WarningPercent:
+		return batterypolicy.LevelCritical
+	case cfg.WarningPercent > 0 && percent <= cfg.WarningPercent:
+		return batterypolicy.LevelWarning
+	default:
+		return batterypolicy.LevelNormal
+	}
+}
@@ -0,0 +1,166 @@
+package batterypolicyimpl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tbe-team/raybot/internal/config"
+	"github.com/tbe-team/raybot/internal/events"
+	"github.com/tbe-team/raybot/internal/logging"
+	"github.com/tbe-team/raybot/internal/services/batterypolicy"
+	"github.com/tbe-team/raybot/internal/services/command"
+	commandmocks "github.com/tbe-team/raybot/internal/services/command/mocks"
+	configmocks "github.com/tbe-team/raybot/internal/services/config/mocks"
+	"github.com/tbe-team/raybot/internal/services/trackmap"
+	trackmapmocks "github.com/tbe-team/raybot/internal/services/trackmap/mocks"
+	eventbusmocks "github.com/tbe-team/raybot/pkg/eventbus/mocks"
+	"github.com/tbe-team/raybot/pkg/validator"
+)
+
+func TestService_HandleBatteryUpdate(t *testing.T) {
+	cfg := config.LowBattery{
+		WarningPercent:     30,
+		CriticalPercent:    15,
+		ChargerLocation:    "charger",
+		ChargeCurrentLimit: 1000,
+		MoveSpeed:          40,
+	}
+
+	newService := func(t *testing.T, level batterypolicy.Level) (batterypolicy.Service, batterypolicy.Repository, *eventbusmocks.FakePublisher, *commandmocks.FakeService) {
+		publisher := eventbusmocks.NewFakePublisher(t)
+		configService := configmocks.NewFakeService(t)
+		commandService := commandmocks.NewFakeService(t)
+		repo := NewRepository()
+		require.NoError(t, repo.UpdateState(context.Background(), batterypolicy.State{Level: level}))
+
+		configService.EXPECT().GetCommandConfig(mock.Anything).Return(config.Command{LowBattery: cfg}, nil)
+
+		s := NewService(logging.NewNoopLogger(), validator.New(), publisher, configService, commandService, trackmapmocks.NewFakeService(t), repo)
+		return s, repo, publisher, commandService
+	}
+
+	t.Run("Should raise an alert when entering warning", func(t *testing.T) {
+		s, repo, publisher, _ := newService(t, batterypolicy.LevelNormal)
+		publisher.EXPECT().Publish(events.BatteryAlertTopic, mock.Anything).Once()
+
+		err := s.HandleBatteryUpdate(context.Background(), batterypolicy.HandleBatteryUpdateParams{Percent: 30})
+		require.NoError(t, err)
+
+		state, err := repo.GetState(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, batterypolicy.LevelWarning, state.Level)
+		require.Equal(t, uint8(30), state.Percent)
+	})
+
+	t.Run("Should cancel running commands and return to the charger when entering critical", func(t *testing.T) {
+		s, repo, publisher, commandService := newService(t, batterypolicy.LevelWarning)
+		publisher.EXPECT().Publish(events.BatteryAlertTopic, mock.Anything).Once()
+		commandService.EXPECT().CancelAllRunningCommands(mock.Anything).Return(nil).Once()
+		commandService.EXPECT().CreateCommand(mock.Anything, mock.MatchedBy(func(params command.CreateCommandParams) bool {
+			mission, ok := params.Inputs.(*command.MissionInputs)
+			if !ok || len(mission.Steps) != 2 {
+				return false
+			}
+			moveTo, ok := mission.Steps[0].Inputs.(*command.MoveToInputs)
+			if !ok {
+				return false
+			}
+			setCharge, ok := mission.Steps[1].Inputs.(*command.BatterySetChargeInputs)
+			if !ok {
+				return false
+			}
+			return params.Source == command.SourceBatteryPolicy &&
+				moveTo.Location == "charger" &&
+				moveTo.MotorSpeed == 40 &&
+				setCharge.Enabled &&
+				setCharge.CurrentLimit == 1000
+		})).Return(command.Command{ID: 1}, nil).Once()
+
+		err := s.HandleBatteryUpdate(context.Background(), batterypolicy.HandleBatteryUpdateParams{Percent: 15})
+		require.NoError(t, err)
+
+		state, err := repo.GetState(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, batterypolicy.LevelCritical, state.Level)
+	})
+
+	t.Run("Should stay critical until the percent is above warning", func(t *testing.T) {
+		s, repo, _, _ := newService(t, batterypolicy.LevelCritical)
+
+		err := s.HandleBatteryUpdate(context.Background(), batterypolicy.HandleBatteryUpdateParams{Percent: 25})
+		require.NoError(t, err)
+
+		state, err := repo.GetState(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, batterypolicy.LevelCritical, state.Level)
+
+		err = s.HandleBatteryUpdate(context.Background(), batterypolicy.HandleBatteryUpdateParams{Percent: 31})
+		require.NoError(t, err)
+
+		state, err = repo.GetState(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, batterypolicy.LevelNormal, state.Level)
+	})
+
+	t.Run("Should not act again while staying critical", func(t *testing.T) {
+		s, _, _, _ := newService(t, batterypolicy.LevelCritical)
+
+		err := s.HandleBatteryUpdate(context.Background(), batterypolicy.HandleBatteryUpdateParams{Percent: 10})
+		require.NoError(t, err)
+	})
+}
+
+func TestService_CheckChargerLocation(t *testing.T) {
+	cfg := config.LowBattery{
+		WarningPercent:     30,
+		CriticalPercent:    15,
+		ChargerLocation:    "charger",
+		ChargeCurrentLimit: 1000,
+	}
+
+	newService := func(t *testing.T, cfg config.LowBattery) (batterypolicy.Service, *trackmapmocks.FakeService) {
+		configService := configmocks.NewFakeService(t)
+		configService.EXPECT().GetCommandConfig(mock.Anything).Return(config.Command{LowBattery: cfg}, nil)
+		trackMapService := trackmapmocks.NewFakeService(t)
+
+		s := NewService(logging.NewNoopLogger(), validator.New(), eventbusmocks.NewFakePublisher(t),
+			configService, commandmocks.NewFakeService(t), trackMapService, NewRepository())
+		return s, trackMapService
+	}
+
+	t.Run("Should pass if the charger location is in the track map", func(t *testing.T) {
+		s, trackMapService := newService(t, cfg)
+		trackMapService.EXPECT().GetTrackMap(mock.Anything).Return(trackmap.TrackMap{
+			Tags: []trackmap.Tag{{Location: "A"}, {Location: "charger"}},
+		}, nil)
+
+		require.NoError(t, s.CheckChargerLocation(context.Background()))
+	})
+
+	t.Run("Should fail if the charger location is not in the track map", func(t *testing.T) {
+		s, trackMapService := newService(t, cfg)
+		trackMapService.EXPECT().GetTrackMap(mock.Anything).Return(trackmap.TrackMap{
+			Tags: []trackmap.Tag{{Location: "A"}},
+		}, nil)
+
+		require.ErrorIs(t, s.CheckChargerLocation(context.Background()), trackmap.ErrTagNotFound)
+	})
+
+	t.Run("Should fail if the track map is empty", func(t *testing.T) {
+		s, trackMapService := newService(t, cfg)
+		trackMapService.EXPECT().GetTrackMap(mock.Anything).Return(trackmap.TrackMap{}, nil)
+
+		require.ErrorIs(t, s.CheckChargerLocation(context.Background()), trackmap.ErrTrackMapEmpty)
+	})
+
+	t.Run("Should pass if the return to the charger is disabled", func(t *testing.T) {
+		disabled := cfg
+		disabled.CriticalPercent = 0
+		s, _ := newService(t, disabled)
+
+		require.NoError(t, s.CheckChargerLocation(context.Background()))
+	})
+}
@@ -0,0 +1,39 @@
+package batterypolicy
+
+import (
+	"fmt"
+	"time"
+)
+
+type Level string
+
+func (l Level) String() string {
+	return string(l)
+}
+
+func (l Level) Validate() error {
+	switch l {
+	case LevelNormal, LevelWarning, LevelCritical:
+		return nil
+	}
+	return fmt.Errorf("invalid level: %s", l)
+}
+
+const (
+	LevelNormal  Level = "NORMAL"
+	LevelWarning Level = "WARNING"
+	// LevelCritical rejects new commands except the charging ones.
+	LevelCritical Level = "CRITICAL"
+)
+
+type State struct {
+	Level   Level
+	Percent uint8
+	// UpdatedAt is the time of the last battery update.
+	UpdatedAt time.Time
+}
+
+// Critical reports whether new commands except the charging ones are rejected.
+func (s State) Critical() bool {
+	return s.Level == LevelCritical
+}
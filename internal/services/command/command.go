@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/tbe-team/raybot/internal/config"
 	"github.com/tbe-team/raybot/pkg/paging"
 	"github.com/tbe-team/raybot/pkg/sort"
 	"github.com/tbe-team/raybot/pkg/xerror"
@@ -15,15 +16,44 @@ var (
 	ErrNoCommandBeingProcessed            = xerror.BadRequest(nil, "command.noCommandBeingProcessed", "no command being processed")
 	ErrCommandInProcessingCanNotBeDeleted = xerror.BadRequest(nil, "command.inProcessingCanNotBeDeleted", "command in processing can not be deleted")
 	ErrCommandAlreadyExists               = xerror.Conflict(nil, "command.alreadyExists", "command already exists")
+	ErrCommandDeadlineInThePast           = xerror.BadRequest(nil, "command.deadlineInThePast", "command deadline must be in the future")
+	ErrInvalidRetryPolicy                 = xerror.BadRequest(nil, "command.invalidRetryPolicy", "invalid retry policy")
+	ErrCommandNotQueued                   = xerror.BadRequest(nil, "command.notQueued", "command is not queued")
 
 	ErrRunningCommandNotFound = xerror.NotFound(nil, "command.runningCommandNotFound", "running command not found")
 	ErrRunningCommandExists   = xerror.BadRequest(nil, "command.runningCommandExists", "running command already exists")
+
+	// ErrCommandSuspended is the cancel cause of a command that was preempted
+	// by a higher priority command and has to be put back into the queue.
+	ErrCommandSuspended = xerror.Conflict(nil, "command.suspended", "command suspended by a higher priority command")
+
+	// ErrCommandTimedOut is the error of a command that did not complete before its timeout or deadline.
+	ErrCommandTimedOut = xerror.Timeout(nil, "command.timedOut", "command timed out")
+
+	// ErrMoveToOvershoot is the error of a MOVE_TO command that is still beyond the target
+	// after the maximum number of corrections.
+	ErrMoveToOvershoot = xerror.UnprocessableEntity(nil, "command.moveToOvershoot", "target location overshot")
+)
+
+const (
+	// PriorityDefault is the priority of a command when no priority is given.
+	PriorityDefault int64 = 0
+	// PriorityMax is the highest priority a command can have.
+	PriorityMax int64 = 100
 )
 
 type CreateCommandParams struct {
 	Source    Source  `validate:"enum"`
 	Inputs    Inputs  `validate:"required"`
 	RequestID *string `validate:"omitempty,max=64"` // Optional request ID for idempotency
+	// Priority of the command, commands with higher priority are executed first.
+	Priority int64 `validate:"min=0,max=100"`
+	// Timeout overrides the timeout of the command type in the command config.
+	Timeout *time.Duration `validate:"omitempty,min=1s"`
+	// Deadline is the time by which the command must be completed.
+	Deadline *time.Time
+	// RetryPolicy overrides the retry policy of the command type in the command config.
+	RetryPolicy *config.RetryPolicy
 }
 
 type GetCommandByIDParams struct {
@@ -32,7 +62,7 @@ type GetCommandByIDParams struct {
 
 type ListCommandsParams struct {
 	PagingParams paging.Params `validate:"required"`
-	Sorts        []sort.Sort   `validate:"sort=type status source created_at updated_at completed_at"`
+	Sorts        []sort.Sort   `validate:"sort=type status source priority queue_order created_at updated_at completed_at"`
 	Statuses     []Status      `validate:"dive,enum"`
 }
 
@@ -40,13 +70,51 @@ type DeleteCommandByIDParams struct {
 	CommandID int64 `validate:"required,min=1"`
 }
 
+type CancelQueuedCommandByIDParams struct {
+	CommandID int64 `validate:"required,min=1"`
+}
+
+type MoveQueuedCommandParams struct {
+	CommandID int64     `validate:"required,min=1"`
+	Move      QueueMove `validate:"enum"`
+}
+
+type GetQueuePositionParams struct {
+	CommandID int64 `validate:"required,min=1"`
+}
+
+type PauseQueueParams struct {
+	// Source is who paused the queue.
+	Source Source  `validate:"enum"`
+	Reason *string `validate:"omitempty,max=255"`
+}
+
 type Service interface {
 	GetCommandByID(ctx context.Context, params GetCommandByIDParams) (Command, error)
 	GetCurrentProcessingCommand(ctx context.Context) (Command, error)
+	// GetCurrentProcessingCommandProgress returns the progress of the command being processed.
+	// It returns ErrRunningCommandNotFound if no command is being processed.
+	GetCurrentProcessingCommandProgress(ctx context.Context) (CommandProgress, error)
 	ListCommands(ctx context.Context, params ListCommandsParams) (paging.List[Command], error)
 	CreateCommand(ctx context.Context, params CreateCommandParams) (Command, error)
 	CancelCurrentProcessingCommand(ctx context.Context) error
 
+	// CancelQueuedCommandByID cancels a single QUEUED command.
+	CancelQueuedCommandByID(ctx context.Context, params CancelQueuedCommandByIDParams) (Command, error)
+	// MoveQueuedCommand moves a QUEUED command to the front or the back of the queue.
+	// The priority of the command is raised or lowered to the priority of the queue head or tail if needed.
+	MoveQueuedCommand(ctx context.Context, params MoveQueuedCommandParams) (Command, error)
+	// GetQueuePosition returns the position of a QUEUED command in the queue.
+	GetQueuePosition(ctx context.Context, params GetQueuePositionParams) (QueuePosition, error)
+
+	// GetQueueState returns the persistent state of the command queue.
+	GetQueueState(ctx context.Context) (QueueState, error)
+	// PauseQueue stops new commands from being started from the queue.
+	// The command being processed completes, the queue stays paused across restarts until it is resumed.
+	PauseQueue(ctx context.Context, params PauseQueueParams) (QueueState, error)
+	// ResumeQueue allows commands to be started from the queue again.
+	ResumeQueue(ctx context.Context) (QueueState, error)
+
 	// CancelActiveCloudCommands cancels all QUEUED and PROCESSING commands created by the cloud.
 	CancelActiveCloudCommands(ctx context.Context) error
 
@@ -76,21 +144,46 @@ type UpdateCommandParams struct {
 	SetStartedAt   bool
 	CompletedAt    *time.Time
 	SetCompletedAt bool
-	UpdatedAt      time.Time
+	// Attempts is the number of times the command has been executed.
+	Attempts    uint32
+	SetAttempts bool
+	// AttemptErrors replaces the errors of the failed attempts.
+	AttemptErrors    []AttemptError
+	SetAttemptErrors bool
+	// Recovery is the startup recovery decision made for the command.
+	Recovery    *Recovery
+	SetRecovery bool
+	UpdatedAt   time.Time
 }
 
 type Repository interface {
 	ListCommands(ctx context.Context, params ListCommandsParams) (paging.List[Command], error)
 	GetNextExecutableCommand(ctx context.Context) (Command, error)
+	// GetNextExecutableCommandBySource returns the next executable command created by the source.
+	GetNextExecutableCommandBySource(ctx context.Context, source Source) (Command, error)
 	GetCurrentProcessingCommand(ctx context.Context) (Command, error)
 	GetCommandByID(ctx context.Context, id int64) (Command, error)
 	CreateCommand(ctx context.Context, command Command) (Command, error)
 	UpdateCommand(ctx context.Context, params UpdateCommandParams) (Command, error)
 
+	// ListPendingCommands lists all pending commands by status QUEUED, PROCESSING, and CANCELING.
+	ListPendingCommands(ctx context.Context) ([]Command, error)
 	// CancelPendingCommands cancels all pending commands by status QUEUED, PROCESSING, and CANCELING.
 	CancelPendingCommands(ctx context.Context) error
 	CancelQueuedAndProcessingCommandsCreatedByCloud(ctx context.Context) error
 
+	// CancelQueuedCommandByID cancels the command if the status is QUEUED.
+	// It returns ErrCommandNotQueued if the command has another status.
+	CancelQueuedCommandByID(ctx context.Context, id int64, canceledAt time.Time) (Command, error)
+	// MoveQueuedCommand moves the command in the queue if the status is QUEUED.
+	// It returns ErrCommandNotQueued if the command has another status.
+	MoveQueuedCommand(ctx context.Context, id int64, move QueueMove, updatedAt time.Time) (Command, error)
+	// GetQueuePosition returns the position of the command in the queue.
+	GetQueuePosition(ctx context.Context, id int64) (QueuePosition, error)
+
+	GetQueueState(ctx context.Context) (QueueState, error)
+	UpdateQueueState(ctx context.Context, state QueueState) (QueueState, error)
+
 	// DeleteCommandByID deletes a command by id.
 	// It does not delete the command if the status is PROCESSING, CANCELING.
 	DeleteCommandByID(ctx context.Context, id int64) error
@@ -101,6 +194,9 @@ type RunningCommandRepository interface {
 	Get(ctx context.Context) (CancelableCommand, error)
 	Add(ctx context.Context, cmd CancelableCommand) error
 	Update(ctx context.Context, cmd CancelableCommand) error
+	// UpdateProgress sets the progress of the running command and returns the command.
+	// It returns ErrRunningCommandNotFound if no command is running.
+	UpdateProgress(ctx context.Context, progress Progress) (CancelableCommand, error)
 	Remove(ctx context.Context) error
 }
 
@@ -9,6 +9,7 @@ import (
 	sq "github.com/Masterminds/squirrel"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/tbe-team/raybot/internal/config"
 	"github.com/tbe-team/raybot/internal/services/command"
 	"github.com/tbe-team/raybot/internal/storage/db"
 	"github.com/tbe-team/raybot/internal/storage/db/sqlc"
@@ -90,6 +91,14 @@ func (r repository) ListCommands(ctx context.Context, params command.ListCommand
 				&row.StartedAt,
 				&row.Outputs,
 				&row.RequestID,
+				&row.Priority,
+				&row.TimeoutMs,
+				&row.Deadline,
+				&row.RetryPolicy,
+				&row.Attempts,
+				&row.AttemptErrors,
+				&row.QueueOrder,
+				&row.Recovery,
 			); err != nil {
 				return fmt.Errorf("scan command: %w", err)
 			}
@@ -129,6 +138,17 @@ func (r repository) GetNextExecutableCommand(ctx context.Context) (command.Comma
 	return r.convertRowToCommand(row)
 }
 
+func (r repository) GetNextExecutableCommandBySource(ctx context.Context, source command.Source) (command.Command, error) {
+	row, err := r.queries.CommandGetNextExecutableBySource(ctx, r.db, source.String())
+	if err != nil {
+		if db.IsNoRowsError(err) {
+			return command.Command{}, command.ErrNoNextExecutableCommand
+		}
+		return command.Command{}, fmt.Errorf("failed to get next executable command by source: %w", err)
+	}
+	return r.convertRowToCommand(row)
+}
+
 func (r repository) GetCurrentProcessingCommand(ctx context.Context) (command.Command, error) {
 	row, err := r.queries.CommandGetCurrentProcessing(ctx, r.db)
 	if err != nil {
@@ -167,6 +187,25 @@ func (r repository) CreateCommand(ctx context.Context, commandArg command.Comman
 		startedAt = ptr.New(commandArg.StartedAt.Format(time.RFC3339Nano))
 	}
 
+	var timeoutMs *int64
+	if commandArg.Timeout != nil {
+		timeoutMs = ptr.New(commandArg.Timeout.Milliseconds())
+	}
+
+	var deadline *string
+	if commandArg.Deadline != nil {
+		deadline = ptr.New(commandArg.Deadline.Format(time.RFC3339Nano))
+	}
+
+	var retryPolicy *string
+	if commandArg.RetryPolicy != nil {
+		retryPolicyBytes, err := json.Marshal(commandArg.RetryPolicy)
+		if err != nil {
+			return command.Command{}, fmt.Errorf("failed to marshal retry policy: %w", err)
+		}
+		retryPolicy = ptr.New(string(retryPolicyBytes))
+	}
+
 	row, err := r.queries.CommandCreate(ctx, r.db, sqlc.CommandCreateParams{
 		Type:        commandArg.Type.String(),
 		Status:      commandArg.Status.String(),
@@ -178,6 +217,10 @@ func (r repository) CreateCommand(ctx context.Context, commandArg command.Comman
 		CreatedAt:   commandArg.CreatedAt.Format(time.RFC3339Nano),
 		UpdatedAt:   commandArg.UpdatedAt.Format(time.RFC3339Nano),
 		RequestID:   commandArg.RequestID,
+		Priority:    commandArg.Priority,
+		TimeoutMs:   timeoutMs,
+		Deadline:    deadline,
+		RetryPolicy: retryPolicy,
 	})
 	if err != nil {
 		return command.Command{}, fmt.Errorf("queries create command: %w", err)
@@ -215,19 +258,43 @@ func (r repository) UpdateCommand(ctx context.Context, params command.UpdateComm
 		outputs = string(outputsBytes)
 	}
 
+	attemptErrors := "[]"
+	if params.AttemptErrors != nil {
+		attemptErrorsBytes, err := json.Marshal(params.AttemptErrors)
+		if err != nil {
+			return command.Command{}, fmt.Errorf("failed to marshal attempt errors: %w", err)
+		}
+		attemptErrors = string(attemptErrorsBytes)
+	}
+
+	var recovery *string
+	if params.Recovery != nil {
+		recoveryBytes, err := json.Marshal(params.Recovery)
+		if err != nil {
+			return command.Command{}, fmt.Errorf("failed to marshal recovery: %w", err)
+		}
+		recovery = ptr.New(string(recoveryBytes))
+	}
+
 	row, err := r.queries.CommandUpdate(ctx, r.db, sqlc.CommandUpdateParams{
-		ID:             params.ID,
-		Status:         params.Status.String(),
-		SetStatus:      params.SetStatus,
-		Outputs:        outputs,
-		SetOutputs:     params.SetOutputs,
-		Error:          params.Error,
-		SetError:       params.SetError,
-		StartedAt:      startedAt,
-		SetStartedAt:   params.SetStartedAt,
-		CompletedAt:    completedAt,
-		SetCompletedAt: params.SetCompletedAt,
-		UpdatedAt:      params.UpdatedAt.Format(time.RFC3339Nano),
+		ID:               params.ID,
+		Status:           params.Status.String(),
+		SetStatus:        params.SetStatus,
+		Outputs:          outputs,
+		SetOutputs:       params.SetOutputs,
+		Error:            params.Error,
+		SetError:         params.SetError,
+		StartedAt:        startedAt,
+		SetStartedAt:     params.SetStartedAt,
+		CompletedAt:      completedAt,
+		SetCompletedAt:   params.SetCompletedAt,
+		Attempts:         int64(params.Attempts),
+		SetAttempts:      params.SetAttempts,
+		AttemptErrors:    attemptErrors,
+		SetAttemptErrors: params.SetAttemptErrors,
+		Recovery:         recovery,
+		SetRecovery:      params.SetRecovery,
+		UpdatedAt:        params.UpdatedAt.Format(time.RFC3339Nano),
 	})
 	if err != nil {
 		return command.Command{}, fmt.Errorf("queries update command: %w", err)
@@ -236,6 +303,24 @@ func (r repository) UpdateCommand(ctx context.Context, params command.UpdateComm
 	return r.convertRowToCommand(row)
 }
 
+func (r repository) ListPendingCommands(ctx context.Context) ([]command.Command, error) {
+	rows, err := r.queries.CommandListPending(ctx, r.db)
+	if err != nil {
+		return nil, fmt.Errorf("queries list pending commands: %w", err)
+	}
+
+	ret := make([]command.Command, 0, len(rows))
+	for _, row := range rows {
+		cmd, err := r.convertRowToCommand(row)
+		if err != nil {
+			return nil, fmt.Errorf("convert row to command: %w", err)
+		}
+		ret = append(ret, cmd)
+	}
+
+	return ret, nil
+}
+
 func (r repository) CancelPendingCommands(ctx context.Context) error {
 	err := r.queries.CommandCancelByStatusQueuedAndProcessingAndCanceling(ctx, r.db)
 	if err != nil {
@@ -253,6 +338,104 @@ func (r repository) CancelQueuedAndProcessingCommandsCreatedByCloud(ctx context.
 	return nil
 }
 
+func (r repository) CancelQueuedCommandByID(ctx context.Context, id int64, canceledAt time.Time) (command.Command, error) {
+	row, err := r.queries.CommandCancelQueuedByID(ctx, r.db, sqlc.CommandCancelQueuedByIDParams{
+		ID:          id,
+		CompletedAt: ptr.New(canceledAt.Format(time.RFC3339Nano)),
+		UpdatedAt:   canceledAt.Format(time.RFC3339Nano),
+	})
+	if err != nil {
+		if db.IsNoRowsError(err) {
+			return command.Command{}, r.notQueuedError(ctx, id)
+		}
+		return command.Command{}, fmt.Errorf("failed to cancel queued command by id: %w", err)
+	}
+	return r.convertRowToCommand(row)
+}
+
+func (r repository) MoveQueuedCommand(ctx context.Context, id int64, move command.QueueMove, updatedAt time.Time) (command.Command, error) {
+	var row sqlc.Command
+	var err error
+	switch move {
+	case command.QueueMoveFront:
+		row, err = r.queries.CommandMoveQueuedToFront(ctx, r.db, sqlc.CommandMoveQueuedToFrontParams{
+			ID:        id,
+			UpdatedAt: updatedAt.Format(time.RFC3339Nano),
+		})
+	case command.QueueMoveBack:
+		row, err = r.queries.CommandMoveQueuedToBack(ctx, r.db, sqlc.CommandMoveQueuedToBackParams{
+			ID:        id,
+			UpdatedAt: updatedAt.Format(time.RFC3339Nano),
+		})
+	default:
+		return command.Command{}, fmt.Errorf("invalid queue move: %s", move)
+	}
+	if err != nil {
+		if db.IsNoRowsError(err) {
+			return command.Command{}, r.notQueuedError(ctx, id)
+		}
+		return command.Command{}, fmt.Errorf("failed to move queued command: %w", err)
+	}
+	return r.convertRowToCommand(row)
+}
+
+func (r repository) GetQueuePosition(ctx context.Context, id int64) (command.QueuePosition, error) {
+	row, err := r.queries.CommandGetQueuePosition(ctx, r.db, id)
+	if err != nil {
+		return command.QueuePosition{}, fmt.Errorf("failed to get queue position: %w", err)
+	}
+	return command.QueuePosition{
+		CommandID:   id,
+		Position:    uint(row.Ahead) + 1, //nolint:gosec
+		QueueLength: uint(row.Total),     //nolint:gosec
+	}, nil
+}
+
+func (r repository) GetQueueState(ctx context.Context) (command.QueueState, error) {
+	row, err := r.queries.CommandQueueStateGet(ctx, r.db)
+	if err != nil {
+		return command.QueueState{}, fmt.Errorf("failed to get queue state: %w", err)
+	}
+	return r.convertRowToQueueState(row)
+}
+
+func (r repository) UpdateQueueState(ctx context.Context, state command.QueueState) (command.QueueState, error) {
+	var pausedBy *string
+	if state.PausedBy != nil {
+		pausedBy = ptr.New(state.PausedBy.String())
+	}
+
+	var pausedAt *string
+	if state.PausedAt != nil {
+		pausedAt = ptr.New(state.PausedAt.Format(time.RFC3339Nano))
+	}
+
+	var paused int64
+	if state.Paused {
+		paused = 1
+	}
+
+	row, err := r.queries.CommandQueueStateUpdate(ctx, r.db, sqlc.CommandQueueStateUpdateParams{
+		Paused:    paused,
+		PausedBy:  pausedBy,
+		Reason:    state.Reason,
+		PausedAt:  pausedAt,
+		UpdatedAt: state.UpdatedAt.Format(time.RFC3339Nano),
+	})
+	if err != nil {
+		return command.QueueState{}, fmt.Errorf("failed to update queue state: %w", err)
+	}
+	return r.convertRowToQueueState(row)
+}
+
+// notQueuedError returns the error of a queue operation that did not match a QUEUED command.
+func (r repository) notQueuedError(ctx context.Context, id int64) error {
+	if _, err := r.GetCommandByID(ctx, id); err != nil {
+		return err
+	}
+	return command.ErrCommandNotQueued
+}
+
 func (r repository) DeleteCommandByID(ctx context.Context, id int64) error {
 	affected, err := r.queries.CommandDeleteByID(ctx, r.db, id)
 	if err != nil {
@@ -280,6 +463,8 @@ func (repository) convertRowToCommand(row sqlc.Command) (command.Command, error)
 		Source:    command.Source(row.Source),
 		Error:     row.Error,
 		RequestID: row.RequestID,
+		Priority:  row.Priority,
+		Attempts:  uint32(row.Attempts), //nolint:gosec
 	}
 	var err error
 
@@ -319,5 +504,64 @@ func (repository) convertRowToCommand(row sqlc.Command) (command.Command, error)
 		ret.CompletedAt = &completedAt
 	}
 
+	if row.TimeoutMs != nil {
+		ret.Timeout = ptr.New(time.Duration(*row.TimeoutMs) * time.Millisecond)
+	}
+
+	if row.Deadline != nil {
+		deadline, err := time.Parse(time.RFC3339Nano, *row.Deadline)
+		if err != nil {
+			return command.Command{}, fmt.Errorf("failed to parse deadline: %w", err)
+		}
+		ret.Deadline = &deadline
+	}
+
+	if row.RetryPolicy != nil {
+		var retryPolicy config.RetryPolicy
+		if err := json.Unmarshal([]byte(*row.RetryPolicy), &retryPolicy); err != nil {
+			return command.Command{}, fmt.Errorf("failed to unmarshal retry policy: %w", err)
+		}
+		ret.RetryPolicy = &retryPolicy
+	}
+
+	if err := json.Unmarshal([]byte(row.AttemptErrors), &ret.AttemptErrors); err != nil {
+		return command.Command{}, fmt.Errorf("failed to unmarshal attempt errors: %w", err)
+	}
+
+	if row.Recovery != nil {
+		var recovery command.Recovery
+		if err := json.Unmarshal([]byte(*row.Recovery), &recovery); err != nil {
+			return command.Command{}, fmt.Errorf("failed to unmarshal recovery: %w", err)
+		}
+		ret.Recovery = &recovery
+	}
+
+	return ret, nil
+}
+
+func (repository) convertRowToQueueState(row sqlc.CommandQueueState) (command.QueueState, error) {
+	ret := command.QueueState{
+		Paused: row.Paused == 1,
+		Reason: row.Reason,
+	}
+
+	if row.PausedBy != nil {
+		ret.PausedBy = ptr.New(command.Source(*row.PausedBy))
+	}
+
+	if row.PausedAt != nil {
+		pausedAt, err := time.Parse(time.RFC3339Nano, *row.PausedAt)
+		if err != nil {
+			return command.QueueState{}, fmt.Errorf("failed to parse paused at: %w", err)
+		}
+		ret.PausedAt = &pausedAt
+	}
+
+	updatedAt, err := time.Parse(time.RFC3339Nano, row.UpdatedAt)
+	if err != nil {
+		return command.QueueState{}, fmt.Errorf("failed to parse updated at: %w", err)
+	}
+	ret.UpdatedAt = updatedAt
+
 	return ret, nil
 }
@@ -44,6 +44,16 @@ func (r *runningCmdRepository) Update(_ context.Context, cmd command.CancelableC
 	return nil
 }
 
+func (r *runningCmdRepository) UpdateProgress(_ context.Context, progress command.Progress) (command.CancelableCommand, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.cmd == nil {
+		return command.CancelableCommand{}, command.ErrRunningCommandNotFound
+	}
+	r.cmd.Progress = progress
+	return *r.cmd, nil
+}
+
 func (r *runningCmdRepository) Remove(_ context.Context) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
@@ -9,7 +9,9 @@ import (
 
 	"github.com/tbe-team/raybot/internal/config"
 	"github.com/tbe-team/raybot/internal/events"
+	"github.com/tbe-team/raybot/internal/services/batterypolicy"
 	"github.com/tbe-team/raybot/internal/services/command"
+	configservice "github.com/tbe-team/raybot/internal/services/config"
 	"github.com/tbe-team/raybot/pkg/eventbus"
 	"github.com/tbe-team/raybot/pkg/paging"
 	"github.com/tbe-team/raybot/pkg/validator"
@@ -21,10 +23,12 @@ type Service struct {
 	log       *slog.Logger
 	validator validator.Validator
 
-	publisher eventbus.Publisher
+	publisher     eventbus.Publisher
+	configService configservice.Service
 
-	runningCmdRepository command.RunningCommandRepository
-	commandRepository    command.Repository
+	runningCmdRepository    command.RunningCommandRepository
+	commandRepository       command.Repository
+	batteryPolicyRepository batterypolicy.Repository
 
 	processingLock  command.ProcessingLock
 	executorService command.ExecutorService
@@ -35,23 +39,27 @@ func NewService(
 	log *slog.Logger,
 	validator validator.Validator,
 	publisher eventbus.Publisher,
+	configService configservice.Service,
 	runningCmdRepository command.RunningCommandRepository,
 	commandRepository command.Repository,
+	batteryPolicyRepository batterypolicy.Repository,
 	processingLock command.ProcessingLock,
 	executorService command.ExecutorService,
 ) command.Service {
 	s := &Service{
-		deleteOldCmdCfg:      deleteOldCmdCfg,
-		log:                  log.With("service", "command"),
-		validator:            validator,
-		publisher:            publisher,
-		runningCmdRepository: runningCmdRepository,
-		commandRepository:    commandRepository,
-		processingLock:       processingLock,
-		executorService:      executorService,
+		deleteOldCmdCfg:         deleteOldCmdCfg,
+		log:                     log.With("service", "command"),
+		validator:               validator,
+		publisher:               publisher,
+		configService:           configService,
+		runningCmdRepository:    runningCmdRepository,
+		commandRepository:       commandRepository,
+		batteryPolicyRepository: batteryPolicyRepository,
+		processingLock:          processingLock,
+		executorService:         executorService,
 	}
 
-	go s.cancelQueuedAndProcessingCommands(context.Background())
+	go s.recoverPendingCommands(context.Background())
 
 	return s
 }
@@ -68,6 +76,19 @@ func (s *Service) GetCurrentProcessingCommand(ctx context.Context) (command.Comm
 	return s.commandRepository.GetCurrentProcessingCommand(ctx)
 }
 
+func (s *Service) GetCurrentProcessingCommandProgress(ctx context.Context) (command.CommandProgress, error) {
+	cmd, err := s.runningCmdRepository.Get(ctx)
+	if err != nil {
+		return command.CommandProgress{}, err
+	}
+
+	return command.CommandProgress{
+		CommandID:   cmd.ID,
+		CommandType: cmd.Type,
+		Progress:    cmd.Progress,
+	}, nil
+}
+
 func (s *Service) ListCommands(ctx context.Context, params command.ListCommandsParams) (paging.List[command.Command], error) {
 	if err := s.validator.Validate(params); err != nil {
 		return paging.List[command.Command]{}, fmt.Errorf("validate params: %w", err)
@@ -81,12 +102,39 @@ func (s *Service) CreateCommand(ctx context.Context, params command.CreateComman
 		return command.Command{}, fmt.Errorf("validate params: %w", err)
 	}
 
-	cmd := command.NewCommand(params.Source, params.Inputs, params.RequestID)
+	if params.Deadline != nil && !params.Deadline.After(time.Now()) {
+		return command.Command{}, command.ErrCommandDeadlineInThePast
+	}
+
+	if params.RetryPolicy != nil {
+		if err := params.RetryPolicy.Validate(); err != nil {
+			return command.Command{}, fmt.Errorf("%w: %s", command.ErrInvalidRetryPolicy, err.Error())
+		}
+
+		if cmdType := params.Inputs.CommandType(); cmdType.IsComposite() && params.RetryPolicy.MaxAttempts > 1 {
+			return command.Command{}, fmt.Errorf("%w: %s can not be retried", command.ErrInvalidRetryPolicy, cmdType)
+		}
+	}
+
+	if err := s.checkBatteryPolicy(ctx, params); err != nil {
+		return command.Command{}, err
+	}
+
+	cmd := command.NewCommand(params.Source, params.Inputs, params.Priority, params.RequestID)
+	cmd.Timeout = params.Timeout
+	cmd.Deadline = params.Deadline
+	cmd.RetryPolicy = params.RetryPolicy
 	cmd, err := s.commandRepository.CreateCommand(ctx, cmd)
 	if err != nil {
 		return command.Command{}, fmt.Errorf("create command: %w", err)
 	}
 
+	if err := s.preemptRunningCommand(ctx, cmd); err != nil {
+		s.log.Error("failed to preempt running command",
+			slog.Int64("command_id", cmd.ID),
+			slog.Any("error", err))
+	}
+
 	s.publisher.Publish(
 		events.CommandCreatedTopic,
 		eventbus.NewMessage(events.CommandCreatedEvent{
@@ -107,24 +155,106 @@ func (s *Service) CancelCurrentProcessingCommand(ctx context.Context) error {
 	}
 
 	if runningCmd.CanBeCanceled() {
-		if _, err := s.commandRepository.UpdateCommand(ctx, command.UpdateCommandParams{
-			ID:        runningCmd.ID,
-			Status:    command.StatusCanceling,
-			SetStatus: true,
-			UpdatedAt: time.Now(),
-		}); err != nil {
-			return fmt.Errorf("update command status: %w", err)
-		}
-
-		runningCmd.Cancel()
-		if err := s.runningCmdRepository.Update(ctx, runningCmd); err != nil {
-			return fmt.Errorf("update running command: %w", err)
+		if err := s.cancelRunningCommand(ctx, runningCmd); err != nil {
+			return fmt.Errorf("cancel running command: %w", err)
 		}
 	}
 
 	return nil
 }
 
+func (s *Service) CancelQueuedCommandByID(ctx context.Context, params command.CancelQueuedCommandByIDParams) (command.Command, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return command.Command{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	cmd, err := s.commandRepository.CancelQueuedCommandByID(ctx, params.CommandID, time.Now())
+	if err != nil {
+		return command.Command{}, fmt.Errorf("cancel queued command by id: %w", err)
+	}
+
+	s.log.Info("queued command canceled", slog.Int64("command_id", cmd.ID))
+
+	return cmd, nil
+}
+
+func (s *Service) MoveQueuedCommand(ctx context.Context, params command.MoveQueuedCommandParams) (command.Command, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return command.Command{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	cmd, err := s.commandRepository.MoveQueuedCommand(ctx, params.CommandID, params.Move, time.Now())
+	if err != nil {
+		return command.Command{}, fmt.Errorf("move queued command: %w", err)
+	}
+
+	s.log.Info("queued command moved",
+		slog.Int64("command_id", cmd.ID),
+		slog.String("move", params.Move.String()),
+		slog.Int64("priority", cmd.Priority))
+
+	return cmd, nil
+}
+
+func (s *Service) GetQueuePosition(ctx context.Context, params command.GetQueuePositionParams) (command.QueuePosition, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return command.QueuePosition{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	cmd, err := s.commandRepository.GetCommandByID(ctx, params.CommandID)
+	if err != nil {
+		return command.QueuePosition{}, fmt.Errorf("get command by id: %w", err)
+	}
+
+	if cmd.Status != command.StatusQueued {
+		return command.QueuePosition{}, command.ErrCommandNotQueued
+	}
+
+	return s.commandRepository.GetQueuePosition(ctx, cmd.ID)
+}
+
+func (s *Service) GetQueueState(ctx context.Context) (command.QueueState, error) {
+	return s.commandRepository.GetQueueState(ctx)
+}
+
+func (s *Service) PauseQueue(ctx context.Context, params command.PauseQueueParams) (command.QueueState, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return command.QueueState{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	now := time.Now()
+	state, err := s.commandRepository.UpdateQueueState(ctx, command.QueueState{
+		Paused:    true,
+		PausedBy:  &params.Source,
+		Reason:    params.Reason,
+		PausedAt:  &now,
+		UpdatedAt: now,
+	})
+	if err != nil {
+		return command.QueueState{}, fmt.Errorf("update queue state: %w", err)
+	}
+
+	s.log.Info("command queue paused",
+		slog.String("paused_by", params.Source.String()),
+		slog.Any("reason", params.Reason))
+
+	return state, nil
+}
+
+func (s *Service) ResumeQueue(ctx context.Context) (command.QueueState, error) {
+	state, err := s.commandRepository.UpdateQueueState(ctx, command.QueueState{
+		Paused:    false,
+		UpdatedAt: time.Now(),
+	})
+	if err != nil {
+		return command.QueueState{}, fmt.Errorf("update queue state: %w", err)
+	}
+
+	s.log.Info("command queue resumed")
+
+	return state, nil
+}
+
 func (s *Service) CancelActiveCloudCommands(ctx context.Context) error {
 	if err := s.processingLock.WithLock(func() error {
 		// Cancel current processing command
@@ -195,14 +325,197 @@ func (s *Service) DeleteOldCommands(ctx context.Context) error {
 	return s.commandRepository.DeleteOldCommands(ctx, cutoffTime)
 }
 
-func (s *Service) cancelQueuedAndProcessingCommands(ctx context.Context) {
-	if err := s.commandRepository.CancelPendingCommands(ctx); err != nil {
-		s.log.Error("failed to cancel queued and processing commands on startup", slog.Any("error", err))
+// checkBatteryPolicy rejects the command while the battery is critical, unless the command
+// is created by the battery policy or helps charging the robot.
+func (s *Service) checkBatteryPolicy(ctx context.Context, params command.CreateCommandParams) error {
+	if params.Source == command.SourceBatteryPolicy {
+		return nil
 	}
+
+	switch params.Inputs.CommandType() {
+	case command.CommandTypeStopMovement, command.CommandTypeBatterySetCharge:
+		return nil
+	}
+
+	state, err := s.batteryPolicyRepository.GetState(ctx)
+	if err != nil {
+		return fmt.Errorf("get battery policy state: %w", err)
+	}
+
+	if state.Critical() {
+		return fmt.Errorf("%w: battery at %d%%", batterypolicy.ErrBatteryCritical, state.Percent)
+	}
+
+	return nil
+}
+
+// preemptRunningCommand applies the configured preemption policy to the running command
+// if the created command has a higher priority.
+func (s *Service) preemptRunningCommand(ctx context.Context, cmd command.Command) error {
+	cfg, err := s.configService.GetCommandConfig(ctx)
+	if err != nil {
+		return fmt.Errorf("get command config: %w", err)
+	}
+
+	policy := cfg.Preemption.Policy
+	if policy != config.PreemptionPolicyCancel && policy != config.PreemptionPolicySuspend {
+		return nil
+	}
+
+	runningCmd, err := s.runningCmdRepository.Get(ctx)
+	if err != nil {
+		if errors.Is(err, command.ErrRunningCommandNotFound) {
+			return nil
+		}
+		return fmt.Errorf("get running command: %w", err)
+	}
+
+	if !runningCmd.CanBeCanceled() || cmd.Priority <= runningCmd.Priority {
+		return nil
+	}
+
+	s.log.Info("preempting running command",
+		slog.Int64("running_command_id", runningCmd.ID),
+		slog.Int64("running_command_priority", runningCmd.Priority),
+		slog.Int64("command_id", cmd.ID),
+		slog.Int64("command_priority", cmd.Priority),
+		slog.String("policy", string(policy)))
+
+	if policy == config.PreemptionPolicyCancel {
+		return s.cancelRunningCommand(ctx, runningCmd)
+	}
+
+	runningCmd.Suspend()
+	if err := s.runningCmdRepository.Update(ctx, runningCmd); err != nil {
+		return fmt.Errorf("update running command: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) cancelRunningCommand(ctx context.Context, runningCmd command.CancelableCommand) error {
+	if _, err := s.commandRepository.UpdateCommand(ctx, command.UpdateCommandParams{
+		ID:        runningCmd.ID,
+		Status:    command.StatusCanceling,
+		SetStatus: true,
+		UpdatedAt: time.Now(),
+	}); err != nil {
+		return fmt.Errorf("update command status: %w", err)
+	}
+
+	runningCmd.Cancel()
+	if err := s.runningCmdRepository.Update(ctx, runningCmd); err != nil {
+		return fmt.Errorf("update running command: %w", err)
+	}
+
+	return nil
+}
+
+// recoverPendingCommands applies the startup recovery policy to the commands
+// that were QUEUED, PROCESSING or CANCELING when the application stopped.
+func (s *Service) recoverPendingCommands(ctx context.Context) {
+	err := s.processingLock.WithLock(func() error {
+		cfg, err := s.configService.GetCommandConfig(ctx)
+		if err != nil {
+			return fmt.Errorf("get command config: %w", err)
+		}
+
+		cmds, err := s.commandRepository.ListPendingCommands(ctx)
+		if err != nil {
+			return fmt.Errorf("list pending commands: %w", err)
+		}
+
+		policy := cfg.Recovery.Policy
+		if policy == "" {
+			policy = config.RecoveryPolicyCancelAll
+		}
+
+		for _, cmd := range cmds {
+			if err := s.recoverPendingCommand(ctx, cmd, policy, cfg.Recovery); err != nil {
+				return fmt.Errorf("recover command %d: %w", cmd.ID, err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		s.log.Error("failed to recover pending commands on startup", slog.Any("error", err))
+	}
+}
+
+func (s *Service) recoverPendingCommand(
+	ctx context.Context,
+	cmd command.Command,
+	policy config.RecoveryPolicy,
+	cfg config.Recovery,
+) error {
+	now := time.Now()
+	action := command.RecoveryActionCanceled
+	switch {
+	case policy == config.RecoveryPolicyCancelAll:
+	case cmd.Status == command.StatusQueued:
+		action = command.RecoveryActionKept
+	case policy == config.RecoveryPolicyResume &&
+		cmd.Status == command.StatusProcessing &&
+		cfg.IsResumable(cmd.Type.String()):
+		action = command.RecoveryActionRequeued
+	}
+
+	params := command.UpdateCommandParams{
+		ID: cmd.ID,
+		Recovery: &command.Recovery{
+			Action:         action,
+			Policy:         policy,
+			PreviousStatus: cmd.Status,
+			RecoveredAt:    now,
+		},
+		SetRecovery: true,
+		UpdatedAt:   now,
+	}
+
+	switch action {
+	case command.RecoveryActionCanceled:
+		params.Status = command.StatusCanceled
+		params.SetStatus = true
+		params.CompletedAt = &now
+		params.SetCompletedAt = true
+	case command.RecoveryActionRequeued:
+		params.Status = command.StatusQueued
+		params.SetStatus = true
+		params.StartedAt = nil
+		params.SetStartedAt = true
+	case command.RecoveryActionKept:
+	}
+
+	if _, err := s.commandRepository.UpdateCommand(ctx, params); err != nil {
+		return fmt.Errorf("update command: %w", err)
+	}
+
+	s.log.Info("recovered pending command on startup",
+		slog.Int64("command_id", cmd.ID),
+		slog.String("type", cmd.Type.String()),
+		slog.String("previous_status", cmd.Status.String()),
+		slog.String("policy", string(policy)),
+		slog.String("action", action.String()),
+	)
+
+	return nil
 }
 
 func (s *Service) runNextExecutableCommand(ctx context.Context) error {
-	cmd, err := s.commandRepository.GetNextExecutableCommand(ctx)
+	queueState, err := s.commandRepository.GetQueueState(ctx)
+	if err != nil {
+		return fmt.Errorf("get queue state: %w", err)
+	}
+
+	var cmd command.Command
+	if queueState.Paused {
+		// The battery policy returns to the charger even if the queue is paused,
+		// the other commands wait for the queue to be resumed.
+		cmd, err = s.commandRepository.GetNextExecutableCommandBySource(ctx, command.SourceBatteryPolicy)
+	} else {
+		cmd, err = s.commandRepository.GetNextExecutableCommand(ctx)
+	}
 	if err != nil {
 		if errors.Is(err, command.ErrNoNextExecutableCommand) {
 			return nil
@@ -5,17 +5,21 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/tbe-team/raybot/internal/config"
 	"github.com/tbe-team/raybot/internal/logging"
+	"github.com/tbe-team/raybot/internal/services/batterypolicy/batterypolicyimpl"
 	"github.com/tbe-team/raybot/internal/services/command"
 	commandmocks "github.com/tbe-team/raybot/internal/services/command/mocks"
 	"github.com/tbe-team/raybot/internal/services/command/processinglockimpl"
+	"github.com/tbe-team/raybot/internal/services/config/configimpl"
 	"github.com/tbe-team/raybot/internal/storage/db"
 	"github.com/tbe-team/raybot/internal/storage/db/sqlc"
 	"github.com/tbe-team/raybot/pkg/eventbus"
 	"github.com/tbe-team/raybot/pkg/paging"
+	"github.com/tbe-team/raybot/pkg/ptr"
 	"github.com/tbe-team/raybot/pkg/validator"
 )
 
@@ -55,8 +59,10 @@ func TestIntegrationCommandService(t *testing.T) {
 			log,
 			validator.New(),
 			eventbus.NewInProcEventBus(log),
+			configimpl.NewService(&config.Config{}, nil, nil),
 			runningCmdRepository,
 			commandRepository,
+			batterypolicyimpl.NewRepository(),
 			processinglockimpl.New(),
 			commandmocks.NewFakeExecutorService(t),
 		)
@@ -74,6 +80,83 @@ func TestIntegrationCommandService(t *testing.T) {
 		})
 		require.NoError(t, err)
 		require.Equal(t, command.StatusCanceled, cmd2.Status)
+		require.NotNil(t, cmd2.Recovery)
+		require.Equal(t, command.RecoveryActionCanceled, cmd2.Recovery.Action)
+		require.Equal(t, config.RecoveryPolicyCancelAll, cmd2.Recovery.Policy)
+		require.Equal(t, command.StatusProcessing, cmd2.Recovery.PreviousStatus)
+	})
+
+	t.Run(`Recover pending commands should keep QUEUED and requeue resumable PROCESSING commands with RESUME policy`, func(t *testing.T) {
+		log := logging.NewNoopLogger()
+		db, err := db.NewTestDB()
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+		err = db.AutoMigrate()
+		require.NoError(t, err)
+		queries := sqlc.New()
+		commandRepository := NewCommandRepository(db, queries)
+		commandService := Service{
+			log:       log,
+			validator: validator.New(),
+			publisher: eventbus.NewInProcEventBus(log),
+			configService: configimpl.NewService(&config.Config{
+				Command: config.Command{
+					Recovery: config.Recovery{
+						Policy:         config.RecoveryPolicyResume,
+						ResumableTypes: []string{command.CommandTypeCargoOpen.String()},
+					},
+				},
+			}, nil, nil),
+			runningCmdRepository: NewRunningCmdRepository(),
+			commandRepository:    commandRepository,
+			processingLock:       processinglockimpl.New(),
+			executorService:      commandmocks.NewFakeExecutorService(t),
+		}
+
+		queued, err := commandRepository.CreateCommand(context.Background(), command.Command{
+			Status: command.StatusQueued,
+			Type:   command.CommandTypeStopMovement,
+		})
+		require.NoError(t, err)
+
+		startedAt := time.Now()
+		resumable, err := commandRepository.CreateCommand(context.Background(), command.Command{
+			Status:    command.StatusProcessing,
+			Type:      command.CommandTypeCargoOpen,
+			StartedAt: &startedAt,
+		})
+		require.NoError(t, err)
+
+		notResumable, err := commandRepository.CreateCommand(context.Background(), command.Command{
+			Status: command.StatusCanceling,
+			Type:   command.CommandTypeCargoOpen,
+		})
+		require.NoError(t, err)
+
+		commandService.recoverPendingCommands(context.Background())
+
+		queued, err = commandRepository.GetCommandByID(context.Background(), queued.ID)
+		require.NoError(t, err)
+		require.Equal(t, command.StatusQueued, queued.Status)
+		require.NotNil(t, queued.Recovery)
+		require.Equal(t, command.RecoveryActionKept, queued.Recovery.Action)
+
+		resumable, err = commandRepository.GetCommandByID(context.Background(), resumable.ID)
+		require.NoError(t, err)
+		require.Equal(t, command.StatusQueued, resumable.Status)
+		require.Nil(t, resumable.StartedAt)
+		require.NotNil(t, resumable.Recovery)
+		require.Equal(t, command.RecoveryActionRequeued, resumable.Recovery.Action)
+		require.Equal(t, command.StatusProcessing, resumable.Recovery.PreviousStatus)
+
+		notResumable, err = commandRepository.GetCommandByID(context.Background(), notResumable.ID)
+		require.NoError(t, err)
+		require.Equal(t, command.StatusCanceled, notResumable.Status)
+		require.NotNil(t, notResumable.CompletedAt)
+		require.NotNil(t, notResumable.Recovery)
+		require.Equal(t, command.RecoveryActionCanceled, notResumable.Recovery.Action)
 	})
 
 	t.Run(`Get current processing command should return the command in PROCESSING status`, func(t *testing.T) {
@@ -365,4 +448,239 @@ func TestIntegrationCommandService(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, command.StatusQueued, cmd4.Status)
 	})
+
+	t.Run("Queue operations should cancel, move and locate individual queued commands", func(t *testing.T) {
+		db, err := db.NewTestDB()
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+		err = db.AutoMigrate()
+		require.NoError(t, err)
+		queries := sqlc.New()
+		commandRepository := NewCommandRepository(db, queries)
+		commandService := Service{
+			log:               logging.NewNoopLogger(),
+			validator:         validator.New(),
+			commandRepository: commandRepository,
+		}
+		ctx := context.Background()
+
+		processing, err := commandRepository.CreateCommand(ctx, command.Command{
+			Status: command.StatusProcessing,
+			Type:   command.CommandTypeStopMovement,
+		})
+		require.NoError(t, err)
+
+		queued := make([]command.Command, 3)
+		for i, priority := range []int64{5, 0, 0} {
+			queued[i], err = commandRepository.CreateCommand(ctx, command.Command{
+				Status:   command.StatusQueued,
+				Type:     command.CommandTypeStopMovement,
+				Priority: priority,
+			})
+			require.NoError(t, err)
+		}
+
+		requirePosition := func(cmd command.Command, position, length uint) {
+			t.Helper()
+			pos, err := commandService.GetQueuePosition(ctx, command.GetQueuePositionParams{CommandID: cmd.ID})
+			require.NoError(t, err)
+			require.Equal(t, position, pos.Position)
+			require.Equal(t, length, pos.QueueLength)
+		}
+
+		requirePosition(queued[0], 1, 3)
+		requirePosition(queued[1], 2, 3)
+		requirePosition(queued[2], 3, 3)
+
+		_, err = commandService.GetQueuePosition(ctx, command.GetQueuePositionParams{CommandID: processing.ID})
+		require.ErrorIs(t, err, command.ErrCommandNotQueued)
+
+		// Moving to the front raises the priority to the priority of the queue head.
+		moved, err := commandService.MoveQueuedCommand(ctx, command.MoveQueuedCommandParams{
+			CommandID: queued[2].ID,
+			Move:      command.QueueMoveFront,
+		})
+		require.NoError(t, err)
+		require.Equal(t, int64(5), moved.Priority)
+		requirePosition(queued[2], 1, 3)
+		requirePosition(queued[0], 2, 3)
+		requirePosition(queued[1], 3, 3)
+
+		next, err := commandRepository.GetNextExecutableCommand(ctx)
+		require.NoError(t, err)
+		require.Equal(t, queued[2].ID, next.ID)
+
+		// Moving to the back lowers the priority to the priority of the queue tail.
+		moved, err = commandService.MoveQueuedCommand(ctx, command.MoveQueuedCommandParams{
+			CommandID: queued[0].ID,
+			Move:      command.QueueMoveBack,
+		})
+		require.NoError(t, err)
+		require.Equal(t, int64(0), moved.Priority)
+		requirePosition(queued[2], 1, 3)
+		requirePosition(queued[1], 2, 3)
+		requirePosition(queued[0], 3, 3)
+
+		_, err = commandService.MoveQueuedCommand(ctx, command.MoveQueuedCommandParams{
+			CommandID: processing.ID,
+			Move:      command.QueueMoveFront,
+		})
+		require.ErrorIs(t, err, command.ErrCommandNotQueued)
+
+		canceled, err := commandService.CancelQueuedCommandByID(ctx, command.CancelQueuedCommandByIDParams{
+			CommandID: queued[1].ID,
+		})
+		require.NoError(t, err)
+		require.Equal(t, command.StatusCanceled, canceled.Status)
+		require.NotNil(t, canceled.CompletedAt)
+		requirePosition(queued[2], 1, 2)
+		requirePosition(queued[0], 2, 2)
+
+		_, err = commandService.CancelQueuedCommandByID(ctx, command.CancelQueuedCommandByIDParams{
+			CommandID: processing.ID,
+		})
+		require.ErrorIs(t, err, command.ErrCommandNotQueued)
+
+		_, err = commandService.CancelQueuedCommandByID(ctx, command.CancelQueuedCommandByIDParams{
+			CommandID: 1000,
+		})
+		require.ErrorIs(t, err, command.ErrCommandNotFound)
+	})
+
+	t.Run("Motion outputs should round trip through the repository", func(t *testing.T) {
+		db, err := db.NewTestDB()
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+		err = db.AutoMigrate()
+		require.NoError(t, err)
+		commandRepository := NewCommandRepository(db, sqlc.New())
+		ctx := context.Background()
+
+		pausedAt := time.Date(2025, 10, 17, 8, 0, 0, 0, time.UTC)
+		motion := command.MotionOutputs{
+			StartLocation:     "A",
+			FinalLocation:     "B",
+			TagsPassed:        []command.PassedTag{{Location: "A", PassedAt: pausedAt}},
+			TravelDuration:    12 * time.Second,
+			AverageMotorSpeed: 62.5,
+			ObstaclePauses: []command.ObstaclePause{
+				{Distance: 15, PausedAt: pausedAt, ResumedAt: ptr.New(pausedAt.Add(time.Second))},
+			},
+		}
+		testCases := []struct {
+			cmdType command.CommandType
+			outputs command.Outputs
+		}{
+			{command.CommandTypeMoveForward, &command.MoveForwardOutputs{MotionOutputs: motion}},
+			{command.CommandTypeMoveBackward, &command.MoveBackwardOutputs{MotionOutputs: motion}},
+			{command.CommandTypeMoveTo, &command.MoveToOutputs{MotionOutputs: motion, Corrections: 2}},
+			{command.CommandTypeMission, &command.MissionOutputs{Steps: []command.MissionStepOutputs{{
+				Type:    command.CommandTypeMoveTo,
+				Status:  command.StatusSucceeded,
+				Outputs: &command.MoveToOutputs{MotionOutputs: motion, Corrections: 2},
+			}}}},
+		}
+
+		for _, tc := range testCases {
+			cmd, err := commandRepository.CreateCommand(ctx, command.Command{
+				Status: command.StatusSucceeded,
+				Type:   tc.cmdType,
+			})
+			require.NoError(t, err)
+
+			_, err = commandRepository.UpdateCommand(ctx, command.UpdateCommandParams{
+				ID:         cmd.ID,
+				Outputs:    tc.outputs,
+				SetOutputs: true,
+			})
+			require.NoError(t, err)
+
+			cmd, err = commandRepository.GetCommandByID(ctx, cmd.ID)
+			require.NoError(t, err)
+			require.Equal(t, tc.outputs, cmd.Outputs, tc.cmdType.String())
+		}
+	})
+
+	t.Run("Paused queue should not start new commands until it is resumed", func(t *testing.T) {
+		db, err := db.NewTestDB()
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+		err = db.AutoMigrate()
+		require.NoError(t, err)
+		queries := sqlc.New()
+		commandRepository := NewCommandRepository(db, queries)
+		executorService := commandmocks.NewFakeExecutorService(t)
+		commandService := Service{
+			log:               logging.NewNoopLogger(),
+			validator:         validator.New(),
+			commandRepository: commandRepository,
+			processingLock:    processinglockimpl.New(),
+			executorService:   executorService,
+		}
+		ctx := context.Background()
+
+		state, err := commandService.GetQueueState(ctx)
+		require.NoError(t, err)
+		require.False(t, state.Paused)
+
+		cmd, err := commandRepository.CreateCommand(ctx, command.Command{
+			Status: command.StatusQueued,
+			Type:   command.CommandTypeStopMovement,
+		})
+		require.NoError(t, err)
+
+		state, err = commandService.PauseQueue(ctx, command.PauseQueueParams{
+			Source: command.SourceCloud,
+			Reason: ptr.New("maintenance"),
+		})
+		require.NoError(t, err)
+		require.True(t, state.Paused)
+
+		// The paused state is persisted, a new repository sees it.
+		state, err = NewCommandRepository(db, queries).GetQueueState(ctx)
+		require.NoError(t, err)
+		require.True(t, state.Paused)
+		require.Equal(t, command.SourceCloud, *state.PausedBy)
+		require.Equal(t, "maintenance", *state.Reason)
+		require.NotNil(t, state.PausedAt)
+
+		require.NoError(t, commandService.RunNextExecutableCommand(ctx))
+
+		// The battery policy returns to the charger while the queue is paused.
+		chargerCmd, err := commandRepository.CreateCommand(ctx, command.Command{
+			Status: command.StatusQueued,
+			Source: command.SourceBatteryPolicy,
+			Type:   command.CommandTypeStopMovement,
+		})
+		require.NoError(t, err)
+		executorService.EXPECT().Execute(mock.Anything, mock.MatchedBy(func(c command.Command) bool {
+			return c.ID == chargerCmd.ID
+		})).Run(func(ctx context.Context, c command.Command) {
+			_, err := commandRepository.UpdateCommand(ctx, command.UpdateCommandParams{
+				ID:        c.ID,
+				Status:    command.StatusSucceeded,
+				SetStatus: true,
+			})
+			require.NoError(t, err)
+		}).Return(nil).Once()
+		require.NoError(t, commandService.RunNextExecutableCommand(ctx))
+		require.NoError(t, commandService.RunNextExecutableCommand(ctx))
+
+		state, err = commandService.ResumeQueue(ctx)
+		require.NoError(t, err)
+		require.False(t, state.Paused)
+		require.Nil(t, state.PausedBy)
+		require.Nil(t, state.Reason)
+
+		executorService.EXPECT().Execute(mock.Anything, mock.MatchedBy(func(c command.Command) bool {
+			return c.ID == cmd.ID
+		})).Return(nil).Once()
+		require.NoError(t, commandService.RunNextExecutableCommand(ctx))
+	})
 }
@@ -8,24 +8,33 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/tbe-team/raybot/internal/config"
 	"github.com/tbe-team/raybot/internal/events"
+	"github.com/tbe-team/raybot/internal/logging"
+	"github.com/tbe-team/raybot/internal/services/batterypolicy"
+	batterypolicymocks "github.com/tbe-team/raybot/internal/services/batterypolicy/mocks"
 	"github.com/tbe-team/raybot/internal/services/command"
 	commandmocks "github.com/tbe-team/raybot/internal/services/command/mocks"
+	configmocks "github.com/tbe-team/raybot/internal/services/config/mocks"
 	eventbusmocks "github.com/tbe-team/raybot/pkg/eventbus/mocks"
+	"github.com/tbe-team/raybot/pkg/ptr"
 	"github.com/tbe-team/raybot/pkg/validator"
 )
 
 func TestService_CreateCommand(t *testing.T) {
 	publisher := eventbusmocks.NewFakePublisher(t)
 	commandRepository := commandmocks.NewFakeRepository(t)
+	configService := configmocks.NewFakeService(t)
 	commandService := Service{
 		validator:         validator.New(),
 		publisher:         publisher,
+		configService:     configService,
 		commandRepository: commandRepository,
 	}
 
 	t.Run("Create command successfully", func(t *testing.T) {
 		commandRepository.EXPECT().CreateCommand(mock.Anything, mock.Anything).Return(command.Command{}, nil)
+		configService.EXPECT().GetCommandConfig(mock.Anything).Return(config.Command{}, nil).Once()
 		publisher.EXPECT().Publish(events.CommandCreatedTopic, mock.Anything).Once()
 		command, err := commandService.CreateCommand(context.Background(), command.CreateCommandParams{
 			Source: command.SourceApp,
@@ -51,6 +60,225 @@ func TestService_CreateCommand(t *testing.T) {
 			})
 			require.Error(t, err)
 		})
+
+		t.Run("Should return validation error when priority is out of range", func(t *testing.T) {
+			_, err := commandService.CreateCommand(context.Background(), command.CreateCommandParams{
+				Source:   command.SourceApp,
+				Inputs:   command.StopMovementInputs{},
+				Priority: command.PriorityMax + 1,
+			})
+			require.Error(t, err)
+		})
+
+		t.Run("Should return validation error when timeout is less than a second", func(t *testing.T) {
+			_, err := commandService.CreateCommand(context.Background(), command.CreateCommandParams{
+				Source:  command.SourceApp,
+				Inputs:  command.StopMovementInputs{},
+				Timeout: ptr.New(time.Millisecond),
+			})
+			require.Error(t, err)
+		})
+
+		t.Run("Should return error when deadline is in the past", func(t *testing.T) {
+			_, err := commandService.CreateCommand(context.Background(), command.CreateCommandParams{
+				Source:   command.SourceApp,
+				Inputs:   command.StopMovementInputs{},
+				Deadline: ptr.New(time.Now().Add(-time.Second)),
+			})
+			require.ErrorIs(t, err, command.ErrCommandDeadlineInThePast)
+		})
+
+		t.Run("Should return error when retry policy is invalid", func(t *testing.T) {
+			_, err := commandService.CreateCommand(context.Background(), command.CreateCommandParams{
+				Source: command.SourceApp,
+				Inputs: command.StopMovementInputs{},
+				RetryPolicy: &config.RetryPolicy{
+					MaxAttempts:     2,
+					RetryableErrors: []config.RetryableError{"UNKNOWN"},
+				},
+			})
+			require.ErrorIs(t, err, command.ErrInvalidRetryPolicy)
+		})
+
+		t.Run("Should return error when a mission has a retry policy", func(t *testing.T) {
+			_, err := commandService.CreateCommand(context.Background(), command.CreateCommandParams{
+				Source: command.SourceApp,
+				Inputs: &command.MissionInputs{
+					Steps: []command.MissionStep{{Inputs: &command.CargoOpenInputs{MotorSpeed: 50}}},
+				},
+				RetryPolicy: &config.RetryPolicy{
+					MaxAttempts:     2,
+					RetryableErrors: []config.RetryableError{config.RetryableErrorACKTimeout},
+				},
+			})
+			require.ErrorIs(t, err, command.ErrInvalidRetryPolicy)
+		})
+	})
+}
+
+func TestService_CreateCommand_BatteryPolicy(t *testing.T) {
+	newService := func(t *testing.T, level batterypolicy.Level) (Service, *commandmocks.FakeRepository, *eventbusmocks.FakePublisher, *configmocks.FakeService) {
+		publisher := eventbusmocks.NewFakePublisher(t)
+		commandRepository := commandmocks.NewFakeRepository(t)
+		configService := configmocks.NewFakeService(t)
+		batteryPolicyRepository := batterypolicymocks.NewFakeRepository(t)
+		batteryPolicyRepository.EXPECT().GetState(mock.Anything).Return(batterypolicy.State{
+			Level:   level,
+			Percent: 5,
+		}, nil).Maybe()
+
+		commandService := Service{
+			validator:               validator.New(),
+			publisher:               publisher,
+			configService:           configService,
+			commandRepository:       commandRepository,
+			batteryPolicyRepository: batteryPolicyRepository,
+		}
+
+		return commandService, commandRepository, publisher, configService
+	}
+
+	t.Run("Should reject command when battery is critical", func(t *testing.T) {
+		commandService, _, _, _ := newService(t, batterypolicy.LevelCritical)
+
+		_, err := commandService.CreateCommand(context.Background(), command.CreateCommandParams{
+			Source: command.SourceApp,
+			Inputs: &command.WaitInputs{DurationMs: 1000},
+		})
+		require.ErrorIs(t, err, batterypolicy.ErrBatteryCritical)
+	})
+
+	t.Run("Should accept charging command when battery is critical", func(t *testing.T) {
+		commandService, commandRepository, publisher, configService := newService(t, batterypolicy.LevelCritical)
+		commandRepository.EXPECT().CreateCommand(mock.Anything, mock.Anything).Return(command.Command{}, nil)
+		configService.EXPECT().GetCommandConfig(mock.Anything).Return(config.Command{}, nil).Once()
+		publisher.EXPECT().Publish(events.CommandCreatedTopic, mock.Anything).Once()
+
+		_, err := commandService.CreateCommand(context.Background(), command.CreateCommandParams{
+			Source: command.SourceApp,
+			Inputs: &command.BatterySetChargeInputs{CurrentLimit: 1000, Enabled: true},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("Should accept battery policy command when battery is critical", func(t *testing.T) {
+		commandService, commandRepository, publisher, configService := newService(t, batterypolicy.LevelCritical)
+		commandRepository.EXPECT().CreateCommand(mock.Anything, mock.Anything).Return(command.Command{}, nil)
+		configService.EXPECT().GetCommandConfig(mock.Anything).Return(config.Command{}, nil).Once()
+		publisher.EXPECT().Publish(events.CommandCreatedTopic, mock.Anything).Once()
+
+		_, err := commandService.CreateCommand(context.Background(), command.CreateCommandParams{
+			Source: command.SourceBatteryPolicy,
+			Inputs: &command.WaitInputs{DurationMs: 1000},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("Should accept command when battery is at warning", func(t *testing.T) {
+		commandService, commandRepository, publisher, configService := newService(t, batterypolicy.LevelWarning)
+		commandRepository.EXPECT().CreateCommand(mock.Anything, mock.Anything).Return(command.Command{}, nil)
+		configService.EXPECT().GetCommandConfig(mock.Anything).Return(config.Command{}, nil).Once()
+		publisher.EXPECT().Publish(events.CommandCreatedTopic, mock.Anything).Once()
+
+		_, err := commandService.CreateCommand(context.Background(), command.CreateCommandParams{
+			Source: command.SourceApp,
+			Inputs: &command.WaitInputs{DurationMs: 1000},
+		})
+		require.NoError(t, err)
+	})
+}
+
+func TestService_CreateCommand_Preemption(t *testing.T) {
+	newService := func(t *testing.T, policy config.PreemptionPolicy, runningPriority int64) (Service, command.CancelableCommand, *commandmocks.FakeRepository, *commandmocks.FakeRunningCommandRepository) {
+		publisher := eventbusmocks.NewFakePublisher(t)
+		commandRepository := commandmocks.NewFakeRepository(t)
+		runningCommandRepository := commandmocks.NewFakeRunningCommandRepository(t)
+		configService := configmocks.NewFakeService(t)
+		commandService := Service{
+			log:                  logging.NewNoopLogger(),
+			validator:            validator.New(),
+			publisher:            publisher,
+			configService:        configService,
+			commandRepository:    commandRepository,
+			runningCmdRepository: runningCommandRepository,
+		}
+
+		runningCmd := command.NewCancelableCommand(context.Background(), command.Command{
+			ID:       1,
+			Status:   command.StatusProcessing,
+			Priority: runningPriority,
+		})
+
+		commandRepository.EXPECT().CreateCommand(mock.Anything, mock.Anything).
+			RunAndReturn(func(_ context.Context, cmd command.Command) (command.Command, error) {
+				cmd.ID = 2
+				return cmd, nil
+			})
+		publisher.EXPECT().Publish(events.CommandCreatedTopic, mock.Anything).Once()
+		configService.EXPECT().GetCommandConfig(mock.Anything).Return(config.Command{
+			Preemption: config.Preemption{Policy: policy},
+		}, nil)
+
+		return commandService, runningCmd, commandRepository, runningCommandRepository
+	}
+
+	t.Run("Should cancel running command with lower priority", func(t *testing.T) {
+		commandService, runningCmd, commandRepository, runningCommandRepository := newService(t, config.PreemptionPolicyCancel, 0)
+
+		runningCommandRepository.EXPECT().Get(mock.Anything).Return(runningCmd, nil)
+		commandRepository.EXPECT().UpdateCommand(mock.Anything, mock.MatchedBy(func(params command.UpdateCommandParams) bool {
+			return params.ID == runningCmd.ID && params.Status == command.StatusCanceling && params.SetStatus
+		})).Return(command.Command{}, nil)
+		runningCommandRepository.EXPECT().Update(mock.Anything, mock.Anything).Return(nil)
+
+		_, err := commandService.CreateCommand(context.Background(), command.CreateCommandParams{
+			Source:   command.SourceCloud,
+			Inputs:   &command.StopMovementInputs{},
+			Priority: 10,
+		})
+		require.NoError(t, err)
+		require.ErrorIs(t, context.Cause(runningCmd.Context()), context.Canceled)
+	})
+
+	t.Run("Should suspend running command with lower priority", func(t *testing.T) {
+		commandService, runningCmd, _, runningCommandRepository := newService(t, config.PreemptionPolicySuspend, 0)
+
+		runningCommandRepository.EXPECT().Get(mock.Anything).Return(runningCmd, nil)
+		runningCommandRepository.EXPECT().Update(mock.Anything, mock.Anything).Return(nil)
+
+		_, err := commandService.CreateCommand(context.Background(), command.CreateCommandParams{
+			Source:   command.SourceCloud,
+			Inputs:   &command.StopMovementInputs{},
+			Priority: 10,
+		})
+		require.NoError(t, err)
+		require.ErrorIs(t, context.Cause(runningCmd.Context()), command.ErrCommandSuspended)
+	})
+
+	t.Run("Should not preempt running command with the same or higher priority", func(t *testing.T) {
+		commandService, runningCmd, _, runningCommandRepository := newService(t, config.PreemptionPolicyCancel, 10)
+
+		runningCommandRepository.EXPECT().Get(mock.Anything).Return(runningCmd, nil)
+
+		_, err := commandService.CreateCommand(context.Background(), command.CreateCommandParams{
+			Source:   command.SourceCloud,
+			Inputs:   &command.StopMovementInputs{},
+			Priority: 10,
+		})
+		require.NoError(t, err)
+		require.NoError(t, runningCmd.Context().Err())
+	})
+
+	t.Run("Should not preempt when policy is NONE", func(t *testing.T) {
+		commandService, runningCmd, _, _ := newService(t, config.PreemptionPolicyNone, 0)
+
+		_, err := commandService.CreateCommand(context.Background(), command.CreateCommandParams{
+			Source:   command.SourceCloud,
+			Inputs:   &command.StopMovementInputs{},
+			Priority: 10,
+		})
+		require.NoError(t, err)
+		require.NoError(t, runningCmd.Context().Err())
 	})
 }
 
@@ -0,0 +1,69 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/tbe-team/raybot/internal/events"
+	"github.com/tbe-team/raybot/internal/services/battery"
+	"github.com/tbe-team/raybot/internal/services/command"
+)
+
+type batterySetChargeExecutor struct {
+	log            *slog.Logger
+	batteryService battery.Service
+	confirmer      batterySettingConfirmer
+}
+
+func newBatterySetChargeExecutor(
+	log *slog.Logger,
+	batteryService battery.Service,
+	confirmer batterySettingConfirmer,
+) CommandExecutor[command.BatterySetChargeInputs, command.BatterySetChargeOutputs] {
+	return batterySetChargeExecutor{
+		log:            log,
+		batteryService: batteryService,
+		confirmer:      confirmer,
+	}
+}
+
+func (e batterySetChargeExecutor) Execute(ctx context.Context, inputs command.BatterySetChargeInputs) (command.BatterySetChargeOutputs, error) {
+	cfg, err := e.confirmer.getConfig(ctx)
+	if err != nil {
+		return command.BatterySetChargeOutputs{}, err
+	}
+
+	if err := validateCurrentLimit(inputs.CurrentLimit, cfg.MaxChargeCurrentLimit); err != nil {
+		return command.BatterySetChargeOutputs{}, err
+	}
+
+	confirmedAt, err := e.confirmer.set(ctx, cfg.ConfirmTimeout, events.BatteryChargeSettingUpdatedTopic,
+		func(payload any) bool {
+			ev, ok := payload.(events.BatteryChargeSettingUpdatedEvent)
+			return ok && ev.CurrentLimit == inputs.CurrentLimit && ev.Enabled == inputs.Enabled
+		},
+		func(ctx context.Context) error {
+			if err := e.batteryService.SetChargeSetting(ctx, battery.SetChargeSettingParams{
+				CurrentLimit: inputs.CurrentLimit,
+				Enabled:      inputs.Enabled,
+			}); err != nil {
+				return fmt.Errorf("failed to set charge setting: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return command.BatterySetChargeOutputs{}, err
+	}
+
+	e.log.Info("battery charge setting confirmed",
+		slog.Int64("current_limit", int64(inputs.CurrentLimit)),
+		slog.Bool("enabled", inputs.Enabled))
+
+	return command.BatterySetChargeOutputs{ConfirmedAt: confirmedAt}, nil
+}
+
+func (batterySetChargeExecutor) OnCancel(_ context.Context) error {
+	return nil
+}
@@ -0,0 +1,72 @@
+package executor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tbe-team/raybot/internal/config"
+	"github.com/tbe-team/raybot/internal/events"
+	"github.com/tbe-team/raybot/internal/logging"
+	"github.com/tbe-team/raybot/internal/services/battery"
+	batterymocks "github.com/tbe-team/raybot/internal/services/battery/mocks"
+	"github.com/tbe-team/raybot/internal/services/command"
+	"github.com/tbe-team/raybot/pkg/eventbus"
+)
+
+func TestBatterySetChargeExecutor_Execute(t *testing.T) {
+	commandCfg := config.Command{
+		Battery: config.Battery{
+			MaxChargeCurrentLimit: 2000,
+			ConfirmTimeout:        100 * time.Millisecond,
+		},
+	}
+
+	t.Run("Should set the charge setting once the sync state confirms it", func(t *testing.T) {
+		log := logging.NewNoopLogger()
+		bus := eventbus.NewInProcEventBus(log)
+		batteryService := batterymocks.NewFakeService(t)
+		e := newBatterySetChargeExecutor(log, batteryService,
+			newBatterySettingConfirmer(log, bus, newFakeConfigService(t, commandCfg)))
+
+		batteryService.EXPECT().SetChargeSetting(mock.Anything, battery.SetChargeSettingParams{
+			CurrentLimit: 1500,
+			Enabled:      true,
+		}).Run(func(context.Context, battery.SetChargeSettingParams) {
+			// a sync state of the previous setting does not confirm the new one
+			bus.Publish(events.BatteryChargeSettingUpdatedTopic, eventbus.NewMessage(
+				events.BatteryChargeSettingUpdatedEvent{CurrentLimit: 1000, Enabled: false}))
+			bus.Publish(events.BatteryChargeSettingUpdatedTopic, eventbus.NewMessage(
+				events.BatteryChargeSettingUpdatedEvent{CurrentLimit: 1500, Enabled: true}))
+		}).Return(nil)
+
+		outputs, err := e.Execute(context.Background(), command.BatterySetChargeInputs{CurrentLimit: 1500, Enabled: true})
+		require.NoError(t, err)
+		require.False(t, outputs.ConfirmedAt.IsZero())
+	})
+
+	t.Run("Should fail if the current limit is above the maximum", func(t *testing.T) {
+		log := logging.NewNoopLogger()
+		batteryService := batterymocks.NewFakeService(t)
+		e := newBatterySetChargeExecutor(log, batteryService,
+			newBatterySettingConfirmer(log, &eventbus.NoopEventBus{}, newFakeConfigService(t, commandCfg)))
+
+		_, err := e.Execute(context.Background(), command.BatterySetChargeInputs{CurrentLimit: 2500, Enabled: true})
+		require.ErrorIs(t, err, battery.ErrCurrentLimitExceeded)
+	})
+
+	t.Run("Should fail if the sync state does not confirm the setting", func(t *testing.T) {
+		log := logging.NewNoopLogger()
+		batteryService := batterymocks.NewFakeService(t)
+		e := newBatterySetChargeExecutor(log, batteryService,
+			newBatterySettingConfirmer(log, &eventbus.NoopEventBus{}, newFakeConfigService(t, commandCfg)))
+
+		batteryService.EXPECT().SetChargeSetting(mock.Anything, mock.Anything).Return(nil)
+
+		_, err := e.Execute(context.Background(), command.BatterySetChargeInputs{CurrentLimit: 1500, Enabled: true})
+		require.ErrorIs(t, err, battery.ErrSettingNotConfirmed)
+	})
+}
@@ -0,0 +1,69 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/tbe-team/raybot/internal/events"
+	"github.com/tbe-team/raybot/internal/services/battery"
+	"github.com/tbe-team/raybot/internal/services/command"
+)
+
+type batterySetDischargeExecutor struct {
+	log            *slog.Logger
+	batteryService battery.Service
+	confirmer      batterySettingConfirmer
+}
+
+func newBatterySetDischargeExecutor(
+	log *slog.Logger,
+	batteryService battery.Service,
+	confirmer batterySettingConfirmer,
+) CommandExecutor[command.BatterySetDischargeInputs, command.BatterySetDischargeOutputs] {
+	return batterySetDischargeExecutor{
+		log:            log,
+		batteryService: batteryService,
+		confirmer:      confirmer,
+	}
+}
+
+func (e batterySetDischargeExecutor) Execute(ctx context.Context, inputs command.BatterySetDischargeInputs) (command.BatterySetDischargeOutputs, error) {
+	cfg, err := e.confirmer.getConfig(ctx)
+	if err != nil {
+		return command.BatterySetDischargeOutputs{}, err
+	}
+
+	if err := validateCurrentLimit(inputs.CurrentLimit, cfg.MaxDischargeCurrentLimit); err != nil {
+		return command.BatterySetDischargeOutputs{}, err
+	}
+
+	confirmedAt, err := e.confirmer.set(ctx, cfg.ConfirmTimeout, events.BatteryDischargeSettingUpdatedTopic,
+		func(payload any) bool {
+			ev, ok := payload.(events.BatteryDischargeSettingUpdatedEvent)
+			return ok && ev.CurrentLimit == inputs.CurrentLimit && ev.Enabled == inputs.Enabled
+		},
+		func(ctx context.Context) error {
+			if err := e.batteryService.SetDischargeSetting(ctx, battery.SetDischargeSettingParams{
+				CurrentLimit: inputs.CurrentLimit,
+				Enabled:      inputs.Enabled,
+			}); err != nil {
+				return fmt.Errorf("failed to set discharge setting: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return command.BatterySetDischargeOutputs{}, err
+	}
+
+	e.log.Info("battery discharge setting confirmed",
+		slog.Int64("current_limit", int64(inputs.CurrentLimit)),
+		slog.Bool("enabled", inputs.Enabled))
+
+	return command.BatterySetDischargeOutputs{ConfirmedAt: confirmedAt}, nil
+}
+
+func (batterySetDischargeExecutor) OnCancel(_ context.Context) error {
+	return nil
+}
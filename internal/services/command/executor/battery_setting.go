@@ -0,0 +1,90 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/tbe-team/raybot/internal/config"
+	"github.com/tbe-team/raybot/internal/services/battery"
+	configservice "github.com/tbe-team/raybot/internal/services/config"
+	"github.com/tbe-team/raybot/pkg/eventbus"
+)
+
+// batterySettingConfirmer sends a battery setting to the PIC and waits
+// until the PIC reports the same setting in its sync state.
+type batterySettingConfirmer struct {
+	log           *slog.Logger
+	subscriber    eventbus.Subscriber
+	configService configservice.Service
+}
+
+func newBatterySettingConfirmer(
+	log *slog.Logger,
+	subscriber eventbus.Subscriber,
+	configService configservice.Service,
+) batterySettingConfirmer {
+	return batterySettingConfirmer{
+		log:           log,
+		subscriber:    subscriber,
+		configService: configService,
+	}
+}
+
+// getConfig returns the battery config of the commands.
+func (c batterySettingConfirmer) getConfig(ctx context.Context) (config.Battery, error) {
+	commandCfg, err := c.configService.GetCommandConfig(ctx)
+	if err != nil {
+		return config.Battery{}, fmt.Errorf("failed to get command config: %w", err)
+	}
+	return commandCfg.Battery, nil
+}
+
+// set subscribes to the setting topic, runs send and waits for an event matching the setting.
+// It returns battery.ErrSettingNotConfirmed if no event matches before the confirm timeout.
+func (c batterySettingConfirmer) set(
+	ctx context.Context,
+	confirmTimeout time.Duration,
+	topic string,
+	matches func(payload any) bool,
+	send func(ctx context.Context) error,
+) (time.Time, error) {
+	confirmCtx, cancel := context.WithTimeout(ctx, confirmTimeout)
+	defer cancel()
+
+	confirmedCh := make(chan time.Time, 1)
+	c.subscriber.Subscribe(confirmCtx, topic, func(msg *eventbus.Message) {
+		if !matches(msg.Payload) {
+			return
+		}
+
+		select {
+		case confirmedCh <- time.Now():
+		default:
+		}
+	})
+
+	if err := send(ctx); err != nil {
+		return time.Time{}, err
+	}
+
+	select {
+	case confirmedAt := <-confirmedCh:
+		return confirmedAt, nil
+	case <-confirmCtx.Done():
+		if ctx.Err() != nil {
+			return time.Time{}, ctx.Err()
+		}
+		return time.Time{}, fmt.Errorf("%w: not reported after %s", battery.ErrSettingNotConfirmed, confirmTimeout)
+	}
+}
+
+// validateCurrentLimit returns battery.ErrCurrentLimitExceeded if the current limit is
+// above the maximum. A maximum of 0 means no maximum.
+func validateCurrentLimit(currentLimit, maxCurrentLimit uint16) error {
+	if maxCurrentLimit > 0 && currentLimit > maxCurrentLimit {
+		return fmt.Errorf("%w: %d is above the maximum %d", battery.ErrCurrentLimitExceeded, currentLimit, maxCurrentLimit)
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/tbe-team/raybot/internal/events"
+	"github.com/tbe-team/raybot/internal/services/command"
+	"github.com/tbe-team/raybot/internal/services/distancesensor"
+	"github.com/tbe-team/raybot/internal/services/liftmotor"
+	"github.com/tbe-team/raybot/internal/services/limitswitch"
+	"github.com/tbe-team/raybot/pkg/eventbus"
+)
+
+// cargoHomeMaxSpeed caps the lift speed while homing,
+// so the lift does not hit the top limit switch hard.
+const cargoHomeMaxSpeed = 30
+
+type cargoHomeExecutor struct {
+	log                   *slog.Logger
+	subscriber            eventbus.Subscriber
+	liftMotorService      liftmotor.Service
+	limitSwitchService    limitswitch.Service
+	distanceSensorService distancesensor.Service
+	progressReporter      progressReporter
+}
+
+func newCargoHomeExecutor(
+	log *slog.Logger,
+	subscriber eventbus.Subscriber,
+	liftMotorService liftmotor.Service,
+	limitSwitchService limitswitch.Service,
+	distanceSensorService distancesensor.Service,
+	progressReporter progressReporter,
+) CommandExecutor[command.CargoHomeInputs, command.CargoHomeOutputs] {
+	return cargoHomeExecutor{
+		log:                   log,
+		subscriber:            subscriber,
+		liftMotorService:      liftMotorService,
+		limitSwitchService:    limitSwitchService,
+		distanceSensorService: distanceSensorService,
+		progressReporter:      progressReporter,
+	}
+}
+
+// Execute raises the lift until the top limit switch is pressed, then saves
+// the down distance read at the limit switch as the zero offset of the lift positions.
+func (e cargoHomeExecutor) Execute(ctx context.Context, inputs command.CargoHomeInputs) (command.CargoHomeOutputs, error) {
+	limitSwitchState, err := e.limitSwitchService.GetLimitSwitchState(ctx)
+	if err != nil {
+		return command.CargoHomeOutputs{}, fmt.Errorf("failed to get limit switch state: %w", err)
+	}
+
+	if !limitSwitchState.LimitSwitch1.Pressed {
+		if err := e.raiseUntilLimitSwitchPressed(ctx, min(inputs.MotorSpeed, cargoHomeMaxSpeed)); err != nil {
+			return command.CargoHomeOutputs{}, err
+		}
+	}
+
+	distanceSensorState, err := e.distanceSensorService.GetDistanceSensorState(ctx)
+	if err != nil {
+		return command.CargoHomeOutputs{}, fmt.Errorf("failed to get distance sensor state: %w", err)
+	}
+
+	calibration, err := e.liftMotorService.UpdateCalibration(ctx, liftmotor.UpdateCalibrationParams{
+		ZeroOffset: distanceSensorState.DownDistance,
+	})
+	if err != nil {
+		return command.CargoHomeOutputs{}, fmt.Errorf("failed to update lift calibration: %w", err)
+	}
+
+	e.log.Info("lift calibrated", slog.Int64("zero_offset", int64(calibration.ZeroOffset)))
+
+	outputs := command.CargoHomeOutputs{ZeroOffset: calibration.ZeroOffset}
+	if calibration.CalibratedAt != nil {
+		outputs.CalibratedAt = *calibration.CalibratedAt
+	}
+	return outputs, nil
+}
+
+func (e cargoHomeExecutor) OnCancel(ctx context.Context) error {
+	if err := e.liftMotorService.Stop(ctx); err != nil {
+		return fmt.Errorf("failed to stop lift motor: %w", err)
+	}
+	return nil
+}
+
+func (e cargoHomeExecutor) raiseUntilLimitSwitchPressed(ctx context.Context, speed uint8) error {
+	trackingCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pressedCh := make(chan struct{}, 1)
+	e.subscriber.Subscribe(trackingCtx, events.LimitSwitch1PressedTopic, func(*eventbus.Message) {
+		select {
+		case pressedCh <- struct{}{}:
+		default:
+		}
+	})
+
+	e.log.Info("raising lift to the limit switch", slog.Int("speed", int(speed)))
+	e.progressReporter.report(ctx, command.ProgressPhaseLifting, nil,
+		fmt.Sprintf("raising lift to the limit switch at speed %d", speed))
+
+	// The top position is never reached, the lift is stopped by the limit switch.
+	if err := e.liftMotorService.SetCargoPosition(ctx, liftmotor.SetCargoPositionParams{
+		MotorSpeed: speed,
+		Position:   0,
+	}); err != nil {
+		return fmt.Errorf("failed to set cargo position: %w", err)
+	}
+
+	select {
+	case <-pressedCh:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	if err := e.liftMotorService.Stop(ctx); err != nil {
+		return fmt.Errorf("failed to stop lift motor: %w", err)
+	}
+	return nil
+}
+
+// getLiftCalibration returns the lift calibration used to convert the positions of the
+// lift commands to down distances. An uncalibrated lift has a zero offset, so the
+// positions are used as they are.
+func getLiftCalibration(ctx context.Context, log *slog.Logger, liftMotorService liftmotor.Service) (liftmotor.Calibration, error) {
+	calibration, err := liftMotorService.GetCalibration(ctx)
+	if err != nil {
+		return liftmotor.Calibration{}, fmt.Errorf("failed to get lift calibration: %w", err)
+	}
+
+	if !calibration.Calibrated() {
+		log.Warn("lift is not calibrated, run CARGO_HOME to calibrate the lift positions")
+	}
+	return calibration, nil
+}
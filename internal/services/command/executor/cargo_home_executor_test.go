@@ -0,0 +1,94 @@
+package executor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tbe-team/raybot/internal/events"
+	"github.com/tbe-team/raybot/internal/logging"
+	"github.com/tbe-team/raybot/internal/services/command"
+	"github.com/tbe-team/raybot/internal/services/distancesensor"
+	distancesensormocks "github.com/tbe-team/raybot/internal/services/distancesensor/mocks"
+	"github.com/tbe-team/raybot/internal/services/liftmotor"
+	liftmotormocks "github.com/tbe-team/raybot/internal/services/liftmotor/mocks"
+	"github.com/tbe-team/raybot/internal/services/limitswitch"
+	limitswitchmocks "github.com/tbe-team/raybot/internal/services/limitswitch/mocks"
+	"github.com/tbe-team/raybot/pkg/eventbus"
+)
+
+func TestCargoHomeExecutor_Execute(t *testing.T) {
+	calibratedAt := time.Now()
+
+	t.Run("Should raise the lift until the limit switch is pressed and save the zero offset", func(t *testing.T) {
+		log := logging.NewNoopLogger()
+		bus := eventbus.NewInProcEventBus(log)
+		liftMotorService := liftmotormocks.NewFakeService(t)
+		limitSwitchService := limitswitchmocks.NewFakeService(t)
+		distanceSensorService := distancesensormocks.NewFakeService(t)
+		e := newCargoHomeExecutor(log, bus, liftMotorService, limitSwitchService, distanceSensorService, progressReporter{})
+
+		limitSwitchService.EXPECT().GetLimitSwitchState(mock.Anything).
+			Return(limitswitch.GetLimitSwitchStateOutput{}, nil)
+		raisedCh := make(chan struct{})
+		liftMotorService.EXPECT().SetCargoPosition(mock.Anything, liftmotor.SetCargoPositionParams{
+			MotorSpeed: cargoHomeMaxSpeed,
+			Position:   0,
+		}).Run(func(context.Context, liftmotor.SetCargoPositionParams) { close(raisedCh) }).Return(nil).Once()
+		liftMotorService.EXPECT().Stop(mock.Anything).Return(nil).Once()
+		distanceSensorService.EXPECT().GetDistanceSensorState(mock.Anything).
+			Return(distancesensor.DistanceSensorState{DownDistance: 12}, nil)
+		liftMotorService.EXPECT().UpdateCalibration(mock.Anything, liftmotor.UpdateCalibrationParams{ZeroOffset: 12}).
+			Return(liftmotor.Calibration{ZeroOffset: 12, CalibratedAt: &calibratedAt}, nil)
+
+		go func() {
+			<-raisedCh
+			bus.Publish(events.LimitSwitch1PressedTopic, eventbus.NewMessage(events.LimitSwitch1PressedEvent{}))
+		}()
+
+		outputs, err := e.Execute(context.Background(), command.CargoHomeInputs{MotorSpeed: 100})
+		require.NoError(t, err)
+		require.Equal(t, command.CargoHomeOutputs{ZeroOffset: 12, CalibratedAt: calibratedAt}, outputs)
+	})
+
+	t.Run("Should not move the lift if the limit switch is already pressed", func(t *testing.T) {
+		log := logging.NewNoopLogger()
+		liftMotorService := liftmotormocks.NewFakeService(t)
+		limitSwitchService := limitswitchmocks.NewFakeService(t)
+		distanceSensorService := distancesensormocks.NewFakeService(t)
+		e := newCargoHomeExecutor(log, &eventbus.NoopEventBus{}, liftMotorService, limitSwitchService, distanceSensorService, progressReporter{})
+
+		limitSwitchService.EXPECT().GetLimitSwitchState(mock.Anything).
+			Return(limitswitch.GetLimitSwitchStateOutput{LimitSwitch1: limitswitch.LimitSwitch{Pressed: true}}, nil)
+		distanceSensorService.EXPECT().GetDistanceSensorState(mock.Anything).
+			Return(distancesensor.DistanceSensorState{DownDistance: 10}, nil)
+		liftMotorService.EXPECT().UpdateCalibration(mock.Anything, liftmotor.UpdateCalibrationParams{ZeroOffset: 10}).
+			Return(liftmotor.Calibration{ZeroOffset: 10, CalibratedAt: &calibratedAt}, nil)
+
+		outputs, err := e.Execute(context.Background(), command.CargoHomeInputs{MotorSpeed: 20})
+		require.NoError(t, err)
+		require.Equal(t, uint16(10), outputs.ZeroOffset)
+	})
+
+	t.Run("Should not calibrate if canceled before the limit switch is pressed", func(t *testing.T) {
+		log := logging.NewNoopLogger()
+		liftMotorService := liftmotormocks.NewFakeService(t)
+		limitSwitchService := limitswitchmocks.NewFakeService(t)
+		distanceSensorService := distancesensormocks.NewFakeService(t)
+		e := newCargoHomeExecutor(log, &eventbus.NoopEventBus{}, liftMotorService, limitSwitchService, distanceSensorService, progressReporter{})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		limitSwitchService.EXPECT().GetLimitSwitchState(mock.Anything).
+			Return(limitswitch.GetLimitSwitchStateOutput{}, nil)
+		liftMotorService.EXPECT().SetCargoPosition(mock.Anything, liftmotor.SetCargoPositionParams{
+			MotorSpeed: 20,
+			Position:   0,
+		}).Run(func(context.Context, liftmotor.SetCargoPositionParams) { cancel() }).Return(nil).Once()
+
+		_, err := e.Execute(ctx, command.CargoHomeInputs{MotorSpeed: 20})
+		require.ErrorIs(t, err, context.Canceled)
+	})
+}
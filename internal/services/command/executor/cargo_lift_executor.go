@@ -20,6 +20,7 @@ type cargoLiftExecutor struct {
 	configService         config.Service
 	liftMotorService      liftmotor.Service
 	distanceSensorService distancesensor.Service
+	progressReporter      progressReporter
 }
 
 func newCargoLiftExecutor(
@@ -28,6 +29,7 @@ func newCargoLiftExecutor(
 	configService config.Service,
 	liftMotorService liftmotor.Service,
 	distanceSensorService distancesensor.Service,
+	progressReporter progressReporter,
 ) CommandExecutor[command.CargoLiftInputs, command.CargoLiftOutputs] {
 	return cargoLiftExecutor{
 		log:                   log,
@@ -35,33 +37,43 @@ func newCargoLiftExecutor(
 		configService:         configService,
 		liftMotorService:      liftMotorService,
 		distanceSensorService: distanceSensorService,
+		progressReporter:      progressReporter,
 	}
 }
 
 func (e cargoLiftExecutor) Execute(ctx context.Context, inputs command.CargoLiftInputs) (command.CargoLiftOutputs, error) {
+	calibration, err := getLiftCalibration(ctx, e.log, e.liftMotorService)
+	if err != nil {
+		return command.CargoLiftOutputs{}, err
+	}
+	position := calibration.RawPosition(inputs.Position)
+
 	distanceSensorState, err := e.distanceSensorService.GetDistanceSensorState(ctx)
 	if err != nil {
 		return command.CargoLiftOutputs{}, fmt.Errorf("failed to get distance sensor state: %w", err)
 	}
 
-	if e.isLiftPositionReached(distanceSensorState.DownDistance, inputs.Position) {
+	if e.isLiftPositionReached(distanceSensorState.DownDistance, position) {
 		return command.CargoLiftOutputs{}, nil
 	}
 
+	progress := newLiftProgress(e.progressReporter, command.ProgressPhaseLifting, position)
+	progress.setStart(distanceSensorState.DownDistance)
+
 	wg := sync.WaitGroup{}
 	readyCh := make(chan struct{}, 1)
 
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		e.trackingLiftPositionUntilReached(ctx, inputs.Position, readyCh)
+		e.trackingLiftPositionUntilReached(ctx, position, progress, readyCh)
 	}()
 
 	<-readyCh
 
 	if err := e.liftMotorService.SetCargoPosition(ctx, liftmotor.SetCargoPositionParams{
 		MotorSpeed: inputs.MotorSpeed,
-		Position:   inputs.Position,
+		Position:   position,
 	}); err != nil {
 		return command.CargoLiftOutputs{}, fmt.Errorf("failed to set cargo position: %w", err)
 	}
@@ -83,7 +95,12 @@ func (e cargoLiftExecutor) OnCancel(ctx context.Context) error {
 	return nil
 }
 
-func (e cargoLiftExecutor) trackingLiftPositionUntilReached(ctx context.Context, liftPosition uint16, readyCh chan<- struct{}) {
+func (e cargoLiftExecutor) trackingLiftPositionUntilReached(
+	ctx context.Context,
+	liftPosition uint16,
+	progress *liftProgress,
+	readyCh chan<- struct{},
+) {
 	ctx, cancel := context.WithCancel(ctx)
 	defer func() {
 		e.log.Info("stop tracking lift position")
@@ -105,6 +122,8 @@ func (e cargoLiftExecutor) trackingLiftPositionUntilReached(ctx context.Context,
 			return
 		}
 
+		progress.update(ctx, ev.DownDistance)
+
 		if e.isLiftPositionReached(ev.DownDistance, liftPosition) {
 			stableReadCount++
 			e.log.Info("lift position reached",
@@ -19,6 +19,7 @@ type cargoLowerExecutor struct {
 	subscriber       eventbus.Subscriber
 	configService    configservice.Service
 	liftMotorService liftmotor.Service
+	progressReporter progressReporter
 }
 
 func newCargoLowerExecutor(
@@ -26,16 +27,25 @@ func newCargoLowerExecutor(
 	subscriber eventbus.Subscriber,
 	configService configservice.Service,
 	liftMotorService liftmotor.Service,
+	progressReporter progressReporter,
 ) CommandExecutor[command.CargoLowerInputs, command.CargoLowerOutputs] {
 	return cargoLowerExecutor{
 		log:              log,
 		subscriber:       subscriber,
 		configService:    configService,
 		liftMotorService: liftMotorService,
+		progressReporter: progressReporter,
 	}
 }
 
 func (e cargoLowerExecutor) Execute(ctx context.Context, inputs command.CargoLowerInputs) (command.CargoLowerOutputs, error) {
+	calibration, err := getLiftCalibration(ctx, e.log, e.liftMotorService)
+	if err != nil {
+		return command.CargoLowerOutputs{}, err
+	}
+	// the tracking and the motor use the down distance of the position
+	inputs.Position = calibration.RawPosition(inputs.Position)
+
 	wg := sync.WaitGroup{}
 
 	obstacleCtx, cancelObstacleTracking := context.WithCancel(ctx)
@@ -55,7 +65,8 @@ func (e cargoLowerExecutor) Execute(ctx context.Context, inputs command.CargoLow
 			wg.Done()
 			cancelObstacleTracking()
 		}()
-		e.trackingLowerPositionUntilReached(ctx, inputs.Position, readyCh)
+		e.trackingLowerPositionUntilReached(ctx, inputs.Position,
+			newLiftProgress(e.progressReporter, command.ProgressPhaseLowering, inputs.Position), readyCh)
 	}()
 
 	<-readyCh
@@ -84,7 +95,14 @@ func (e cargoLowerExecutor) OnCancel(ctx context.Context) error {
 	return nil
 }
 
-func (e cargoLowerExecutor) trackingLowerPositionUntilReached(ctx context.Context, lowerPosition uint16, readyCh chan<- struct{}) {
+// trackingLowerPositionUntilReached waits for the lower position. The position
+// is not read before lowering, so the progress starts from the first distance read.
+func (e cargoLowerExecutor) trackingLowerPositionUntilReached(
+	ctx context.Context,
+	lowerPosition uint16,
+	progress *liftProgress,
+	readyCh chan<- struct{},
+) {
 	ctx, cancel := context.WithCancel(ctx)
 	defer func() {
 		e.log.Info("stop tracking lower position")
@@ -106,6 +124,8 @@ func (e cargoLowerExecutor) trackingLowerPositionUntilReached(ctx context.Contex
 			return
 		}
 
+		progress.update(ctx, ev.DownDistance)
+
 		if e.isLowerPositionReached(ev.DownDistance, lowerPosition) {
 			stableReadCount++
 			e.log.Info("lower position reached",
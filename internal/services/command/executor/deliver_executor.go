@@ -0,0 +1,312 @@
+package executor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/tbe-team/raybot/internal/services/command"
+	"github.com/tbe-team/raybot/internal/services/distancesensor"
+	"github.com/tbe-team/raybot/internal/services/liftmotor"
+	"github.com/tbe-team/raybot/internal/services/trackmap"
+	"github.com/tbe-team/raybot/pkg/ptr"
+)
+
+// deliverRollbackTimeout bounds the rollback, which is not canceled
+// together with the command so that the cargo is not left open.
+const deliverRollbackTimeout = time.Minute
+
+type deliverExecutor struct {
+	log                   *slog.Logger
+	router                commandRouter
+	trackMapService       trackmap.Service
+	distanceSensorService distancesensor.Service
+	liftMotorService      liftmotor.Service
+
+	// currentStep keeps the phase being executed so OnCancel
+	// can run the cancel hook of the right executor.
+	currentStep *missionCurrentStep
+}
+
+func newDeliverExecutor(
+	log *slog.Logger,
+	router commandRouter,
+	trackMapService trackmap.Service,
+	distanceSensorService distancesensor.Service,
+	liftMotorService liftmotor.Service,
+) CommandExecutor[command.DeliverInputs, command.DeliverOutputs] {
+	return deliverExecutor{
+		log:                   log,
+		router:                router,
+		trackMapService:       trackMapService,
+		distanceSensorService: distanceSensorService,
+		liftMotorService:      liftMotorService,
+		currentStep:           &missionCurrentStep{},
+	}
+}
+
+// Execute runs the delivery phases through their executors. If a phase fails or the
+// command is canceled after the cargo was lowered, the cargo is closed and lifted back.
+func (e deliverExecutor) Execute(ctx context.Context, inputs command.DeliverInputs) (command.DeliverOutputs, error) {
+	outputs := command.DeliverOutputs{
+		Phases:   []command.MissionStepOutputs{},
+		Rollback: []command.MissionStepOutputs{},
+	}
+
+	location, err := e.resolveStation(ctx, inputs.Station, inputs.Direction)
+	if err != nil {
+		return outputs, err
+	}
+	outputs.Location = location
+
+	liftPosition, err := e.getLiftPosition(ctx)
+	if err != nil {
+		return outputs, err
+	}
+
+	phases := e.phases(inputs, location, liftPosition)
+	for _, phase := range phases {
+		outputs.Phases = append(outputs.Phases, command.MissionStepOutputs{
+			Type:   phase.CommandType(),
+			Status: command.StatusQueued,
+		})
+	}
+
+	e.setCurrentStep(nil)
+
+	var lowered, opened bool
+	for i, phase := range phases {
+		if ctx.Err() != nil {
+			e.cancelPhasesFrom(&outputs, i)
+			break
+		}
+
+		switch phase.CommandType() {
+		case command.CommandTypeCargoLower:
+			lowered = true
+		case command.CommandTypeCargoOpen:
+			opened = true
+		}
+
+		err = e.runStep(ctx, &outputs.Phases[i], phase)
+		if err != nil || ctx.Err() != nil {
+			e.cancelPhasesFrom(&outputs, i+1)
+			err = fmt.Errorf("deliver phase %d (%s) failed: %w", i, phase.CommandType(), err)
+			break
+		}
+
+		switch phase.CommandType() {
+		case command.CommandTypeCargoClose:
+			opened = false
+		case command.CommandTypeCargoLift:
+			lowered = false
+		}
+	}
+
+	if ctx.Err() != nil {
+		// the phase executor stops on its cancel hook, which must run before the rollback
+		if stopErr := e.OnCancel(context.WithoutCancel(ctx)); stopErr != nil {
+			e.log.Error("failed to stop deliver phase", slog.Any("error", stopErr))
+		}
+		err = ctx.Err()
+	}
+
+	if err == nil {
+		return outputs, nil
+	}
+
+	if rollbackErr := e.rollback(ctx, &outputs, inputs, liftPosition, lowered, opened); rollbackErr != nil {
+		return outputs, errors.Join(err, rollbackErr)
+	}
+
+	return outputs, err
+}
+
+func (e deliverExecutor) OnCancel(ctx context.Context) error {
+	e.currentStep.mu.Lock()
+	stepCmd := e.currentStep.cmd
+	e.currentStep.cmd = nil
+	e.currentStep.mu.Unlock()
+
+	if stepCmd == nil {
+		return nil
+	}
+
+	if err := e.router.runCancelHook(ctx, *stepCmd); err != nil {
+		return fmt.Errorf("failed to cancel deliver phase: %w", err)
+	}
+	return nil
+}
+
+// phases returns the inputs of the delivery phases in order.
+func (deliverExecutor) phases(inputs command.DeliverInputs, location string, liftPosition uint16) []command.Inputs {
+	var checkPhase command.Inputs = &command.WaitInputs{DurationMs: inputs.WaitDurationMs}
+	if inputs.QRCode != "" {
+		checkPhase = &command.CargoCheckQRInputs{QRCode: inputs.QRCode}
+	}
+
+	direction := inputs.Direction
+	if direction == "" {
+		direction = command.MoveDirectionAuto
+	}
+
+	return []command.Inputs{
+		&command.MoveToInputs{
+			Location:   location,
+			Direction:  direction,
+			MotorSpeed: inputs.MoveSpeed,
+		},
+		&command.CargoLowerInputs{Position: inputs.LowerPosition, MotorSpeed: inputs.LiftSpeed},
+		&command.CargoOpenInputs{MotorSpeed: inputs.DoorSpeed},
+		checkPhase,
+		&command.CargoCloseInputs{MotorSpeed: inputs.DoorSpeed},
+		&command.CargoLiftInputs{Position: liftPosition, MotorSpeed: inputs.LiftSpeed},
+	}
+}
+
+// rollback closes the cargo if it was opened and lifts it if it was lowered.
+// It is not canceled together with the command.
+func (e deliverExecutor) rollback(
+	ctx context.Context,
+	outputs *command.DeliverOutputs,
+	inputs command.DeliverInputs,
+	liftPosition uint16,
+	lowered bool,
+	opened bool,
+) error {
+	var steps []command.Inputs
+	if opened {
+		steps = append(steps, &command.CargoCloseInputs{MotorSpeed: inputs.DoorSpeed})
+	}
+	if lowered {
+		steps = append(steps, &command.CargoLiftInputs{Position: liftPosition, MotorSpeed: inputs.LiftSpeed})
+	}
+	if len(steps) == 0 {
+		return nil
+	}
+
+	e.log.Warn("delivery failed, rolling back",
+		slog.Bool("close_cargo", opened),
+		slog.Bool("lift_cargo", lowered))
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), deliverRollbackTimeout)
+	defer cancel()
+
+	for _, step := range steps {
+		outputs.Rollback = append(outputs.Rollback, command.MissionStepOutputs{
+			Type:   step.CommandType(),
+			Status: command.StatusQueued,
+		})
+	}
+
+	for i, step := range steps {
+		if err := e.runStep(ctx, &outputs.Rollback[i], step); err != nil {
+			e.setCurrentStep(nil)
+			for j := i + 1; j < len(outputs.Rollback); j++ {
+				outputs.Rollback[j].Status = command.StatusCanceled
+			}
+			return fmt.Errorf("deliver rollback (%s) failed: %w", step.CommandType(), err)
+		}
+	}
+
+	return nil
+}
+
+// runStep routes the step to its executor and records its result.
+func (e deliverExecutor) runStep(ctx context.Context, stepOutputs *command.MissionStepOutputs, inputs command.Inputs) error {
+	stepCmd := command.Command{
+		Type:   inputs.CommandType(),
+		Inputs: inputs,
+	}
+	e.setCurrentStep(&stepCmd)
+
+	stepOutputs.Status = command.StatusProcessing
+	stepOutputs.StartedAt = ptr.New(time.Now())
+
+	e.log.Info("executing deliver phase",
+		slog.String("command_type", stepCmd.Type.String()),
+		slog.Any("command_inputs", stepCmd.Inputs))
+
+	out, err := e.router.routeStep(ctx, stepCmd)
+	stepOutputs.Outputs = out
+	stepOutputs.CompletedAt = ptr.New(time.Now())
+
+	switch {
+	case ctx.Err() != nil:
+		// The current step is kept so that OnCancel can stop it.
+		stepOutputs.Status = command.StatusCanceled
+		return ctx.Err()
+
+	case err != nil:
+		stepOutputs.Status = command.StatusFailed
+		stepOutputs.Error = ptr.New(err.Error())
+		e.setCurrentStep(nil)
+		return err
+
+	default:
+		stepOutputs.Status = command.StatusSucceeded
+		e.setCurrentStep(nil)
+		return nil
+	}
+}
+
+// getLiftPosition returns the position of the cargo before lowering, where it is lifted back.
+// The position is relative to the lift calibration, like the inputs of CARGO_LIFT.
+func (e deliverExecutor) getLiftPosition(ctx context.Context) (uint16, error) {
+	state, err := e.distanceSensorService.GetDistanceSensorState(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get distance sensor state: %w", err)
+	}
+
+	calibration, err := getLiftCalibration(ctx, e.log, e.liftMotorService)
+	if err != nil {
+		return 0, err
+	}
+
+	if state.DownDistance < calibration.ZeroOffset {
+		return 0, nil
+	}
+	return state.DownDistance - calibration.ZeroOffset, nil
+}
+
+// resolveStation returns the location of the station. The station is a tag location,
+// or a station name in the track map. Without a track map, the station is used as the location
+// if the direction is given, since AUTO can not pick one.
+func (e deliverExecutor) resolveStation(ctx context.Context, station string, direction command.MoveDirection) (string, error) {
+	trackMap, err := e.trackMapService.GetTrackMap(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get track map: %w", err)
+	}
+
+	if len(trackMap.Tags) == 0 {
+		if direction == "" || direction == command.MoveDirectionAuto {
+			return "", fmt.Errorf("%w: the direction to the station is required", trackmap.ErrTrackMapEmpty)
+		}
+		return station, nil
+	}
+
+	if trackMap.IndexOf(station) >= 0 {
+		return station, nil
+	}
+
+	i := trackMap.IndexOfName(station)
+	if i < 0 {
+		return "", fmt.Errorf("%w: station %s", trackmap.ErrTagNotFound, station)
+	}
+	return trackMap.Tags[i].Location, nil
+}
+
+func (e deliverExecutor) setCurrentStep(cmd *command.Command) {
+	e.currentStep.mu.Lock()
+	defer e.currentStep.mu.Unlock()
+	e.currentStep.cmd = cmd
+}
+
+// cancelPhasesFrom marks all phases starting from index as canceled.
+func (deliverExecutor) cancelPhasesFrom(outputs *command.DeliverOutputs, index int) {
+	for i := index; i < len(outputs.Phases); i++ {
+		outputs.Phases[i].Status = command.StatusCanceled
+	}
+}
@@ -0,0 +1,206 @@
+package executor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tbe-team/raybot/internal/logging"
+	"github.com/tbe-team/raybot/internal/services/command"
+	"github.com/tbe-team/raybot/internal/services/distancesensor"
+	distancesensormocks "github.com/tbe-team/raybot/internal/services/distancesensor/mocks"
+	"github.com/tbe-team/raybot/internal/services/liftmotor"
+	liftmotormocks "github.com/tbe-team/raybot/internal/services/liftmotor/mocks"
+	"github.com/tbe-team/raybot/internal/services/trackmap"
+	trackmapmocks "github.com/tbe-team/raybot/internal/services/trackmap/mocks"
+	"github.com/tbe-team/raybot/pkg/ptr"
+)
+
+func TestDeliverExecutor_Execute(t *testing.T) {
+	trackMap := trackmap.TrackMap{
+		Topology: trackmap.TopologyLinear,
+		Tags: []trackmap.Tag{
+			{Location: "A"}, {Location: "B", Name: "dock"}, {Location: "C"},
+		},
+	}
+
+	inputs := command.DeliverInputs{
+		Station:       "dock",
+		LowerPosition: 200,
+		QRCode:        "qr",
+		MoveSpeed:     80,
+		LiftSpeed:     50,
+		DoorSpeed:     40,
+	}
+
+	setupWithTrackMap := func(
+		t *testing.T,
+		router *fakeCommandRouter,
+		trackMap trackmap.TrackMap,
+	) CommandExecutor[command.DeliverInputs, command.DeliverOutputs] {
+		trackMapService := trackmapmocks.NewFakeService(t)
+		trackMapService.EXPECT().GetTrackMap(mock.Anything).Return(trackMap, nil)
+		distanceSensorService := distancesensormocks.NewFakeService(t)
+		distanceSensorService.EXPECT().GetDistanceSensorState(mock.Anything).
+			Return(distancesensor.DistanceSensorState{DownDistance: 25}, nil).Maybe()
+		liftMotorService := liftmotormocks.NewFakeService(t)
+		liftMotorService.EXPECT().GetCalibration(mock.Anything).
+			Return(liftmotor.Calibration{ZeroOffset: 5, CalibratedAt: ptr.New(time.Now())}, nil).Maybe()
+
+		return newDeliverExecutor(logging.NewNoopLogger(), router, trackMapService, distanceSensorService, liftMotorService)
+	}
+
+	setup := func(t *testing.T, router *fakeCommandRouter) CommandExecutor[command.DeliverInputs, command.DeliverOutputs] {
+		return setupWithTrackMap(t, router, trackMap)
+	}
+
+	t.Run("Should run all phases at the station", func(t *testing.T) {
+		router := &fakeCommandRouter{}
+		e := setup(t, router)
+
+		outputs, err := e.Execute(context.Background(), inputs)
+		require.NoError(t, err)
+		require.Equal(t, "B", outputs.Location)
+		require.Equal(t, []command.CommandType{
+			command.CommandTypeMoveTo,
+			command.CommandTypeCargoLower,
+			command.CommandTypeCargoOpen,
+			command.CommandTypeCargoCheckQR,
+			command.CommandTypeCargoClose,
+			command.CommandTypeCargoLift,
+		}, router.routed)
+		require.Equal(t, "B", router.routedInputs[0].(*command.MoveToInputs).Location)
+		require.Equal(t, command.MoveDirectionAuto, router.routedInputs[0].(*command.MoveToInputs).Direction)
+		require.Equal(t, uint16(20), router.routedInputs[5].(*command.CargoLiftInputs).Position)
+
+		require.Len(t, outputs.Phases, 6)
+		for _, phase := range outputs.Phases {
+			require.Equal(t, command.StatusSucceeded, phase.Status)
+			require.NotNil(t, phase.StartedAt)
+			require.NotNil(t, phase.CompletedAt)
+		}
+		require.Empty(t, outputs.Rollback)
+	})
+
+	t.Run("Should wait without QR code", func(t *testing.T) {
+		router := &fakeCommandRouter{}
+		e := setup(t, router)
+
+		in := inputs
+		in.QRCode = ""
+		in.WaitDurationMs = 1000
+
+		_, err := e.Execute(context.Background(), in)
+		require.NoError(t, err)
+		require.Equal(t, command.CommandTypeWait, router.routed[3])
+		require.Equal(t, int64(1000), router.routedInputs[3].(*command.WaitInputs).DurationMs)
+	})
+
+	t.Run("Should close and lift the cargo if a phase fails", func(t *testing.T) {
+		qrErr := errors.New("qr code mismatch")
+		router := &fakeCommandRouter{
+			errs: map[command.CommandType]error{command.CommandTypeCargoCheckQR: qrErr},
+		}
+		e := setup(t, router)
+
+		outputs, err := e.Execute(context.Background(), inputs)
+		require.ErrorIs(t, err, qrErr)
+		require.Equal(t, []command.CommandType{
+			command.CommandTypeMoveTo,
+			command.CommandTypeCargoLower,
+			command.CommandTypeCargoOpen,
+			command.CommandTypeCargoCheckQR,
+			command.CommandTypeCargoClose,
+			command.CommandTypeCargoLift,
+		}, router.routed)
+
+		require.Equal(t, command.StatusFailed, outputs.Phases[3].Status)
+		require.Equal(t, command.StatusCanceled, outputs.Phases[4].Status)
+		require.Equal(t, command.StatusCanceled, outputs.Phases[5].Status)
+		require.Len(t, outputs.Rollback, 2)
+		for _, step := range outputs.Rollback {
+			require.Equal(t, command.StatusSucceeded, step.Status)
+		}
+	})
+
+	t.Run("Should not roll back if moving to the station fails", func(t *testing.T) {
+		moveErr := errors.New("move error")
+		router := &fakeCommandRouter{
+			errs: map[command.CommandType]error{command.CommandTypeMoveTo: moveErr},
+		}
+		e := setup(t, router)
+
+		outputs, err := e.Execute(context.Background(), inputs)
+		require.ErrorIs(t, err, moveErr)
+		require.Equal(t, []command.CommandType{command.CommandTypeMoveTo}, router.routed)
+		require.Empty(t, outputs.Rollback)
+	})
+
+	t.Run("Should stop the current phase and roll back if canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		router := &fakeCommandRouter{
+			onRoute: func(cmdType command.CommandType) {
+				if cmdType == command.CommandTypeCargoLower {
+					cancel()
+				}
+			},
+		}
+		e := setup(t, router)
+
+		outputs, err := e.Execute(ctx, inputs)
+		require.ErrorIs(t, err, context.Canceled)
+		require.Equal(t, []command.CommandType{command.CommandTypeCargoLower}, router.canceled)
+		require.Equal(t, []command.CommandType{
+			command.CommandTypeMoveTo,
+			command.CommandTypeCargoLower,
+			command.CommandTypeCargoLift,
+		}, router.routed)
+		require.Equal(t, command.StatusCanceled, outputs.Phases[1].Status)
+		require.Len(t, outputs.Rollback, 1)
+		require.Equal(t, command.StatusSucceeded, outputs.Rollback[0].Status)
+
+		require.NoError(t, e.OnCancel(context.Background()))
+		require.Len(t, router.canceled, 1)
+	})
+
+	t.Run("Should fail if the station is not in the track map", func(t *testing.T) {
+		router := &fakeCommandRouter{}
+		e := setup(t, router)
+
+		in := inputs
+		in.Station = "unknown"
+
+		_, err := e.Execute(context.Background(), in)
+		require.ErrorIs(t, err, trackmap.ErrTagNotFound)
+		require.Empty(t, router.routed)
+	})
+
+	t.Run("Should fail without a track map and a direction", func(t *testing.T) {
+		router := &fakeCommandRouter{}
+		e := setupWithTrackMap(t, router, trackmap.TrackMap{})
+
+		_, err := e.Execute(context.Background(), inputs)
+		require.ErrorIs(t, err, trackmap.ErrTrackMapEmpty)
+		require.Empty(t, router.routed)
+	})
+
+	t.Run("Should move to the station in the direction without a track map", func(t *testing.T) {
+		router := &fakeCommandRouter{}
+		e := setupWithTrackMap(t, router, trackmap.TrackMap{})
+
+		in := inputs
+		in.Station = "B"
+		in.Direction = command.MoveDirectionForward
+
+		outputs, err := e.Execute(context.Background(), in)
+		require.NoError(t, err)
+		require.Equal(t, "B", outputs.Location)
+		moveTo := router.routedInputs[0].(*command.MoveToInputs)
+		require.Equal(t, "B", moveTo.Location)
+		require.Equal(t, command.MoveDirectionForward, moveTo.Direction)
+	})
+}
@@ -0,0 +1,99 @@
+package executor
+
+import (
+	"context"
+	"sync"
+
+	"github.com/tbe-team/raybot/internal/services/command"
+	"github.com/tbe-team/raybot/internal/services/drivemotor"
+)
+
+// driveMotion controls the drive motor of a running drive command.
+// The obstacle tracking and the speed control share it, so a speed change
+// does not start the motor while it is paused for an obstacle.
+type driveMotion struct {
+	driveMotorService drivemotor.Service
+	recorder          *travelRecorder
+	direction         command.MoveDirection
+
+	mu     sync.Mutex
+	speed  uint8
+	paused bool
+}
+
+func newDriveMotion(
+	driveMotorService drivemotor.Service,
+	recorder *travelRecorder,
+	direction command.MoveDirection,
+	speed uint8,
+) *driveMotion {
+	return &driveMotion{
+		driveMotorService: driveMotorService,
+		recorder:          recorder,
+		direction:         direction,
+		speed:             speed,
+	}
+}
+
+// start runs the drive motor in the direction at the current speed.
+func (m *driveMotion) start(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.move(ctx)
+}
+
+// setSpeed changes the speed of the drive motor.
+// If the motion is paused, the speed is used when it is resumed.
+func (m *driveMotion) setSpeed(ctx context.Context, speed uint8) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.speed == speed {
+		return nil
+	}
+	m.speed = speed
+
+	if m.paused {
+		return nil
+	}
+	return m.move(ctx)
+}
+
+// pause stops the drive motor until resume is called.
+func (m *driveMotion) pause(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.paused = true
+	if err := m.driveMotorService.Stop(ctx); err != nil {
+		return err
+	}
+
+	m.recorder.motorStopped()
+	return nil
+}
+
+// resume runs the drive motor again at the current speed.
+func (m *driveMotion) resume(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.paused = false
+	return m.move(ctx)
+}
+
+func (m *driveMotion) move(ctx context.Context) error {
+	var err error
+	if m.direction == command.MoveDirectionBackward {
+		err = m.driveMotorService.MoveBackward(ctx, drivemotor.MoveBackwardParams{Speed: m.speed})
+	} else {
+		err = m.driveMotorService.MoveForward(ctx, drivemotor.MoveForwardParams{Speed: m.speed})
+	}
+	if err != nil {
+		return err
+	}
+
+	m.recorder.motorRunning(m.speed)
+	return nil
+}
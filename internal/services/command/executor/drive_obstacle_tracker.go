@@ -0,0 +1,132 @@
+package executor
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/tbe-team/raybot/internal/config"
+	"github.com/tbe-team/raybot/internal/events"
+	"github.com/tbe-team/raybot/internal/services/command"
+	configservice "github.com/tbe-team/raybot/internal/services/config"
+	"github.com/tbe-team/raybot/pkg/eventbus"
+	"github.com/tbe-team/raybot/pkg/ptr"
+)
+
+// driveObstacleTracker stops the drive motor when an obstacle blocks the rail
+// in the direction of travel and moves again when the obstacle is cleared.
+type driveObstacleTracker struct {
+	log           *slog.Logger
+	subscriber    eventbus.Subscriber
+	configService configservice.Service
+}
+
+func newDriveObstacleTracker(
+	log *slog.Logger,
+	subscriber eventbus.Subscriber,
+	configService configservice.Service,
+) driveObstacleTracker {
+	return driveObstacleTracker{
+		log:           log,
+		subscriber:    subscriber,
+		configService: configService,
+	}
+}
+
+// tracksManualDrive reports whether MOVE_FORWARD and MOVE_BACKWARD track the obstacles,
+// which requires both the obstacle tracking and the manual drive tracking in the command config.
+func (t driveObstacleTracker) tracksManualDrive(ctx context.Context) bool {
+	commandCfg, err := t.configService.GetCommandConfig(ctx)
+	if err != nil {
+		t.log.Error("failed to get command config", slog.Any("error", err))
+		return false
+	}
+	return commandCfg.Drive.ObstacleTrackingEnabled() && commandCfg.Drive.TrackManualDrive
+}
+
+// tracking tracks the obstacles in the direction of the motion until the context
+// is canceled and returns the pauses of the drive motor.
+// The motion must be started before.
+func (t driveObstacleTracker) tracking(ctx context.Context, motion *driveMotion) []command.ObstaclePause {
+	ctx, cancel := context.WithCancel(ctx)
+	defer func() {
+		t.log.Info("stop tracking drive obstacle")
+		cancel()
+	}()
+
+	pauses := []command.ObstaclePause{}
+
+	obstacleTracking, ok := t.getObstacleTracking(ctx)
+	if !ok {
+		<-ctx.Done()
+		return pauses
+	}
+
+	distanceCh := make(chan uint16, 1)
+
+	t.log.Info("start tracking drive obstacle", slog.String("direction", motion.direction.String()))
+	t.subscriber.Subscribe(ctx, events.DistanceSensorUpdatedTopic, func(msg *eventbus.Message) {
+		ev, ok := msg.Payload.(events.UpdateDistanceSensorEvent)
+		if !ok {
+			t.log.Error("invalid event", slog.Any("event", msg.Payload))
+			return
+		}
+
+		distance := ev.FrontDistance
+		if motion.direction == command.MoveDirectionBackward {
+			distance = ev.BackDistance
+		}
+
+		select {
+		case distanceCh <- distance:
+		default:
+			t.log.Error("dropped message from drive distance channel",
+				slog.Uint64("distance", uint64(distance)))
+		}
+	})
+
+	isMotorRunning := true
+
+	for {
+		select {
+		case <-ctx.Done():
+			return pauses
+
+		case distance := <-distanceCh:
+			// If the distance is less than the enter distance, we stop the motor
+			if distance <= obstacleTracking.EnterDistance && isMotorRunning {
+				t.log.Info("obstacle detected, stopping drive motor", slog.Uint64("distance", uint64(distance)))
+				if err := motion.pause(ctx); err != nil {
+					t.log.Error("failed to stop drive motor", slog.Any("error", err))
+				}
+
+				pauses = append(pauses, command.ObstaclePause{
+					Distance: distance,
+					PausedAt: time.Now(),
+				})
+				isMotorRunning = false
+				continue
+			}
+
+			// If the distance is greater than the exit distance, we run motor again
+			if distance >= obstacleTracking.ExitDistance && !isMotorRunning {
+				t.log.Info("obstacle cleared, running drive motor again", slog.Uint64("distance", uint64(distance)))
+				if err := motion.resume(ctx); err != nil {
+					t.log.Error("failed to run drive motor", slog.Any("error", err))
+				}
+
+				pauses[len(pauses)-1].ResumedAt = ptr.New(time.Now())
+				isMotorRunning = true
+			}
+		}
+	}
+}
+
+func (t driveObstacleTracker) getObstacleTracking(ctx context.Context) (config.ObstacleTracking, bool) {
+	commandCfg, err := t.configService.GetCommandConfig(ctx)
+	if err != nil {
+		t.log.Error("failed to get command config", slog.Any("error", err))
+		return config.ObstacleTracking{}, false
+	}
+	return commandCfg.Drive.ObstacleTracking, commandCfg.Drive.ObstacleTrackingEnabled()
+}
@@ -0,0 +1,129 @@
+package executor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tbe-team/raybot/internal/config"
+	"github.com/tbe-team/raybot/internal/events"
+	"github.com/tbe-team/raybot/internal/logging"
+	"github.com/tbe-team/raybot/internal/services/command"
+	"github.com/tbe-team/raybot/internal/services/drivemotor"
+	drivemotormocks "github.com/tbe-team/raybot/internal/services/drivemotor/mocks"
+	"github.com/tbe-team/raybot/internal/services/location"
+	locationmocks "github.com/tbe-team/raybot/internal/services/location/mocks"
+	"github.com/tbe-team/raybot/pkg/eventbus"
+)
+
+func TestDriveObstacleTracker_Tracking(t *testing.T) {
+	commandCfg := config.Command{
+		Drive: config.Drive{
+			ObstacleTracking: config.ObstacleTracking{
+				EnterDistance: 20,
+				ExitDistance:  30,
+			},
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		direction command.MoveDirection
+		blocked   events.UpdateDistanceSensorEvent
+		cleared   events.UpdateDistanceSensorEvent
+		expectRun func(driveMotorService *drivemotormocks.FakeService, resumedCh chan struct{})
+	}{
+		{
+			name:      "Should pause on front obstacle when moving forward",
+			direction: command.MoveDirectionForward,
+			blocked:   events.UpdateDistanceSensorEvent{FrontDistance: 15, BackDistance: 100},
+			cleared:   events.UpdateDistanceSensorEvent{FrontDistance: 35, BackDistance: 100},
+			expectRun: func(driveMotorService *drivemotormocks.FakeService, resumedCh chan struct{}) {
+				driveMotorService.EXPECT().MoveForward(mock.Anything, drivemotor.MoveForwardParams{Speed: 50}).
+					Run(func(context.Context, drivemotor.MoveForwardParams) { close(resumedCh) }).
+					Return(nil).Once()
+			},
+		},
+		{
+			name:      "Should pause on back obstacle when moving backward",
+			direction: command.MoveDirectionBackward,
+			blocked:   events.UpdateDistanceSensorEvent{FrontDistance: 100, BackDistance: 15},
+			cleared:   events.UpdateDistanceSensorEvent{FrontDistance: 10, BackDistance: 35},
+			expectRun: func(driveMotorService *drivemotormocks.FakeService, resumedCh chan struct{}) {
+				driveMotorService.EXPECT().MoveBackward(mock.Anything, drivemotor.MoveBackwardParams{Speed: 50}).
+					Run(func(context.Context, drivemotor.MoveBackwardParams) { close(resumedCh) }).
+					Return(nil).Once()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			log := logging.NewNoopLogger()
+			bus := eventbus.NewInProcEventBus(log)
+			driveMotorService := drivemotormocks.NewFakeService(t)
+			tracker := newDriveObstacleTracker(log, bus, newFakeConfigService(t, commandCfg))
+
+			stoppedCh := make(chan struct{})
+			resumedCh := make(chan struct{})
+			driveMotorService.EXPECT().Stop(mock.Anything).
+				Run(func(context.Context) { close(stoppedCh) }).
+				Return(nil).Once()
+			tc.expectRun(driveMotorService, resumedCh)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			pausesCh := make(chan []command.ObstaclePause, 1)
+			go func() {
+				pausesCh <- tracker.tracking(ctx, newDriveMotion(driveMotorService, newTravelRecorder(log, bus), tc.direction, 50))
+			}()
+
+			publishDistanceUntil(t, bus, tc.blocked, stoppedCh)
+			publishDistanceUntil(t, bus, tc.cleared, resumedCh)
+			cancel()
+
+			pauses := <-pausesCh
+			require.Len(t, pauses, 1)
+			require.Equal(t, uint16(15), pauses[0].Distance)
+			require.NotNil(t, pauses[0].ResumedAt)
+		})
+	}
+
+	t.Run("Should not track manual drive if the manual drive tracking is off", func(t *testing.T) {
+		log := logging.NewNoopLogger()
+		bus := &eventbus.NoopEventBus{}
+		driveMotorService := drivemotormocks.NewFakeService(t)
+		locationService := locationmocks.NewFakeService(t)
+		e := newMoveForwardExecutor(log, bus, driveMotorService, locationService,
+			newDriveObstacleTracker(log, bus, newFakeConfigService(t, commandCfg)), progressReporter{})
+
+		locationService.EXPECT().GetLocation(mock.Anything).Return(location.Location{CurrentLocation: "A"}, nil)
+		driveMotorService.EXPECT().MoveForward(mock.Anything, drivemotor.MoveForwardParams{Speed: 50}).Return(nil)
+
+		outputs, err := e.Execute(context.Background(), command.MoveForwardInputs{MotorSpeed: 50})
+		require.NoError(t, err)
+		require.Equal(t, "A", outputs.StartLocation)
+		require.Empty(t, outputs.FinalLocation)
+		require.Empty(t, outputs.ObstaclePauses)
+	})
+}
+
+// publishDistanceUntil publishes the distance sensor event until doneCh is closed.
+func publishDistanceUntil(t *testing.T, bus eventbus.Publisher, ev events.UpdateDistanceSensorEvent, doneCh <-chan struct{}) {
+	t.Helper()
+
+	timeout := time.After(time.Second)
+	for {
+		bus.Publish(events.DistanceSensorUpdatedTopic, eventbus.NewMessage(ev))
+
+		select {
+		case <-doneCh:
+			return
+		case <-timeout:
+			t.Fatal("timed out waiting for drive motor")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/tbe-team/raybot/internal/services/command"
+	"github.com/tbe-team/raybot/internal/services/drivemotor"
+	"github.com/tbe-team/raybot/internal/services/location"
+	"github.com/tbe-team/raybot/pkg/eventbus"
+)
+
+// manualDriver runs the MOVE_FORWARD and MOVE_BACKWARD commands,
+// which move in a direction without a target location.
+type manualDriver struct {
+	log               *slog.Logger
+	subscriber        eventbus.Subscriber
+	driveMotorService drivemotor.Service
+	locationService   location.Service
+	obstacleTracker   driveObstacleTracker
+	progressReporter  progressReporter
+}
+
+// drive starts moving in the direction and returns once the drive motor is started.
+// If the manual drive tracking is enabled, it keeps running until the context is canceled
+// so that the obstacles can be tracked.
+func (d manualDriver) drive(ctx context.Context, direction command.MoveDirection, speed uint8) (command.MotionOutputs, error) {
+	startLocation := d.getCurrentLocation(ctx)
+
+	recordCtx, cancelRecording := context.WithCancel(ctx)
+	defer cancelRecording()
+
+	recorder := newTravelRecorder(d.log, d.subscriber)
+	recorder.start(recordCtx)
+
+	motion := newDriveMotion(d.driveMotorService, recorder, direction, speed)
+	if err := motion.start(ctx); err != nil {
+		return recorder.outputs(startLocation, startLocation, nil),
+			fmt.Errorf("failed to move %s: %w", strings.ToLower(direction.String()), err)
+	}
+
+	d.progressReporter.report(ctx, command.ProgressPhaseMoving, nil,
+		fmt.Sprintf("moving %s at speed %d", strings.ToLower(direction.String()), speed))
+
+	if !d.obstacleTracker.tracksManualDrive(ctx) {
+		// the robot is still moving, so there is no final location
+		return recorder.outputs(startLocation, "", nil), nil
+	}
+
+	pauses := d.obstacleTracker.tracking(ctx, motion)
+	recorder.motorStopped()
+
+	finalLocation := recorder.lastTag()
+	if finalLocation == "" {
+		finalLocation = startLocation
+	}
+
+	return recorder.outputs(startLocation, finalLocation, pauses), nil
+}
+
+func (d manualDriver) stop(ctx context.Context) error {
+	if err := d.driveMotorService.Stop(ctx); err != nil {
+		return fmt.Errorf("failed to stop drive motor: %w", err)
+	}
+	return nil
+}
+
+// getCurrentLocation returns the current location, empty if it can not be read.
+func (d manualDriver) getCurrentLocation(ctx context.Context) string {
+	loc, err := d.locationService.GetLocation(ctx)
+	if err != nil {
+		d.log.Error("failed to get location", slog.Any("error", err))
+		return ""
+	}
+	return loc.CurrentLocation
+}
@@ -0,0 +1,142 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/tbe-team/raybot/internal/services/command"
+	"github.com/tbe-team/raybot/pkg/ptr"
+)
+
+// commandRouter routes the steps of a command to their executors and runs their cancel hooks.
+type commandRouter interface {
+	routeStep(ctx context.Context, step command.Command) (command.Outputs, error)
+	runCancelHook(ctx context.Context, cmd command.Command) error
+}
+
+type missionExecutor struct {
+	log    *slog.Logger
+	router commandRouter
+
+	// currentStep keeps the step being executed so OnCancel
+	// can run the cancel hook of the right executor.
+	currentStep *missionCurrentStep
+}
+
+type missionCurrentStep struct {
+	mu  sync.Mutex
+	cmd *command.Command
+}
+
+func newMissionExecutor(
+	log *slog.Logger,
+	router commandRouter,
+) CommandExecutor[command.MissionInputs, command.MissionOutputs] {
+	return missionExecutor{
+		log:         log,
+		router:      router,
+		currentStep: &missionCurrentStep{},
+	}
+}
+
+func (e missionExecutor) Execute(ctx context.Context, inputs command.MissionInputs) (command.MissionOutputs, error) {
+	outputs := command.MissionOutputs{
+		Steps: make([]command.MissionStepOutputs, len(inputs.Steps)),
+	}
+
+	for i, step := range inputs.Steps {
+		if step.Inputs == nil {
+			return outputs, fmt.Errorf("mission step %d has no inputs", i)
+		}
+		if step.Inputs.CommandType() == command.CommandTypeMission {
+			return outputs, fmt.Errorf("mission step %d: nested mission is not allowed", i)
+		}
+
+		outputs.Steps[i] = command.MissionStepOutputs{
+			Type:   step.Inputs.CommandType(),
+			Status: command.StatusQueued,
+		}
+	}
+
+	e.setCurrentStep(nil)
+
+	for i, step := range inputs.Steps {
+		if ctx.Err() != nil {
+			e.cancelStepsFrom(&outputs, i)
+			return outputs, ctx.Err()
+		}
+
+		stepCmd := command.Command{
+			Type:   step.Inputs.CommandType(),
+			Inputs: step.Inputs,
+		}
+		e.setCurrentStep(&stepCmd)
+
+		stepOutputs := &outputs.Steps[i]
+		stepOutputs.Status = command.StatusProcessing
+		stepOutputs.StartedAt = ptr.New(time.Now())
+
+		e.log.Info("executing mission step",
+			slog.Int("step", i),
+			slog.String("command_type", stepCmd.Type.String()),
+			slog.Any("command_inputs", stepCmd.Inputs))
+
+		out, err := e.router.routeStep(ctx, stepCmd)
+		stepOutputs.Outputs = out
+		stepOutputs.CompletedAt = ptr.New(time.Now())
+
+		switch {
+		case ctx.Err() != nil:
+			// The current step is kept so that OnCancel can stop it.
+			stepOutputs.Status = command.StatusCanceled
+			e.cancelStepsFrom(&outputs, i+1)
+			return outputs, ctx.Err()
+
+		case err != nil:
+			stepOutputs.Status = command.StatusFailed
+			stepOutputs.Error = ptr.New(err.Error())
+			e.cancelStepsFrom(&outputs, i+1)
+			e.setCurrentStep(nil)
+			return outputs, fmt.Errorf("mission step %d (%s) failed: %w", i, stepCmd.Type, err)
+
+		default:
+			stepOutputs.Status = command.StatusSucceeded
+		}
+	}
+
+	e.setCurrentStep(nil)
+
+	return outputs, nil
+}
+
+func (e missionExecutor) OnCancel(ctx context.Context) error {
+	e.currentStep.mu.Lock()
+	stepCmd := e.currentStep.cmd
+	e.currentStep.cmd = nil
+	e.currentStep.mu.Unlock()
+
+	if stepCmd == nil {
+		return nil
+	}
+
+	if err := e.router.runCancelHook(ctx, *stepCmd); err != nil {
+		return fmt.Errorf("failed to cancel mission step: %w", err)
+	}
+	return nil
+}
+
+func (e missionExecutor) setCurrentStep(cmd *command.Command) {
+	e.currentStep.mu.Lock()
+	defer e.currentStep.mu.Unlock()
+	e.currentStep.cmd = cmd
+}
+
+// cancelStepsFrom marks all steps starting from index as canceled.
+func (missionExecutor) cancelStepsFrom(outputs *command.MissionOutputs, index int) {
+	for i := index; i < len(outputs.Steps); i++ {
+		outputs.Steps[i].Status = command.StatusCanceled
+	}
+}
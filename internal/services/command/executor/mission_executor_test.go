@@ -0,0 +1,128 @@
+package executor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tbe-team/raybot/internal/logging"
+	"github.com/tbe-team/raybot/internal/services/command"
+)
+
+func TestMissionExecutor_Execute(t *testing.T) {
+	t.Run("Should execute all steps in order", func(t *testing.T) {
+		router := &fakeCommandRouter{}
+		e := newMissionExecutor(logging.NewNoopLogger(), router)
+
+		outputs, err := e.Execute(context.Background(), command.MissionInputs{
+			Steps: []command.MissionStep{
+				{Inputs: &command.CargoOpenInputs{}},
+				{Inputs: &command.WaitInputs{DurationMs: 1}},
+				{Inputs: &command.CargoCloseInputs{}},
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []command.CommandType{
+			command.CommandTypeCargoOpen,
+			command.CommandTypeWait,
+			command.CommandTypeCargoClose,
+		}, router.routed)
+		require.Len(t, outputs.Steps, 3)
+		for _, step := range outputs.Steps {
+			require.Equal(t, command.StatusSucceeded, step.Status)
+			require.NotNil(t, step.StartedAt)
+			require.NotNil(t, step.CompletedAt)
+		}
+	})
+
+	t.Run("Should stop at the first failed step", func(t *testing.T) {
+		stepErr := errors.New("step error")
+		router := &fakeCommandRouter{
+			errs: map[command.CommandType]error{command.CommandTypeWait: stepErr},
+		}
+		e := newMissionExecutor(logging.NewNoopLogger(), router)
+
+		outputs, err := e.Execute(context.Background(), command.MissionInputs{
+			Steps: []command.MissionStep{
+				{Inputs: &command.CargoOpenInputs{}},
+				{Inputs: &command.WaitInputs{DurationMs: 1}},
+				{Inputs: &command.CargoCloseInputs{}},
+			},
+		})
+		require.ErrorIs(t, err, stepErr)
+		require.Equal(t, []command.CommandType{
+			command.CommandTypeCargoOpen,
+			command.CommandTypeWait,
+		}, router.routed)
+		require.Equal(t, command.StatusSucceeded, outputs.Steps[0].Status)
+		require.Equal(t, command.StatusFailed, outputs.Steps[1].Status)
+		require.Equal(t, stepErr.Error(), *outputs.Steps[1].Error)
+		require.Equal(t, command.StatusCanceled, outputs.Steps[2].Status)
+		require.Nil(t, outputs.Steps[2].StartedAt)
+	})
+
+	t.Run("Should reject nested mission", func(t *testing.T) {
+		router := &fakeCommandRouter{}
+		e := newMissionExecutor(logging.NewNoopLogger(), router)
+
+		_, err := e.Execute(context.Background(), command.MissionInputs{
+			Steps: []command.MissionStep{
+				{Inputs: &command.CargoOpenInputs{}},
+				{Inputs: &command.MissionInputs{}},
+			},
+		})
+		require.Error(t, err)
+		require.Empty(t, router.routed)
+	})
+
+	t.Run("Should cancel remaining steps and run cancel hook of current step", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		router := &fakeCommandRouter{
+			onRoute: func(cmdType command.CommandType) {
+				if cmdType == command.CommandTypeWait {
+					cancel()
+				}
+			},
+		}
+		e := newMissionExecutor(logging.NewNoopLogger(), router)
+
+		outputs, err := e.Execute(ctx, command.MissionInputs{
+			Steps: []command.MissionStep{
+				{Inputs: &command.CargoOpenInputs{}},
+				{Inputs: &command.WaitInputs{DurationMs: 1}},
+				{Inputs: &command.CargoCloseInputs{}},
+			},
+		})
+		require.ErrorIs(t, err, context.Canceled)
+		require.Equal(t, command.StatusSucceeded, outputs.Steps[0].Status)
+		require.Equal(t, command.StatusCanceled, outputs.Steps[1].Status)
+		require.Equal(t, command.StatusCanceled, outputs.Steps[2].Status)
+
+		require.NoError(t, e.OnCancel(context.Background()))
+		require.Equal(t, []command.CommandType{command.CommandTypeWait}, router.canceled)
+	})
+}
+
+type fakeCommandRouter struct {
+	errs         map[command.CommandType]error
+	onRoute      func(cmdType command.CommandType)
+	routed       []command.CommandType
+	routedInputs []command.Inputs
+	canceled     []command.CommandType
+}
+
+func (r *fakeCommandRouter) routeStep(_ context.Context, cmd command.Command) (command.Outputs, error) {
+	r.routed = append(r.routed, cmd.Type)
+	r.routedInputs = append(r.routedInputs, cmd.Inputs)
+	if r.onRoute != nil {
+		r.onRoute(cmd.Type)
+	}
+	return nil, r.errs[cmd.Type]
+}
+
+func (r *fakeCommandRouter) runCancelHook(_ context.Context, cmd command.Command) error {
+	r.canceled = append(r.canceled, cmd.Type)
+	return nil
+}
@@ -2,37 +2,45 @@ package executor
 
 import (
 	"context"
-	"fmt"
+	"log/slog"
 
 	"github.com/tbe-team/raybot/internal/services/command"
 	"github.com/tbe-team/raybot/internal/services/drivemotor"
+	"github.com/tbe-team/raybot/internal/services/location"
+	"github.com/tbe-team/raybot/pkg/eventbus"
 )
 
 type moveBackwardExecutor struct {
-	driveMotorService drivemotor.Service
+	driver manualDriver
 }
 
 func newMoveBackwardExecutor(
+	log *slog.Logger,
+	subscriber eventbus.Subscriber,
 	driveMotorService drivemotor.Service,
+	locationService location.Service,
+	obstacleTracker driveObstacleTracker,
+	progressReporter progressReporter,
 ) CommandExecutor[command.MoveBackwardInputs, command.MoveBackwardOutputs] {
 	return moveBackwardExecutor{
-		driveMotorService: driveMotorService,
+		driver: manualDriver{
+			log:               log,
+			subscriber:        subscriber,
+			driveMotorService: driveMotorService,
+			locationService:   locationService,
+			obstacleTracker:   obstacleTracker,
+			progressReporter:  progressReporter,
+		},
 	}
 }
 
+// Execute starts moving backward. If the manual drive tracking is enabled, the command
+// keeps running until it is canceled so that the obstacles can be tracked.
 func (e moveBackwardExecutor) Execute(ctx context.Context, inputs command.MoveBackwardInputs) (command.MoveBackwardOutputs, error) {
-	if err := e.driveMotorService.MoveBackward(ctx, drivemotor.MoveBackwardParams{
-		Speed: inputs.MotorSpeed,
-	}); err != nil {
-		return command.MoveBackwardOutputs{}, fmt.Errorf("failed to move backward: %w", err)
-	}
-
-	return command.MoveBackwardOutputs{}, nil
+	outputs, err := e.driver.drive(ctx, command.MoveDirectionBackward, inputs.MotorSpeed)
+	return command.MoveBackwardOutputs{MotionOutputs: outputs}, err
 }
 
 func (e moveBackwardExecutor) OnCancel(ctx context.Context) error {
-	if err := e.driveMotorService.Stop(ctx); err != nil {
-		return fmt.Errorf("failed to stop drive motor: %w", err)
-	}
-	return nil
+	return e.driver.stop(ctx)
 }
@@ -2,37 +2,45 @@ package executor
 
 import (
 	"context"
-	"fmt"
+	"log/slog"
 
 	"github.com/tbe-team/raybot/internal/services/command"
 	"github.com/tbe-team/raybot/internal/services/drivemotor"
+	"github.com/tbe-team/raybot/internal/services/location"
+	"github.com/tbe-team/raybot/pkg/eventbus"
 )
 
 type moveForwardExecutor struct {
-	driveMotorService drivemotor.Service
+	driver manualDriver
 }
 
 func newMoveForwardExecutor(
+	log *slog.Logger,
+	subscriber eventbus.Subscriber,
 	driveMotorService drivemotor.Service,
+	locationService location.Service,
+	obstacleTracker driveObstacleTracker,
+	progressReporter progressReporter,
 ) CommandExecutor[command.MoveForwardInputs, command.MoveForwardOutputs] {
 	return moveForwardExecutor{
-		driveMotorService: driveMotorService,
+		driver: manualDriver{
+			log:               log,
+			subscriber:        subscriber,
+			driveMotorService: driveMotorService,
+			locationService:   locationService,
+			obstacleTracker:   obstacleTracker,
+			progressReporter:  progressReporter,
+		},
 	}
 }
 
+// Execute starts moving forward. If the manual drive tracking is enabled, the command
+// keeps running until it is canceled so that the obstacles can be tracked.
 func (e moveForwardExecutor) Execute(ctx context.Context, inputs command.MoveForwardInputs) (command.MoveForwardOutputs, error) {
-	if err := e.driveMotorService.MoveForward(ctx, drivemotor.MoveForwardParams{
-		Speed: inputs.MotorSpeed,
-	}); err != nil {
-		return command.MoveForwardOutputs{}, fmt.Errorf("failed to move forward: %w", err)
-	}
-
-	return command.MoveForwardOutputs{}, nil
+	outputs, err := e.driver.drive(ctx, command.MoveDirectionForward, inputs.MotorSpeed)
+	return command.MoveForwardOutputs{MotionOutputs: outputs}, err
 }
 
 func (e moveForwardExecutor) OnCancel(ctx context.Context) error {
-	if err := e.driveMotorService.Stop(ctx); err != nil {
-		return fmt.Errorf("failed to stop drive motor: %w", err)
-	}
-	return nil
+	return e.driver.stop(ctx)
 }
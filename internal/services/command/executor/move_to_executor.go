@@ -4,67 +4,286 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"strings"
 	"sync"
+	"time"
 
+	"github.com/tbe-team/raybot/internal/config"
 	"github.com/tbe-team/raybot/internal/events"
 	"github.com/tbe-team/raybot/internal/services/command"
+	configservice "github.com/tbe-team/raybot/internal/services/config"
 	"github.com/tbe-team/raybot/internal/services/drivemotor"
+	"github.com/tbe-team/raybot/internal/services/location"
+	"github.com/tbe-team/raybot/internal/services/trackmap"
 	"github.com/tbe-team/raybot/pkg/eventbus"
 )
 
 type moveToExecutor struct {
 	log               *slog.Logger
 	subscriber        eventbus.Subscriber
+	configService     configservice.Service
 	driveMotorService drivemotor.Service
+	locationService   location.Service
+	trackMapService   trackmap.Service
+	obstacleTracker   driveObstacleTracker
+	progressReporter  progressReporter
 }
 
 func newMoveToExecutor(
 	log *slog.Logger,
 	subscriber eventbus.Subscriber,
+	configService configservice.Service,
 	driveMotorService drivemotor.Service,
+	locationService location.Service,
+	trackMapService trackmap.Service,
+	obstacleTracker driveObstacleTracker,
+	progressReporter progressReporter,
 ) CommandExecutor[command.MoveToInputs, command.MoveToOutputs] {
 	return moveToExecutor{
 		log:               log,
 		subscriber:        subscriber,
+		configService:     configService,
 		driveMotorService: driveMotorService,
+		locationService:   locationService,
+		trackMapService:   trackMapService,
+		obstacleTracker:   obstacleTracker,
+		progressReporter:  progressReporter,
 	}
 }
 
 func (e moveToExecutor) Execute(ctx context.Context, inputs command.MoveToInputs) (command.MoveToOutputs, error) {
+	var currentLocation string
+	if inputs.Direction == "" || inputs.Direction == command.MoveDirectionAuto {
+		loc, err := e.locationService.GetLocation(ctx)
+		if err != nil {
+			return command.MoveToOutputs{}, fmt.Errorf("failed to get location: %w", err)
+		}
+		currentLocation = loc.CurrentLocation
+
+		if currentLocation == inputs.Location {
+			e.log.Info("already at location", slog.String("location", inputs.Location))
+			return command.MoveToOutputs{
+				MotionOutputs: newTravelRecorder(e.log, e.subscriber).outputs(currentLocation, currentLocation, nil),
+			}, nil
+		}
+
+		direction, err := e.shortestDirection(ctx, currentLocation, inputs.Location)
+		if err != nil {
+			return command.MoveToOutputs{}, fmt.Errorf("failed to pick direction: %w", err)
+		}
+		e.log.Info("picked direction from track map",
+			slog.String("from", currentLocation),
+			slog.String("to", inputs.Location),
+			slog.String("direction", direction.String()),
+		)
+		inputs.Direction = direction
+	} else {
+		// the start location is only recorded in the outputs, so it is not required
+		loc, err := e.locationService.GetLocation(ctx)
+		if err != nil {
+			e.log.Error("failed to get location", slog.Any("error", err))
+		}
+		currentLocation = loc.CurrentLocation
+	}
+
+	switch inputs.Direction {
+	case command.MoveDirectionForward, command.MoveDirectionBackward:
+	default:
+		return command.MoveToOutputs{}, fmt.Errorf("invalid move direction: %s", inputs.Direction)
+	}
+
+	recordCtx, cancelRecording := context.WithCancel(ctx)
+	defer cancelRecording()
+
+	recorder := newTravelRecorder(e.log, e.subscriber)
+	recorder.start(recordCtx)
+
+	var (
+		pauses        []command.ObstaclePause
+		finalLocation string
+		corrections   uint8
+	)
+	outputs := func() command.MoveToOutputs {
+		return command.MoveToOutputs{
+			MotionOutputs: recorder.outputs(currentLocation, finalLocation, pauses),
+			Corrections:   corrections,
+		}
+	}
+
+	cfg := e.getMoveToConfig(ctx)
+	planner := e.newSpeedPlanner(ctx, cfg, inputs)
+	speed := inputs.MotorSpeed
+	if currentLocation != "" {
+		speed = planner.speedAt(currentLocation)
+	}
+
+	progress := e.newMoveToProgress(ctx, currentLocation, inputs)
+	e.progressReporter.report(ctx, command.ProgressPhaseMoving, progress.percentage(currentLocation),
+		fmt.Sprintf("moving %s to %s", strings.ToLower(inputs.Direction.String()), inputs.Location))
+
+	drivePauses, err := e.drive(
+		ctx,
+		inputs.Location,
+		newDriveMotion(e.driveMotorService, recorder, inputs.Direction, speed),
+		planner,
+		func(location string) {
+			e.progressReporter.report(ctx, command.ProgressPhaseMoving, progress.percentage(location),
+				fmt.Sprintf("passed %s, moving to %s", location, inputs.Location))
+		},
+	)
+	pauses = append(pauses, drivePauses...)
+	if err != nil {
+		return outputs(), err
+	}
+	finalLocation = inputs.Location
+
+	// corrections move back at creep speed, or at the motor speed if creeping is disabled
+	correctionSpeed := inputs.MotorSpeed
+	if cfg.CreepSpeed > 0 && cfg.CreepSpeed < correctionSpeed {
+		correctionSpeed = cfg.CreepSpeed
+	}
+
+	direction := inputs.Direction
+	for {
+		recorder.motorStopped()
+		overshotLocation, overshot, err := e.stopAndWatchOvershoot(ctx, inputs.Location, cfg.OvershootWindow)
+		if err != nil {
+			return outputs(), err
+		}
+		if !overshot {
+			return outputs(), nil
+		}
+
+		recorder.tagPassed(overshotLocation)
+		finalLocation = overshotLocation
+		if corrections >= cfg.MaxCorrections {
+			return outputs(), fmt.Errorf("%w: stopped at %s after %d corrections",
+				command.ErrMoveToOvershoot, overshotLocation, corrections)
+		}
+
+		corrections++
+		direction = reverseDirection(direction)
+		e.log.Warn("target overshot, moving back",
+			slog.String("target", inputs.Location),
+			slog.String("location", overshotLocation),
+			slog.String("direction", direction.String()),
+			slog.Int("correction", int(corrections)),
+		)
+
+		e.progressReporter.report(ctx, command.ProgressPhaseCorrecting, nil,
+			fmt.Sprintf("overshot to %s, moving back to %s", overshotLocation, inputs.Location))
+
+		drivePauses, err := e.drive(
+			ctx,
+			inputs.Location,
+			newDriveMotion(e.driveMotorService, recorder, direction, correctionSpeed),
+			newMoveToSpeedPlanner(correctionSpeed, config.MoveTo{}, nil),
+			func(location string) {
+				e.progressReporter.report(ctx, command.ProgressPhaseCorrecting, nil,
+					fmt.Sprintf("passed %s, moving back to %s", location, inputs.Location))
+			},
+		)
+		pauses = append(pauses, drivePauses...)
+		if err != nil {
+			return outputs(), err
+		}
+		finalLocation = inputs.Location
+	}
+}
+
+// drive runs the motion until the target location is read and returns the obstacle pauses.
+// Every location read, including the target, is passed to onLocation.
+// It does not stop the drive motor.
+func (e moveToExecutor) drive(
+	ctx context.Context,
+	target string,
+	motion *driveMotion,
+	planner *moveToSpeedPlanner,
+	onLocation func(location string),
+) ([]command.ObstaclePause, error) {
 	wg := sync.WaitGroup{}
 
+	obstacleCtx, cancelObstacleTracking := context.WithCancel(ctx)
+	defer cancelObstacleTracking()
+
+	wg.Add(1)
+	go func() {
+		defer func() {
+			wg.Done()
+			cancelObstacleTracking()
+		}()
+		e.trackingLocationUntilReached(ctx, target, planner, motion, onLocation)
+	}()
+
+	if err := motion.start(ctx); err != nil {
+		return nil, fmt.Errorf("failed to move %s: %w", strings.ToLower(motion.direction.String()), err)
+	}
+
+	var pauses []command.ObstaclePause
+
+	// the motor is running, so obstacles can be tracked from now on
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		e.trackingLocationUntilReached(ctx, inputs.Location)
+		pauses = e.obstacleTracker.tracking(obstacleCtx, motion)
 	}()
 
-	switch inputs.Direction {
-	case command.MoveDirectionForward:
-		if err := e.driveMotorService.MoveForward(ctx, drivemotor.MoveForwardParams{
-			Speed: inputs.MotorSpeed,
-		}); err != nil {
-			return command.MoveToOutputs{}, fmt.Errorf("failed to move forward: %w", err)
+	// wait for tracking to finish
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return pauses, err
+	}
+
+	return pauses, nil
+}
+
+// stopAndWatchOvershoot stops the drive motor and watches the locations read during the window.
+// Reading a location other than the target means the robot overshot the target.
+func (e moveToExecutor) stopAndWatchOvershoot(ctx context.Context, target string, window time.Duration) (string, bool, error) {
+	if window <= 0 {
+		if err := e.driveMotorService.Stop(ctx); err != nil {
+			return "", false, fmt.Errorf("failed to stop drive motor: %w", err)
 		}
+		return "", false, nil
+	}
 
-	case command.MoveDirectionBackward:
-		if err := e.driveMotorService.MoveBackward(ctx, drivemotor.MoveBackwardParams{
-			Speed: inputs.MotorSpeed,
-		}); err != nil {
-			return command.MoveToOutputs{}, fmt.Errorf("failed to move backward: %w", err)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	locationCh := make(chan string, 1)
+	e.subscriber.Subscribe(ctx, events.LocationUpdatedTopic, func(msg *eventbus.Message) {
+		ev, ok := msg.Payload.(events.UpdateLocationEvent)
+		if !ok {
+			e.log.Error("invalid event", slog.Any("event", msg.Payload))
+			return
 		}
 
-	default:
-		return command.MoveToOutputs{}, fmt.Errorf("invalid move direction: %s", inputs.Direction)
-	}
+		if ev.Location == target {
+			return
+		}
 
-	wg.Wait()
+		select {
+		case locationCh <- ev.Location:
+		default:
+		}
+	})
 
 	if err := e.driveMotorService.Stop(ctx); err != nil {
-		return command.MoveToOutputs{}, fmt.Errorf("failed to stop drive motor: %w", err)
+		return "", false, fmt.Errorf("failed to stop drive motor: %w", err)
 	}
 
-	return command.MoveToOutputs{}, nil
+	timer := time.NewTimer(window)
+	defer timer.Stop()
+
+	select {
+	case loc := <-locationCh:
+		return loc, true, nil
+	case <-timer.C:
+		return "", false, nil
+	case <-ctx.Done():
+		return "", false, ctx.Err()
+	}
 }
 
 func (e moveToExecutor) OnCancel(ctx context.Context) error {
@@ -74,14 +293,146 @@ func (e moveToExecutor) OnCancel(ctx context.Context) error {
 	return nil
 }
 
-func (e moveToExecutor) trackingLocationUntilReached(ctx context.Context, location string) {
+// shortestDirection returns the direction with the fewest tags between the locations on the track map.
+func (e moveToExecutor) shortestDirection(ctx context.Context, from, to string) (command.MoveDirection, error) {
+	trackMap, err := e.trackMapService.GetTrackMap(ctx)
+	if err != nil {
+		return "", fmt.Errorf("get track map: %w", err)
+	}
+
+	direction, err := trackMap.ShortestDirection(from, to)
+	if err != nil {
+		return "", fmt.Errorf("shortest direction: %w", err)
+	}
+
+	if direction == trackmap.DirectionBackward {
+		return command.MoveDirectionBackward, nil
+	}
+	return command.MoveDirectionForward, nil
+}
+
+// getMoveToConfig returns the MOVE_TO config, or the zero config
+// (constant speed, no overshoot correction) if it can not be read.
+func (e moveToExecutor) getMoveToConfig(ctx context.Context) config.MoveTo {
+	commandCfg, err := e.configService.GetCommandConfig(ctx)
+	if err != nil {
+		e.log.Error("failed to get command config, using constant speed", slog.Any("error", err))
+		return config.MoveTo{}
+	}
+	return commandCfg.MoveTo
+}
+
+// newSpeedPlanner returns the speed planner of the command. The pre-target tags are
+// taken from the config, or else the tag before the target in the track map is used.
+func (e moveToExecutor) newSpeedPlanner(ctx context.Context, cfg config.MoveTo, inputs command.MoveToInputs) *moveToSpeedPlanner {
+	preTargetTags, ok := cfg.PreTargetTags[inputs.Location]
+	if !ok && cfg.CreepSpeed > 0 {
+		preTargetTags = e.tagBeforeTarget(ctx, inputs.Location, inputs.Direction)
+	}
+	e.log.Info("planned move to speed",
+		slog.Int("creep_speed", int(cfg.CreepSpeed)),
+		slog.Any("pre_target_tags", preTargetTags),
+		slog.Int("segment_speed_limits", len(cfg.SegmentSpeedLimits)),
+	)
+
+	return newMoveToSpeedPlanner(inputs.MotorSpeed, cfg, preTargetTags)
+}
+
+// tagBeforeTarget returns the tag before the target in the track map, nil if it is unknown.
+func (e moveToExecutor) tagBeforeTarget(ctx context.Context, target string, direction command.MoveDirection) []string {
+	trackMap, err := e.trackMapService.GetTrackMap(ctx)
+	if err != nil {
+		e.log.Warn("failed to get track map, not slowing down before target", slog.Any("error", err))
+		return nil
+	}
+
+	trackMapDirection := trackmap.DirectionForward
+	if direction == command.MoveDirectionBackward {
+		trackMapDirection = trackmap.DirectionBackward
+	}
+
+	tag, ok := trackMap.TagBefore(target, trackMapDirection)
+	if !ok {
+		return nil
+	}
+	return []string{tag.Location}
+}
+
+// moveToProgress computes the MOVE_TO progress from the tags left to the target on the track map.
+type moveToProgress struct {
+	trackMap  trackmap.TrackMap
+	target    string
+	direction trackmap.Direction
+	// total is the number of tags from the start to the target, 0 if it is unknown.
+	total int
+}
+
+// percentage returns the progress at the location, nil if it can not be computed.
+func (p moveToProgress) percentage(location string) *uint8 {
+	if p.total == 0 {
+		return nil
+	}
+
+	left, ok := p.trackMap.TagsBetween(location, p.target, p.direction)
+	if !ok || left > p.total {
+		// off the path from the start to the target
+		return nil
+	}
+	return progressPercentage(p.total-left, p.total)
+}
+
+// newMoveToProgress returns the progress of the command, without a percentage
+// if the start location or the track map is unknown.
+func (e moveToExecutor) newMoveToProgress(ctx context.Context, start string, inputs command.MoveToInputs) moveToProgress {
+	if start == "" {
+		return moveToProgress{}
+	}
+
+	trackMap, err := e.trackMapService.GetTrackMap(ctx)
+	if err != nil {
+		e.log.Warn("failed to get track map, not computing progress", slog.Any("error", err))
+		return moveToProgress{}
+	}
+
+	direction := trackmap.DirectionForward
+	if inputs.Direction == command.MoveDirectionBackward {
+		direction = trackmap.DirectionBackward
+	}
+
+	total, ok := trackMap.TagsBetween(start, inputs.Location, direction)
+	if !ok {
+		return moveToProgress{}
+	}
+
+	return moveToProgress{
+		trackMap:  trackMap,
+		target:    inputs.Location,
+		direction: direction,
+		total:     total,
+	}
+}
+
+// trackingLocationUntilReached waits for the target location and sets
+// the speed of the motion from the planner on every other location.
+func (e moveToExecutor) trackingLocationUntilReached(
+	ctx context.Context,
+	location string,
+	planner *moveToSpeedPlanner,
+	motion *driveMotion,
+	onLocation func(location string),
+) {
 	ctx, cancel := context.WithCancel(ctx)
 	defer func() {
 		e.log.Info("stop tracking location", slog.String("location", location))
 		cancel()
 	}()
 
+	// events are handled concurrently, but the planner is stateful
+	var plannerMu sync.Mutex
+
 	doneCh := make(chan struct{})
+	// the target may be read more than once before the tracking stops
+	closeDoneCh := sync.OnceFunc(func() { close(doneCh) })
 	e.log.Info("start tracking location", slog.String("target_location", location))
 	e.subscriber.Subscribe(ctx, events.LocationUpdatedTopic, func(msg *eventbus.Message) {
 		ev, ok := msg.Payload.(events.UpdateLocationEvent)
@@ -90,10 +441,26 @@ func (e moveToExecutor) trackingLocationUntilReached(ctx context.Context, locati
 			return
 		}
 
+		onLocation(ev.Location)
+
 		if ev.Location == location {
 			e.log.Info("location reached", slog.String("location", ev.Location))
-			close(doneCh)
+			motion.recorder.tagPassed(ev.Location)
+			closeDoneCh()
+			return
 		}
+
+		plannerMu.Lock()
+		defer plannerMu.Unlock()
+
+		speed := planner.speedAt(ev.Location)
+		if err := motion.setSpeed(ctx, speed); err != nil {
+			e.log.Error("failed to set drive speed", slog.Any("error", err))
+			return
+		}
+		e.log.Debug("drive speed planned",
+			slog.String("location", ev.Location),
+			slog.Int("speed", int(speed)))
 	})
 
 	select {
@@ -101,3 +468,10 @@ func (e moveToExecutor) trackingLocationUntilReached(ctx context.Context, locati
 	case <-ctx.Done():
 	}
 }
+
+func reverseDirection(direction command.MoveDirection) command.MoveDirection {
+	if direction == command.MoveDirectionBackward {
+		return command.MoveDirectionForward
+	}
+	return command.MoveDirectionBackward
+}
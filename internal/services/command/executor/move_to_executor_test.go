@@ -0,0 +1,246 @@
+package executor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tbe-team/raybot/internal/config"
+	"github.com/tbe-team/raybot/internal/events"
+	"github.com/tbe-team/raybot/internal/logging"
+	"github.com/tbe-team/raybot/internal/services/command"
+	"github.com/tbe-team/raybot/internal/services/command/commandimpl"
+	configmocks "github.com/tbe-team/raybot/internal/services/config/mocks"
+	"github.com/tbe-team/raybot/internal/services/drivemotor"
+	drivemotormocks "github.com/tbe-team/raybot/internal/services/drivemotor/mocks"
+	"github.com/tbe-team/raybot/internal/services/location"
+	locationmocks "github.com/tbe-team/raybot/internal/services/location/mocks"
+	"github.com/tbe-team/raybot/internal/services/trackmap"
+	trackmapmocks "github.com/tbe-team/raybot/internal/services/trackmap/mocks"
+	"github.com/tbe-team/raybot/pkg/eventbus"
+)
+
+func TestMoveToExecutor_Execute_AutoDirection(t *testing.T) {
+	trackMap := trackmap.TrackMap{
+		Topology: trackmap.TopologyLoop,
+		Tags: []trackmap.Tag{
+			{Location: "A"}, {Location: "B"}, {Location: "C"}, {Location: "D"}, {Location: "E"},
+		},
+	}
+
+	t.Run("Should move in the shortest direction from the track map", func(t *testing.T) {
+		log := logging.NewNoopLogger()
+		bus := eventbus.NewInProcEventBus(log)
+		driveMotorService := drivemotormocks.NewFakeService(t)
+		locationService := locationmocks.NewFakeService(t)
+		trackMapService := trackmapmocks.NewFakeService(t)
+		configService := newFakeConfigService(t, config.Command{})
+		e := newMoveToExecutor(log, bus, configService, driveMotorService, locationService, trackMapService,
+			newDriveObstacleTracker(log, bus, configService), progressReporter{})
+
+		locationService.EXPECT().GetLocation(mock.Anything).Return(location.Location{CurrentLocation: "B"}, nil)
+		trackMapService.EXPECT().GetTrackMap(mock.Anything).Return(trackMap, nil)
+		driveMotorService.EXPECT().MoveBackward(mock.Anything, drivemotor.MoveBackwardParams{Speed: 50}).
+			Run(func(context.Context, drivemotor.MoveBackwardParams) {
+				go func() {
+					time.Sleep(20 * time.Millisecond)
+					bus.Publish(events.LocationUpdatedTopic, eventbus.NewMessage(events.UpdateLocationEvent{Location: "A"}))
+				}()
+			}).
+			Return(nil)
+		driveMotorService.EXPECT().Stop(mock.Anything).Return(nil)
+
+		_, err := e.Execute(context.Background(), command.MoveToInputs{
+			Location:   "A",
+			Direction:  command.MoveDirectionAuto,
+			MotorSpeed: 50,
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("Should not move if already at the location", func(t *testing.T) {
+		log := logging.NewNoopLogger()
+		locationService := locationmocks.NewFakeService(t)
+		e := newMoveToExecutor(log, &eventbus.NoopEventBus{}, configmocks.NewFakeService(t), drivemotormocks.NewFakeService(t), locationService, trackmapmocks.NewFakeService(t), driveObstacleTracker{}, progressReporter{})
+
+		locationService.EXPECT().GetLocation(mock.Anything).Return(location.Location{CurrentLocation: "C"}, nil)
+
+		_, err := e.Execute(context.Background(), command.MoveToInputs{
+			Location:   "C",
+			MotorSpeed: 50,
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("Should fail if the location is not in the track map", func(t *testing.T) {
+		log := logging.NewNoopLogger()
+		locationService := locationmocks.NewFakeService(t)
+		trackMapService := trackmapmocks.NewFakeService(t)
+		e := newMoveToExecutor(log, &eventbus.NoopEventBus{}, configmocks.NewFakeService(t), drivemotormocks.NewFakeService(t), locationService, trackMapService, driveObstacleTracker{}, progressReporter{})
+
+		locationService.EXPECT().GetLocation(mock.Anything).Return(location.Location{CurrentLocation: "B"}, nil)
+		trackMapService.EXPECT().GetTrackMap(mock.Anything).Return(trackMap, nil)
+
+		_, err := e.Execute(context.Background(), command.MoveToInputs{
+			Location:   "Z",
+			MotorSpeed: 50,
+		})
+		require.ErrorIs(t, err, trackmap.ErrTagNotFound)
+	})
+}
+
+func TestMoveToExecutor_Execute_SpeedControl(t *testing.T) {
+	trackMap := trackmap.TrackMap{
+		Topology: trackmap.TopologyLinear,
+		Tags: []trackmap.Tag{
+			{Location: "A"}, {Location: "B"}, {Location: "C"}, {Location: "D"},
+		},
+	}
+
+	t.Run("Should creep after the tag before the target", func(t *testing.T) {
+		log := logging.NewNoopLogger()
+		bus := eventbus.NewInProcEventBus(log)
+		driveMotorService := drivemotormocks.NewFakeService(t)
+		locationService := locationmocks.NewFakeService(t)
+		trackMapService := trackmapmocks.NewFakeService(t)
+		configService := newFakeConfigService(t, config.Command{
+			MoveTo: config.MoveTo{
+				CreepSpeed: 20,
+				SegmentSpeedLimits: []config.SegmentSpeedLimit{
+					{From: "A", To: "B", MaxSpeed: 40},
+				},
+			},
+		})
+		runningCmdRepository := commandimpl.NewRunningCmdRepository()
+		require.NoError(t, runningCmdRepository.Add(context.Background(),
+			command.NewCancelableCommand(context.Background(), command.Command{ID: 1, Type: command.CommandTypeMoveTo})))
+		e := newMoveToExecutor(log, bus, configService, driveMotorService, locationService, trackMapService,
+			newDriveObstacleTracker(log, bus, configService), newProgressReporter(log, bus, runningCmdRepository))
+
+		locationService.EXPECT().GetLocation(mock.Anything).Return(location.Location{CurrentLocation: "A"}, nil)
+		trackMapService.EXPECT().GetTrackMap(mock.Anything).Return(trackMap, nil)
+
+		publish := func(loc string) {
+			go func() {
+				time.Sleep(20 * time.Millisecond)
+				bus.Publish(events.LocationUpdatedTopic, eventbus.NewMessage(events.UpdateLocationEvent{Location: loc}))
+			}()
+		}
+		driveMotorService.EXPECT().MoveForward(mock.Anything, drivemotor.MoveForwardParams{Speed: 40}).
+			Run(func(context.Context, drivemotor.MoveForwardParams) { publish("B") }).
+			Return(nil).Once()
+		driveMotorService.EXPECT().MoveForward(mock.Anything, drivemotor.MoveForwardParams{Speed: 80}).
+			Run(func(context.Context, drivemotor.MoveForwardParams) { publish("C") }).
+			Return(nil).Once()
+		driveMotorService.EXPECT().MoveForward(mock.Anything, drivemotor.MoveForwardParams{Speed: 20}).
+			Run(func(context.Context, drivemotor.MoveForwardParams) { publish("D") }).
+			Return(nil).Once()
+		driveMotorService.EXPECT().Stop(mock.Anything).Return(nil)
+
+		outputs, err := e.Execute(context.Background(), command.MoveToInputs{
+			Location:   "D",
+			Direction:  command.MoveDirectionAuto,
+			MotorSpeed: 80,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "A", outputs.StartLocation)
+		require.Equal(t, "D", outputs.FinalLocation)
+		require.Equal(t, []string{"B", "C", "D"}, passedLocations(outputs.TagsPassed))
+		require.Greater(t, outputs.AverageMotorSpeed, float64(20))
+		require.Less(t, outputs.AverageMotorSpeed, float64(80))
+		require.Positive(t, outputs.TravelDuration)
+
+		runningCmd, err := runningCmdRepository.Get(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, command.ProgressPhaseMoving, runningCmd.Progress.Phase)
+		require.Equal(t, uint8(100), *runningCmd.Progress.Percentage)
+	})
+}
+
+func TestMoveToExecutor_Execute_Overshoot(t *testing.T) {
+	setup := func(t *testing.T, maxCorrections uint8) (moveToExecutor, *eventbus.InProcEventBus, *drivemotormocks.FakeService) {
+		log := logging.NewNoopLogger()
+		bus := eventbus.NewInProcEventBus(log)
+		driveMotorService := drivemotormocks.NewFakeService(t)
+		configService := newFakeConfigService(t, config.Command{
+			MoveTo: config.MoveTo{
+				CreepSpeed:      20,
+				OvershootWindow: 100 * time.Millisecond,
+				MaxCorrections:  maxCorrections,
+			},
+		})
+		trackMapService := trackmapmocks.NewFakeService(t)
+		trackMapService.EXPECT().GetTrackMap(mock.Anything).Return(trackmap.TrackMap{}, nil)
+		locationService := locationmocks.NewFakeService(t)
+		locationService.EXPECT().GetLocation(mock.Anything).Return(location.Location{CurrentLocation: "A"}, nil)
+		e := newMoveToExecutor(log, bus, configService, driveMotorService,
+			locationService, trackMapService,
+			newDriveObstacleTracker(log, bus, configService), progressReporter{})
+
+		return e.(moveToExecutor), bus, driveMotorService
+	}
+
+	publishLater := func(bus *eventbus.InProcEventBus, loc string) {
+		go func() {
+			time.Sleep(20 * time.Millisecond)
+			bus.Publish(events.LocationUpdatedTopic, eventbus.NewMessage(events.UpdateLocationEvent{Location: loc}))
+		}()
+	}
+
+	t.Run("Should move back to the target at creep speed", func(t *testing.T) {
+		e, bus, driveMotorService := setup(t, 1)
+
+		driveMotorService.EXPECT().MoveForward(mock.Anything, drivemotor.MoveForwardParams{Speed: 50}).
+			Run(func(context.Context, drivemotor.MoveForwardParams) { publishLater(bus, "C") }).
+			Return(nil).Once()
+		driveMotorService.EXPECT().Stop(mock.Anything).
+			Run(func(context.Context) { publishLater(bus, "D") }).
+			Return(nil).Once()
+		driveMotorService.EXPECT().MoveBackward(mock.Anything, drivemotor.MoveBackwardParams{Speed: 20}).
+			Run(func(context.Context, drivemotor.MoveBackwardParams) { publishLater(bus, "C") }).
+			Return(nil).Once()
+		driveMotorService.EXPECT().Stop(mock.Anything).Return(nil).Once()
+
+		outputs, err := e.Execute(context.Background(), command.MoveToInputs{
+			Location:   "C",
+			Direction:  command.MoveDirectionForward,
+			MotorSpeed: 50,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "A", outputs.StartLocation)
+		require.Equal(t, "C", outputs.FinalLocation)
+		require.Equal(t, uint8(1), outputs.Corrections)
+		require.Equal(t, []string{"C", "D", "C"}, passedLocations(outputs.TagsPassed))
+	})
+
+	t.Run("Should fail after the maximum number of corrections", func(t *testing.T) {
+		e, bus, driveMotorService := setup(t, 0)
+
+		driveMotorService.EXPECT().MoveForward(mock.Anything, drivemotor.MoveForwardParams{Speed: 50}).
+			Run(func(context.Context, drivemotor.MoveForwardParams) { publishLater(bus, "C") }).
+			Return(nil).Once()
+		driveMotorService.EXPECT().Stop(mock.Anything).
+			Run(func(context.Context) { publishLater(bus, "D") }).
+			Return(nil).Once()
+
+		outputs, err := e.Execute(context.Background(), command.MoveToInputs{
+			Location:   "C",
+			Direction:  command.MoveDirectionForward,
+			MotorSpeed: 50,
+		})
+		require.ErrorIs(t, err, command.ErrMoveToOvershoot)
+		require.Equal(t, "D", outputs.FinalLocation)
+		require.Equal(t, uint8(0), outputs.Corrections)
+	})
+}
+
+func passedLocations(tags []command.PassedTag) []string {
+	locs := make([]string, 0, len(tags))
+	for _, t := range tags {
+		locs = append(locs, t.Location)
+	}
+	return locs
+}
@@ -0,0 +1,85 @@
+package executor
+
+import (
+	"slices"
+
+	"github.com/tbe-team/raybot/internal/config"
+)
+
+// moveToSpeedPlanner picks the speed of the MOVE_TO command from the last passed tag.
+// The robot creeps after passing a pre-target tag and never drives faster than
+// the limit of the segment it is in. It is not safe for concurrent use.
+type moveToSpeedPlanner struct {
+	maxSpeed           uint8
+	creepSpeed         uint8
+	preTargetTags      []string
+	segmentSpeedLimits []config.SegmentSpeedLimit
+
+	// approaching is true after a pre-target tag is passed
+	approaching bool
+	// segment is the index of the segment speed limit the robot is in, -1 if none
+	segment int
+	// segmentExit is the tag that ends the current segment
+	segmentExit string
+}
+
+func newMoveToSpeedPlanner(maxSpeed uint8, cfg config.MoveTo, preTargetTags []string) *moveToSpeedPlanner {
+	return &moveToSpeedPlanner{
+		maxSpeed:           maxSpeed,
+		creepSpeed:         cfg.CreepSpeed,
+		preTargetTags:      preTargetTags,
+		segmentSpeedLimits: cfg.SegmentSpeedLimits,
+		segment:            -1,
+	}
+}
+
+// speedAt returns the speed to drive at after passing the location.
+func (p *moveToSpeedPlanner) speedAt(location string) uint8 {
+	p.updateSegment(location)
+
+	if p.creepSpeed > 0 && slices.Contains(p.preTargetTags, location) {
+		p.approaching = true
+	}
+
+	speed := p.maxSpeed
+	if p.segment >= 0 {
+		speed = min(speed, p.segmentSpeedLimits[p.segment].MaxSpeed)
+	}
+	if p.approaching {
+		speed = min(speed, p.creepSpeed)
+	}
+	return speed
+}
+
+func (p *moveToSpeedPlanner) updateSegment(location string) {
+	if p.segment < 0 {
+		p.enterSegment(location, -1)
+		return
+	}
+
+	if location != p.segmentExit {
+		return
+	}
+
+	// the exit tag of a segment may be the entry tag of the next one
+	left := p.segment
+	p.segment = -1
+	p.enterSegment(location, left)
+}
+
+func (p *moveToSpeedPlanner) enterSegment(location string, skip int) {
+	for i, l := range p.segmentSpeedLimits {
+		if i == skip {
+			continue
+		}
+
+		switch location {
+		case l.From:
+			p.segment, p.segmentExit = i, l.To
+			return
+		case l.To:
+			p.segment, p.segmentExit = i, l.From
+			return
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package executor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tbe-team/raybot/internal/config"
+)
+
+func TestMoveToSpeedPlanner_SpeedAt(t *testing.T) {
+	cfg := config.MoveTo{
+		CreepSpeed: 20,
+		SegmentSpeedLimits: []config.SegmentSpeedLimit{
+			{From: "B", To: "D", MaxSpeed: 40},
+			{From: "D", To: "E", MaxSpeed: 60},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		preTargetTags []string
+		locations     []string
+		want          []uint8
+	}{
+		{
+			name:      "Should limit speed inside segments",
+			locations: []string{"A", "B", "C", "D", "E", "F"},
+			want:      []uint8{80, 40, 40, 60, 80, 80},
+		},
+		{
+			name:      "Should limit speed inside segments in reverse direction",
+			locations: []string{"F", "E", "D", "C", "B", "A"},
+			want:      []uint8{80, 60, 40, 40, 80, 80},
+		},
+		{
+			name:          "Should creep after pre-target tag",
+			preTargetTags: []string{"E"},
+			locations:     []string{"A", "E", "F"},
+			want:          []uint8{80, 20, 20},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newMoveToSpeedPlanner(80, cfg, tc.preTargetTags)
+
+			got := make([]uint8, 0, len(tc.locations))
+			for _, loc := range tc.locations {
+				got = append(got, p.speedAt(loc))
+			}
+			require.Equal(t, tc.want, got)
+		})
+	}
+
+	t.Run("Should not creep if creep speed is 0", func(t *testing.T) {
+		p := newMoveToSpeedPlanner(80, config.MoveTo{}, []string{"E"})
+		require.Equal(t, uint8(80), p.speedAt("E"))
+	})
+}
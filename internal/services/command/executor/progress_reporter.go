@@ -0,0 +1,129 @@
+package executor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/tbe-team/raybot/internal/events"
+	"github.com/tbe-team/raybot/internal/services/command"
+	"github.com/tbe-team/raybot/pkg/eventbus"
+)
+
+// progressReporter saves the progress of the running command
+// and publishes it on the event bus. The zero value reports nothing.
+type progressReporter struct {
+	log                      *slog.Logger
+	publisher                eventbus.Publisher
+	runningCommandRepository command.RunningCommandRepository
+}
+
+func newProgressReporter(
+	log *slog.Logger,
+	publisher eventbus.Publisher,
+	runningCommandRepository command.RunningCommandRepository,
+) progressReporter {
+	return progressReporter{
+		log:                      log,
+		publisher:                publisher,
+		runningCommandRepository: runningCommandRepository,
+	}
+}
+
+// report reports the progress of the running command, percentage is nil if it can not be computed.
+// Errors are logged, since the progress is informational.
+func (r progressReporter) report(ctx context.Context, phase command.ProgressPhase, percentage *uint8, message string) {
+	if r.runningCommandRepository == nil {
+		return
+	}
+
+	progress := command.Progress{
+		Phase:      phase,
+		Percentage: percentage,
+		Message:    message,
+		UpdatedAt:  time.Now(),
+	}
+
+	cmd, err := r.runningCommandRepository.UpdateProgress(ctx, progress)
+	if err != nil {
+		if !errors.Is(err, command.ErrRunningCommandNotFound) {
+			r.log.Error("failed to update command progress", slog.Any("error", err))
+		}
+		return
+	}
+
+	r.publisher.Publish(events.CommandProgressUpdatedTopic, eventbus.NewMessage(events.CommandProgressUpdatedEvent{
+		CommandID:  cmd.ID,
+		Phase:      phase.String(),
+		Percentage: percentage,
+		Message:    message,
+		UpdatedAt:  progress.UpdatedAt,
+	}))
+}
+
+// progressPercentage returns done out of total as a percentage, nil if total is 0.
+func progressPercentage(done, total int) *uint8 {
+	if total <= 0 {
+		return nil
+	}
+
+	done = max(0, min(done, total))
+	p := uint8(done * 100 / total)
+	return &p
+}
+
+// liftProgress reports the progress of the lift motor moving to the target position
+// from the down distance. The first distance read is the start position, and the
+// progress is only reported when the percentage changes.
+type liftProgress struct {
+	reporter progressReporter
+	phase    command.ProgressPhase
+	target   uint16
+
+	mu          sync.Mutex
+	start       *uint16
+	lastPercent *uint8
+}
+
+func newLiftProgress(reporter progressReporter, phase command.ProgressPhase, target uint16) *liftProgress {
+	return &liftProgress{
+		reporter: reporter,
+		phase:    phase,
+		target:   target,
+	}
+}
+
+// setStart sets the start position if it is known before the first distance read.
+func (p *liftProgress) setStart(position uint16) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.start = &position
+}
+
+func (p *liftProgress) update(ctx context.Context, downDistance uint16) {
+	p.mu.Lock()
+	if p.start == nil {
+		p.start = &downDistance
+	}
+	start := *p.start
+
+	total := int(p.target) - int(start)
+	done := int(downDistance) - int(start)
+	if total < 0 {
+		total, done = -total, -done
+	}
+
+	percent := progressPercentage(done, total)
+	if percent == nil || (p.lastPercent != nil && *p.lastPercent == *percent) {
+		p.mu.Unlock()
+		return
+	}
+	p.lastPercent = percent
+	p.mu.Unlock()
+
+	p.reporter.report(ctx, p.phase, percent,
+		fmt.Sprintf("down distance %d, moving from %d to %d", downDistance, start, p.target))
+}
@@ -0,0 +1,108 @@
+package executor
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/tbe-team/raybot/internal/config"
+	"github.com/tbe-team/raybot/internal/hardware/controller"
+	"github.com/tbe-team/raybot/internal/hardware/espserial"
+	"github.com/tbe-team/raybot/internal/hardware/picserial"
+	"github.com/tbe-team/raybot/internal/services/command"
+)
+
+// getRetryPolicy returns the retry policy of the command,
+// falling back to the retry policy of the command type in the command config.
+// The composite commands are never retried.
+func (service) getRetryPolicy(cfg config.Command, cmd command.Command) config.RetryPolicy {
+	if cmd.Type.IsComposite() {
+		return config.RetryPolicy{}
+	}
+	if cmd.RetryPolicy != nil {
+		return *cmd.RetryPolicy
+	}
+	return cfg.Retry.ForType(cmd.Type.String())
+}
+
+// routeWithRetry executes the command and executes it again while it fails
+// with a retryable error and the retry policy allows another attempt.
+// The failed attempts and their errors are recorded on the command, so the attempts
+// of a command suspended and executed again still count towards the max attempts.
+// The cancel hook is run before each retry to bring the hardware back to a safe state.
+func (s *service) routeWithRetry(
+	ctx context.Context,
+	cmdCtx context.Context,
+	cmd command.Command,
+	policy config.RetryPolicy,
+) (command.Outputs, error) {
+	for {
+		out, err := s.route(cmdCtx, cmd)
+		if err == nil || cmdCtx.Err() != nil ||
+			errors.Is(err, context.Canceled) || errors.Is(err, command.ErrCommandSuspended) {
+			return out, err
+		}
+
+		cmd.Attempts++
+		retry := cmd.Attempts < uint32(policy.MaxAttempts) && isRetryableError(policy, err)
+
+		now := time.Now()
+		cmd.AttemptErrors = append(cmd.AttemptErrors, command.AttemptError{
+			Attempt:  cmd.Attempts,
+			Error:    err.Error(),
+			FailedAt: now,
+		})
+		if _, updateErr := s.commandRepository.UpdateCommand(ctx, command.UpdateCommandParams{
+			ID:               cmd.ID,
+			Attempts:         cmd.Attempts,
+			SetAttempts:      true,
+			AttemptErrors:    cmd.AttemptErrors,
+			SetAttemptErrors: true,
+			UpdatedAt:        now,
+		}); updateErr != nil {
+			s.log.Error("failed to update command attempts",
+				slog.Int64("command_id", cmd.ID),
+				slog.Any("error", updateErr))
+		}
+
+		if !retry {
+			return out, err
+		}
+
+		backoff := policy.BackoffFor(uint8(min(cmd.Attempts, 255))) //nolint:gosec
+		s.log.Warn("command attempt failed, retrying",
+			slog.Int64("command_id", cmd.ID),
+			slog.Uint64("attempt", uint64(cmd.Attempts)),
+			slog.Uint64("max_attempts", uint64(policy.MaxAttempts)),
+			slog.Duration("backoff", backoff),
+			slog.Any("error", err))
+
+		if err := s.runCancelHook(ctx, cmd); err != nil {
+			s.log.Error("failed to run cancel hook before retry",
+				slog.Int64("command_id", cmd.ID),
+				slog.Any("error", err))
+		}
+
+		select {
+		case <-cmdCtx.Done():
+			return out, err
+		case <-time.After(backoff):
+		}
+	}
+}
+
+// isRetryableError returns true if the error belongs to a retryable error class of the policy.
+func isRetryableError(policy config.RetryPolicy, err error) bool {
+	switch {
+	case errors.Is(err, controller.ErrCommandACKTimeout):
+		return policy.IsRetryable(config.RetryableErrorACKTimeout)
+
+	case errors.Is(err, espserial.ErrESPSerialNotConnected),
+		errors.Is(err, picserial.ErrPICSerialNotConnected):
+		return policy.IsRetryable(config.RetryableErrorSerialNotConnected)
+
+	default:
+		return false
+	}
+}
@@ -0,0 +1,194 @@
+package executor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tbe-team/raybot/internal/config"
+	"github.com/tbe-team/raybot/internal/hardware/controller"
+	"github.com/tbe-team/raybot/internal/logging"
+	"github.com/tbe-team/raybot/internal/services/command"
+	commandmocks "github.com/tbe-team/raybot/internal/services/command/mocks"
+)
+
+func TestService_RouteWithRetry(t *testing.T) {
+	ackTimeoutErr := fmt.Errorf("open cargo door: %w", controller.ErrCommandACKTimeout)
+	policy := config.RetryPolicy{
+		MaxAttempts:     3,
+		Backoff:         time.Millisecond,
+		RetryableErrors: []config.RetryableError{config.RetryableErrorACKTimeout},
+	}
+
+	newService := func(t *testing.T, executor *flakyFakeExecutor) (*service, *commandmocks.FakeRepository) {
+		commandRepository := commandmocks.NewFakeRepository(t)
+		s := newTestService(logging.NewNoopLogger(), nil, commandmocks.NewFakeRunningCommandRepository(t), commandRepository, nil)
+		s.cargoOpenExecutor = executor
+		s.cancelableMap[command.CommandTypeCargoOpen] = executor
+		return s, commandRepository
+	}
+
+	cmd := command.Command{
+		ID:     1,
+		Type:   command.CommandTypeCargoOpen,
+		Inputs: &command.CargoOpenInputs{},
+	}
+
+	t.Run("Should retry retryable error until success", func(t *testing.T) {
+		executor := &flakyFakeExecutor{errs: []error{ackTimeoutErr, ackTimeoutErr}}
+		s, commandRepository := newService(t, executor)
+
+		commandRepository.EXPECT().UpdateCommand(mock.Anything, mock.MatchedBy(
+			func(params command.UpdateCommandParams) bool {
+				return params.SetAttempts && params.SetAttemptErrors
+			},
+		)).Return(command.Command{}, nil).Times(2)
+
+		_, err := s.routeWithRetry(context.Background(), context.Background(), cmd, policy)
+		require.NoError(t, err)
+		require.Equal(t, 3, executor.calls)
+		require.Equal(t, 2, executor.cancels)
+	})
+
+	t.Run("Should stop retrying after max attempts", func(t *testing.T) {
+		executor := &flakyFakeExecutor{errs: []error{ackTimeoutErr, ackTimeoutErr, ackTimeoutErr, ackTimeoutErr}}
+		s, commandRepository := newService(t, executor)
+
+		commandRepository.EXPECT().UpdateCommand(mock.Anything, mock.MatchedBy(
+			func(params command.UpdateCommandParams) bool {
+				return params.SetAttempts && params.SetAttemptErrors
+			},
+		)).Return(command.Command{}, nil).Times(2)
+		commandRepository.EXPECT().UpdateCommand(mock.Anything, mock.MatchedBy(
+			func(params command.UpdateCommandParams) bool {
+				return params.Attempts == 3 &&
+					params.SetAttemptErrors &&
+					len(params.AttemptErrors) == 3 &&
+					params.AttemptErrors[2].Attempt == 3
+			},
+		)).Return(command.Command{}, nil).Once()
+
+		_, err := s.routeWithRetry(context.Background(), context.Background(), cmd, policy)
+		require.ErrorIs(t, err, controller.ErrCommandACKTimeout)
+		require.Equal(t, 3, executor.calls)
+	})
+
+	t.Run("Should count the failed attempts made before a suspension", func(t *testing.T) {
+		executor := &flakyFakeExecutor{errs: []error{ackTimeoutErr, ackTimeoutErr, ackTimeoutErr}}
+		s, commandRepository := newService(t, executor)
+
+		commandRepository.EXPECT().UpdateCommand(mock.Anything, mock.MatchedBy(
+			func(params command.UpdateCommandParams) bool {
+				return params.Attempts == 2 && len(params.AttemptErrors) == 2
+			},
+		)).Return(command.Command{}, nil).Once()
+		commandRepository.EXPECT().UpdateCommand(mock.Anything, mock.MatchedBy(
+			func(params command.UpdateCommandParams) bool {
+				return params.Attempts == 3 && len(params.AttemptErrors) == 3
+			},
+		)).Return(command.Command{}, nil).Once()
+
+		// The command failed once, then it was suspended and executed again.
+		suspended := cmd
+		suspended.Attempts = 1
+		suspended.AttemptErrors = []command.AttemptError{{Attempt: 1, Error: ackTimeoutErr.Error()}}
+		_, err := s.routeWithRetry(context.Background(), context.Background(), suspended, policy)
+		require.ErrorIs(t, err, controller.ErrCommandACKTimeout)
+		require.Equal(t, 2, executor.calls)
+	})
+
+	t.Run("Should not retry non retryable error", func(t *testing.T) {
+		execErr := errors.New("exec error")
+		executor := &flakyFakeExecutor{errs: []error{execErr}}
+		s, commandRepository := newService(t, executor)
+
+		commandRepository.EXPECT().UpdateCommand(mock.Anything, mock.MatchedBy(
+			func(params command.UpdateCommandParams) bool {
+				return params.Attempts == 1 && len(params.AttemptErrors) == 1
+			},
+		)).Return(command.Command{}, nil).Once()
+
+		_, err := s.routeWithRetry(context.Background(), context.Background(), cmd, policy)
+		require.ErrorIs(t, err, execErr)
+		require.Equal(t, 1, executor.calls)
+	})
+
+	t.Run("Should stop retrying if command is canceled during backoff", func(t *testing.T) {
+		executor := &flakyFakeExecutor{errs: []error{ackTimeoutErr, ackTimeoutErr}}
+		s, commandRepository := newService(t, executor)
+
+		commandRepository.EXPECT().UpdateCommand(mock.Anything, mock.Anything).Return(command.Command{}, nil).Once()
+
+		cmdCtx, cancel := context.WithCancel(context.Background())
+		executor.onCancel = cancel
+
+		longBackoffPolicy := policy
+		longBackoffPolicy.Backoff = time.Hour
+
+		_, err := s.routeWithRetry(context.Background(), cmdCtx, cmd, longBackoffPolicy)
+		require.ErrorIs(t, err, controller.ErrCommandACKTimeout)
+		require.Equal(t, 1, executor.calls)
+	})
+}
+
+func TestService_GetRetryPolicy(t *testing.T) {
+	policy := config.RetryPolicy{
+		MaxAttempts:     3,
+		RetryableErrors: []config.RetryableError{config.RetryableErrorACKTimeout},
+	}
+	cfg := config.Command{Retry: config.Retry{Default: policy}}
+
+	t.Run("Should fall back to the retry policy of the config", func(t *testing.T) {
+		require.Equal(t, policy, service{}.getRetryPolicy(cfg, command.Command{Type: command.CommandTypeCargoOpen}))
+	})
+
+	t.Run("Should never retry a composite command", func(t *testing.T) {
+		for _, cmdType := range []command.CommandType{command.CommandTypeMission, command.CommandTypeDeliver} {
+			require.Equal(t, config.RetryPolicy{}, service{}.getRetryPolicy(cfg, command.Command{
+				Type:        cmdType,
+				RetryPolicy: &policy,
+			}))
+		}
+	})
+}
+
+func TestRetryPolicy_BackoffFor(t *testing.T) {
+	policy := config.RetryPolicy{
+		Backoff:    time.Second,
+		MaxBackoff: 5 * time.Second,
+	}
+
+	require.Equal(t, time.Second, policy.BackoffFor(1))
+	require.Equal(t, 2*time.Second, policy.BackoffFor(2))
+	require.Equal(t, 4*time.Second, policy.BackoffFor(3))
+	require.Equal(t, 5*time.Second, policy.BackoffFor(4))
+}
+
+// flakyFakeExecutor returns the given errors in order, then succeeds.
+type flakyFakeExecutor struct {
+	errs     []error
+	calls    int
+	cancels  int
+	onCancel func()
+}
+
+func (e *flakyFakeExecutor) Execute(_ context.Context, _ command.CargoOpenInputs) (command.CargoOpenOutputs, error) {
+	e.calls++
+	if e.calls <= len(e.errs) {
+		return command.CargoOpenOutputs{}, e.errs[e.calls-1]
+	}
+	return command.CargoOpenOutputs{}, nil
+}
+
+func (e *flakyFakeExecutor) OnCancel(_ context.Context) error {
+	e.cancels++
+	if e.onCancel != nil {
+		e.onCancel()
+	}
+	return nil
+}
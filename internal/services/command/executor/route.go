@@ -77,6 +77,13 @@ func (s *service) route(ctx context.Context, cmd command.Command) (command.Outpu
 		}
 		outputs, err = s.cargoCheckQRExecutor.Execute(ctx, *i)
 
+	case command.CommandTypeCargoHome:
+		i, ok := cmd.Inputs.(*command.CargoHomeInputs)
+		if !ok {
+			return nil, fmt.Errorf("invalid cargo home inputs: %v", cmd.Inputs)
+		}
+		outputs, err = s.cargoHomeExecutor.Execute(ctx, *i)
+
 	case command.CommandTypeScanLocation:
 		i, ok := cmd.Inputs.(*command.ScanLocationInputs)
 		if !ok {
@@ -91,9 +98,61 @@ func (s *service) route(ctx context.Context, cmd command.Command) (command.Outpu
 		}
 		outputs, err = s.waitExecutor.Execute(ctx, *i)
 
+	case command.CommandTypeMission:
+		i, ok := cmd.Inputs.(*command.MissionInputs)
+		if !ok {
+			return nil, fmt.Errorf("invalid mission inputs: %v", cmd.Inputs)
+		}
+		outputs, err = s.missionExecutor.Execute(ctx, *i)
+
+	case command.CommandTypeDeliver:
+		i, ok := cmd.Inputs.(*command.DeliverInputs)
+		if !ok {
+			return nil, fmt.Errorf("invalid deliver inputs: %v", cmd.Inputs)
+		}
+		outputs, err = s.deliverExecutor.Execute(ctx, *i)
+
+	case command.CommandTypeBatterySetCharge:
+		i, ok := cmd.Inputs.(*command.BatterySetChargeInputs)
+		if !ok {
+			return nil, fmt.Errorf("invalid battery set charge inputs: %v", cmd.Inputs)
+		}
+		outputs, err = s.batterySetChargeExecutor.Execute(ctx, *i)
+
+	case command.CommandTypeBatterySetDischarge:
+		i, ok := cmd.Inputs.(*command.BatterySetDischargeInputs)
+		if !ok {
+			return nil, fmt.Errorf("invalid battery set discharge inputs: %v", cmd.Inputs)
+		}
+		outputs, err = s.batterySetDischargeExecutor.Execute(ctx, *i)
+
 	default:
 		return nil, fmt.Errorf("invalid command type: %v", cmd.Type)
 	}
 
 	return outputs, err
 }
+
+// routeStep routes a step of a MISSION or DELIVER command. The step is bounded by the
+// timeout of its command type, on top of the deadline of the parent command.
+// A step running out of time is stopped by its cancel hook and fails with
+// ErrCommandTimedOut, so the parent command handles it like any failed step.
+func (s *service) routeStep(ctx context.Context, step command.Command) (command.Outputs, error) {
+	timeout := s.getCommandConfig(ctx).Timeout.ForType(step.Type.String())
+	if timeout <= 0 {
+		return s.route(ctx, step)
+	}
+
+	stepCtx, cancel := context.WithTimeoutCause(ctx, timeout, command.ErrCommandTimedOut)
+	defer cancel()
+
+	outputs, err := s.route(stepCtx, step)
+	if ctx.Err() == nil && stepCtx.Err() != nil {
+		if err := s.runCancelHook(ctx, step); err != nil {
+			return outputs, err
+		}
+		return outputs, context.Cause(stepCtx)
+	}
+
+	return outputs, err
+}
@@ -174,6 +174,23 @@ func TestService_route(t *testing.T) {
 			expectedOutputs: command.CargoCheckQROutputs{},
 			expectedErr:     execErr,
 		},
+		{
+			name: "cargo home execute successfully",
+			cmd: command.Command{
+				Type:   command.CommandTypeCargoHome,
+				Inputs: &command.CargoHomeInputs{},
+			},
+			expectedOutputs: command.CargoHomeOutputs{},
+		},
+		{
+			name: "cargo home execute with error",
+			cmd: command.Command{
+				Type:   command.CommandTypeCargoHome,
+				Inputs: &command.CargoHomeInputs{},
+			},
+			expectedOutputs: command.CargoHomeOutputs{},
+			expectedErr:     execErr,
+		},
 		{
 			name: "scan location execute successfully",
 			cmd: command.Command{
@@ -208,12 +225,81 @@ func TestService_route(t *testing.T) {
 			expectedOutputs: command.WaitOutputs{},
 			expectedErr:     execErr,
 		},
+		{
+			name: "mission execute successfully",
+			cmd: command.Command{
+				Type:   command.CommandTypeMission,
+				Inputs: &command.MissionInputs{},
+			},
+			expectedOutputs: command.MissionOutputs{},
+		},
+		{
+			name: "mission execute with error",
+			cmd: command.Command{
+				Type:   command.CommandTypeMission,
+				Inputs: &command.MissionInputs{},
+			},
+			expectedOutputs: command.MissionOutputs{},
+			expectedErr:     execErr,
+		},
+		{
+			name: "deliver execute successfully",
+			cmd: command.Command{
+				Type:   command.CommandTypeDeliver,
+				Inputs: &command.DeliverInputs{},
+			},
+			expectedOutputs: command.DeliverOutputs{},
+		},
+		{
+			name: "deliver execute with error",
+			cmd: command.Command{
+				Type:   command.CommandTypeDeliver,
+				Inputs: &command.DeliverInputs{},
+			},
+			expectedOutputs: command.DeliverOutputs{},
+			expectedErr:     execErr,
+		},
+		{
+			name: "battery set charge execute successfully",
+			cmd: command.Command{
+				Type:   command.CommandTypeBatterySetCharge,
+				Inputs: &command.BatterySetChargeInputs{},
+			},
+			expectedOutputs: command.BatterySetChargeOutputs{},
+		},
+		{
+			name: "battery set charge execute with error",
+			cmd: command.Command{
+				Type:   command.CommandTypeBatterySetCharge,
+				Inputs: &command.BatterySetChargeInputs{},
+			},
+			expectedOutputs: command.BatterySetChargeOutputs{},
+			expectedErr:     execErr,
+		},
+		{
+			name: "battery set discharge execute successfully",
+			cmd: command.Command{
+				Type:   command.CommandTypeBatterySetDischarge,
+				Inputs: &command.BatterySetDischargeInputs{},
+			},
+			expectedOutputs: command.BatterySetDischargeOutputs{},
+		},
+		{
+			name: "battery set discharge execute with error",
+			cmd: command.Command{
+				Type:   command.CommandTypeBatterySetDischarge,
+				Inputs: &command.BatterySetDischargeInputs{},
+			},
+			expectedOutputs: command.BatterySetDischargeOutputs{},
+			expectedErr:     execErr,
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			s := newTestService(
 				logging.NewNoopLogger(),
+				nil,
 				commandmocks.NewFakeRunningCommandRepository(t),
 				commandmocks.NewFakeRepository(t),
 				tc.expectedErr,
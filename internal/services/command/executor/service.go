@@ -7,12 +7,17 @@ import (
 	"log/slog"
 	"time"
 
+	"github.com/tbe-team/raybot/internal/config"
+	"github.com/tbe-team/raybot/internal/services/battery"
 	"github.com/tbe-team/raybot/internal/services/cargo"
 	"github.com/tbe-team/raybot/internal/services/command"
-	"github.com/tbe-team/raybot/internal/services/config"
+	configservice "github.com/tbe-team/raybot/internal/services/config"
 	"github.com/tbe-team/raybot/internal/services/distancesensor"
 	"github.com/tbe-team/raybot/internal/services/drivemotor"
 	"github.com/tbe-team/raybot/internal/services/liftmotor"
+	"github.com/tbe-team/raybot/internal/services/limitswitch"
+	"github.com/tbe-team/raybot/internal/services/location"
+	"github.com/tbe-team/raybot/internal/services/trackmap"
 	"github.com/tbe-team/raybot/pkg/eventbus"
 	"github.com/tbe-team/raybot/pkg/ptr"
 )
@@ -34,6 +39,7 @@ type CommandExecutor[I command.Inputs, O command.Outputs] interface {
 
 type service struct {
 	log                      *slog.Logger
+	configService            configservice.Service
 	runningCommandRepository command.RunningCommandRepository
 	commandRepository        command.Repository
 
@@ -47,40 +53,60 @@ type service struct {
 	cargoLiftExecutor    CommandExecutor[command.CargoLiftInputs, command.CargoLiftOutputs]
 	cargoLowerExecutor   CommandExecutor[command.CargoLowerInputs, command.CargoLowerOutputs]
 	cargoCheckQRExecutor CommandExecutor[command.CargoCheckQRInputs, command.CargoCheckQROutputs]
+	cargoHomeExecutor    CommandExecutor[command.CargoHomeInputs, command.CargoHomeOutputs]
 
 	scanLocationExecutor CommandExecutor[command.ScanLocationInputs, command.ScanLocationOutputs]
 	waitExecutor         CommandExecutor[command.WaitInputs, command.WaitOutputs]
 
+	missionExecutor CommandExecutor[command.MissionInputs, command.MissionOutputs]
+	deliverExecutor CommandExecutor[command.DeliverInputs, command.DeliverOutputs]
+
+	batterySetChargeExecutor    CommandExecutor[command.BatterySetChargeInputs, command.BatterySetChargeOutputs]
+	batterySetDischargeExecutor CommandExecutor[command.BatterySetDischargeInputs, command.BatterySetDischargeOutputs]
+
 	cancelableMap map[command.CommandType]Cancelable
 }
 
 func NewService(
 	log *slog.Logger,
-	subscriber eventbus.Subscriber,
-	configService config.Service,
+	eventBus eventbus.EventBus,
+	configService configservice.Service,
 	driveMotorService drivemotor.Service,
 	liftMotorService liftmotor.Service,
+	limitSwitchService limitswitch.Service,
 	cargoService cargo.Service,
+	batteryService battery.Service,
 	distanceSensorService distancesensor.Service,
+	locationService location.Service,
+	trackMapService trackmap.Service,
 	runningCommandRepository command.RunningCommandRepository,
 	commandRepository command.Repository,
 ) command.ExecutorService {
+	driveObstacleTracker := newDriveObstacleTracker(log, eventBus, configService)
+	progressReporter := newProgressReporter(log, eventBus, runningCommandRepository)
+
 	stopMovementExecutor := newStopMovementExecutor(driveMotorService)
-	moveBackwardExecutor := newMoveBackwardExecutor(driveMotorService)
-	moveForwardExecutor := newMoveForwardExecutor(driveMotorService)
-	moveToExecutor := newMoveToExecutor(log, subscriber, driveMotorService)
+	moveBackwardExecutor := newMoveBackwardExecutor(log, eventBus, driveMotorService, locationService, driveObstacleTracker, progressReporter)
+	moveForwardExecutor := newMoveForwardExecutor(log, eventBus, driveMotorService, locationService, driveObstacleTracker, progressReporter)
+	moveToExecutor := newMoveToExecutor(log, eventBus, configService, driveMotorService, locationService, trackMapService, driveObstacleTracker, progressReporter)
+
+	cargoOpenExecutor := newCargoOpenExecutor(log, eventBus, cargoService)
+	cargoCloseExecutor := newCargoCloseExecutor(log, eventBus, cargoService)
+	cargoLiftExecutor := newCargoLiftExecutor(log, eventBus, configService, liftMotorService, distanceSensorService, progressReporter)
+	cargoLowerExecutor := newCargoLowerExecutor(log, eventBus, configService, liftMotorService, progressReporter)
+	cargoCheckQRExecutor := newCargoCheckQRExecutor(log, eventBus)
+	cargoHomeExecutor := newCargoHomeExecutor(log, eventBus, liftMotorService, limitSwitchService, distanceSensorService, progressReporter)
 
-	cargoOpenExecutor := newCargoOpenExecutor(log, subscriber, cargoService)
-	cargoCloseExecutor := newCargoCloseExecutor(log, subscriber, cargoService)
-	cargoLiftExecutor := newCargoLiftExecutor(log, subscriber, configService, liftMotorService, distanceSensorService)
-	cargoLowerExecutor := newCargoLowerExecutor(log, subscriber, configService, liftMotorService)
-	cargoCheckQRExecutor := newCargoCheckQRExecutor(log, subscriber)
+	scanLocationExecutor := newScanLocationExecutor(log, eventBus, driveMotorService)
+	waitExecutor := newWaitExecutor(progressReporter)
 
-	scanLocationExecutor := newScanLocationExecutor(log, subscriber, driveMotorService)
-	waitExecutor := newWaitExecutor()
+	batterySettingConfirmer := newBatterySettingConfirmer(log, eventBus, configService)
+	batterySetChargeExecutor := newBatterySetChargeExecutor(log, batteryService, batterySettingConfirmer)
+	batterySetDischargeExecutor := newBatterySetDischargeExecutor(log, batteryService, batterySettingConfirmer)
 
-	return &service{
+	s := &service{
 		log:                      log,
+		configService:            configService,
 		runningCommandRepository: runningCommandRepository,
 		commandRepository:        commandRepository,
 
@@ -94,10 +120,14 @@ func NewService(
 		cargoLiftExecutor:    cargoLiftExecutor,
 		cargoLowerExecutor:   cargoLowerExecutor,
 		cargoCheckQRExecutor: cargoCheckQRExecutor,
+		cargoHomeExecutor:    cargoHomeExecutor,
 
 		scanLocationExecutor: scanLocationExecutor,
 		waitExecutor:         waitExecutor,
 
+		batterySetChargeExecutor:    batterySetChargeExecutor,
+		batterySetDischargeExecutor: batterySetDischargeExecutor,
+
 		cancelableMap: map[command.CommandType]Cancelable{
 			command.CommandTypeStopMovement: stopMovementExecutor,
 			command.CommandTypeMoveBackward: moveBackwardExecutor,
@@ -109,11 +139,26 @@ func NewService(
 			command.CommandTypeCargoLift:    cargoLiftExecutor,
 			command.CommandTypeCargoLower:   cargoLowerExecutor,
 			command.CommandTypeCargoCheckQR: cargoCheckQRExecutor,
+			command.CommandTypeCargoHome:    cargoHomeExecutor,
 
 			command.CommandTypeScanLocation: scanLocationExecutor,
 			command.CommandTypeWait:         waitExecutor,
+
+			command.CommandTypeBatterySetCharge:    batterySetChargeExecutor,
+			command.CommandTypeBatterySetDischarge: batterySetDischargeExecutor,
 		},
 	}
+
+	// The mission and deliver executors run their steps through the other executors.
+	missionExecutor := newMissionExecutor(log, s)
+	s.missionExecutor = missionExecutor
+	s.cancelableMap[command.CommandTypeMission] = missionExecutor
+
+	deliverExecutor := newDeliverExecutor(log, s, trackMapService, distanceSensorService, liftMotorService)
+	s.deliverExecutor = deliverExecutor
+	s.cancelableMap[command.CommandTypeDeliver] = deliverExecutor
+
+	return s
 }
 
 func (s *service) Execute(ctx context.Context, cmd command.Command) error {
@@ -122,11 +167,14 @@ func (s *service) Execute(ctx context.Context, cmd command.Command) error {
 	case err == nil:
 		return s.handleSuccess(ctx, cmd.ID, outputs)
 
+	case errors.Is(err, command.ErrCommandSuspended):
+		return s.handleSuspend(ctx, cmd.ID)
+
 	case errors.Is(err, context.Canceled):
 		return s.handleCancel(ctx, cmd.ID, outputs)
 
 	default:
-		return s.handleFailure(ctx, cmd.ID, err)
+		return s.handleFailure(ctx, cmd.ID, outputs, err)
 	}
 }
 
@@ -158,8 +206,16 @@ func (s *service) execute(ctx context.Context, cmd command.Command) (command.Out
 		return nil, fmt.Errorf("failed to add running command: %w", err)
 	}
 
+	cmdCfg := s.getCommandConfig(ctx)
+
 	cmdCtx := runningCmd.Context()
-	out, err := s.route(cmdCtx, cmd)
+	if deadline, ok := cmd.ExecutionDeadline(now, cmdCfg.Timeout.ForType(cmd.Type.String())); ok {
+		var cancelDeadline context.CancelFunc
+		cmdCtx, cancelDeadline = context.WithDeadlineCause(cmdCtx, deadline, command.ErrCommandTimedOut)
+		defer cancelDeadline()
+	}
+
+	out, err := s.routeWithRetry(ctx, cmdCtx, cmd, s.getRetryPolicy(cmdCfg, cmd))
 
 	select {
 	case <-cmdCtx.Done():
@@ -167,13 +223,24 @@ func (s *service) execute(ctx context.Context, cmd command.Command) (command.Out
 		if err != nil {
 			return out, err
 		}
-		return out, cmdCtx.Err()
+		return out, context.Cause(cmdCtx)
 
 	default:
 		return out, err
 	}
 }
 
+// getCommandConfig returns the command config,
+// or the zero config (no timeout, no retry) if it can not be read.
+func (s *service) getCommandConfig(ctx context.Context) config.Command {
+	cfg, err := s.configService.GetCommandConfig(ctx)
+	if err != nil {
+		s.log.Error("failed to get command config, using no timeout and no retry", slog.Any("error", err))
+		return config.Command{}
+	}
+	return cfg
+}
+
 func (s *service) runCancelHook(ctx context.Context, cmd command.Command) error {
 	c, ok := s.cancelableMap[cmd.Type]
 	if !ok {
@@ -232,7 +299,27 @@ func (s *service) handleCancel(ctx context.Context, id int64, outputs command.Ou
 	return nil
 }
 
-func (s *service) handleFailure(ctx context.Context, id int64, execErr error) error {
+// handleSuspend puts a command preempted by a higher priority command back into the queue.
+func (s *service) handleSuspend(ctx context.Context, id int64) error {
+	log := s.log.With(slog.Int64("command_id", id))
+	log.Info("command suspended, putting back into the queue")
+
+	_, err := s.commandRepository.UpdateCommand(ctx, command.UpdateCommandParams{
+		ID:           id,
+		Status:       command.StatusQueued,
+		SetStatus:    true,
+		StartedAt:    nil,
+		SetStartedAt: true,
+		UpdatedAt:    time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update command status: %w", err)
+	}
+
+	return nil
+}
+
+func (s *service) handleFailure(ctx context.Context, id int64, outputs command.Outputs, execErr error) error {
 	log := s.log.With(slog.Int64("command_id", id), slog.Any("exec_error", execErr))
 	log.Error("command execution failed")
 
@@ -241,6 +328,8 @@ func (s *service) handleFailure(ctx context.Context, id int64, execErr error) er
 		ID:             id,
 		Status:         command.StatusFailed,
 		SetStatus:      true,
+		Outputs:        outputs,
+		SetOutputs:     outputs != nil,
 		Error:          ptr.New(execErr.Error()),
 		SetError:       true,
 		CompletedAt:    ptr.New(now),
@@ -4,20 +4,32 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/tbe-team/raybot/internal/config"
 	"github.com/tbe-team/raybot/internal/logging"
+	batterymocks "github.com/tbe-team/raybot/internal/services/battery/mocks"
 	cargomocks "github.com/tbe-team/raybot/internal/services/cargo/mocks"
 	"github.com/tbe-team/raybot/internal/services/command"
 	commandmocks "github.com/tbe-team/raybot/internal/services/command/mocks"
+	configservice "github.com/tbe-team/raybot/internal/services/config"
 	configmocks "github.com/tbe-team/raybot/internal/services/config/mocks"
 	distancesensormocks "github.com/tbe-team/raybot/internal/services/distancesensor/mocks"
+	"github.com/tbe-team/raybot/internal/services/drivemotor"
 	drivemotormocks "github.com/tbe-team/raybot/internal/services/drivemotor/mocks"
 	liftmotormocks "github.com/tbe-team/raybot/internal/services/liftmotor/mocks"
+	limitswitchmocks "github.com/tbe-team/raybot/internal/services/limitswitch/mocks"
+	"github.com/tbe-team/raybot/internal/services/location"
+	locationmocks "github.com/tbe-team/raybot/internal/services/location/mocks"
+	trackmapmocks "github.com/tbe-team/raybot/internal/services/trackmap/mocks"
 	"github.com/tbe-team/raybot/pkg/eventbus"
+	"github.com/tbe-team/raybot/pkg/ptr"
 )
 
 func TestService_NewService(t *testing.T) {
@@ -27,8 +39,12 @@ func TestService_NewService(t *testing.T) {
 		configmocks.NewFakeService(t),
 		drivemotormocks.NewFakeService(t),
 		liftmotormocks.NewFakeService(t),
+		limitswitchmocks.NewFakeService(t),
 		cargomocks.NewFakeService(t),
+		batterymocks.NewFakeService(t),
 		distancesensormocks.NewFakeService(t),
+		locationmocks.NewFakeService(t),
+		trackmapmocks.NewFakeService(t),
 		commandmocks.NewFakeRunningCommandRepository(t),
 		commandmocks.NewFakeRepository(t),
 	)
@@ -40,7 +56,7 @@ func TestService_Execute(t *testing.T) {
 		log := logging.NewNoopLogger()
 		runningCommandRepository := commandmocks.NewFakeRunningCommandRepository(t)
 		commandRepository := commandmocks.NewFakeRepository(t)
-		service := newTestService(log, runningCommandRepository, commandRepository, nil)
+		service := newTestService(log, newFakeConfigService(t, config.Command{}), runningCommandRepository, commandRepository, nil)
 
 		cmdID := int64(1)
 
@@ -88,7 +104,7 @@ func TestService_Execute(t *testing.T) {
 		runningCommandRepository := commandmocks.NewFakeRunningCommandRepository(t)
 		commandRepository := commandmocks.NewFakeRepository(t)
 		execErr := errors.New("exec error")
-		service := newTestService(log, runningCommandRepository, commandRepository, execErr)
+		service := newTestService(log, newFakeConfigService(t, config.Command{}), runningCommandRepository, commandRepository, execErr)
 
 		cmdID := int64(1)
 
@@ -109,12 +125,24 @@ func TestService_Execute(t *testing.T) {
 
 		runningCommandRepository.EXPECT().Add(mock.Anything, mock.Anything).Return(nil)
 		runningCommandRepository.EXPECT().Remove(mock.Anything).Return(nil)
+		commandRepository.EXPECT().UpdateCommand(mock.Anything, mock.MatchedBy(
+			func(params command.UpdateCommandParams) bool {
+				return params.ID == cmdID &&
+					!params.SetStatus &&
+					params.SetAttempts &&
+					params.Attempts == 1 &&
+					params.SetAttemptErrors &&
+					len(params.AttemptErrors) == 1 &&
+					params.AttemptErrors[0].Error == execErr.Error()
+			},
+		)).Return(command.Command{}, nil)
 		commandRepository.EXPECT().UpdateCommand(mock.Anything, mock.MatchedBy(
 			func(params command.UpdateCommandParams) bool {
 				return params.ID == cmdID &&
 					params.Status == command.StatusFailed &&
 					params.SetStatus &&
-					!params.SetOutputs &&
+					params.SetOutputs &&
+					params.Outputs != nil &&
 					params.Error != nil &&
 					*params.Error == execErr.Error() &&
 					params.SetError &&
@@ -136,7 +164,7 @@ func TestService_Execute(t *testing.T) {
 		log := logging.NewNoopLogger()
 		runningCommandRepository := commandmocks.NewFakeRunningCommandRepository(t)
 		commandRepository := commandmocks.NewFakeRepository(t)
-		service := newTestService(log, runningCommandRepository, commandRepository, context.Canceled)
+		service := newTestService(log, newFakeConfigService(t, config.Command{}), runningCommandRepository, commandRepository, context.Canceled)
 
 		cmdID := int64(1)
 
@@ -177,10 +205,276 @@ func TestService_Execute(t *testing.T) {
 		})
 		require.NoError(t, err)
 	})
+
+	t.Run("Should handle command has been suspended and put it back into the queue successfully", func(t *testing.T) {
+		log := logging.NewNoopLogger()
+		runningCommandRepository := commandmocks.NewFakeRunningCommandRepository(t)
+		commandRepository := commandmocks.NewFakeRepository(t)
+		service := newTestService(log, newFakeConfigService(t, config.Command{}), runningCommandRepository, commandRepository, command.ErrCommandSuspended)
+
+		cmdID := int64(1)
+
+		commandRepository.EXPECT().UpdateCommand(mock.Anything, mock.MatchedBy(
+			func(params command.UpdateCommandParams) bool {
+				return params.ID == cmdID &&
+					params.Status == command.StatusProcessing &&
+					params.SetStatus &&
+					!params.SetAttempts
+			},
+		)).Return(command.Command{
+			ID:     cmdID,                           // Required by the next repository mock call
+			Type:   command.CommandTypeStopMovement, // Required by executor
+			Inputs: &command.StopMovementInputs{},   // Required by executor
+		}, nil)
+
+		runningCommandRepository.EXPECT().Add(mock.Anything, mock.Anything).Return(nil)
+		runningCommandRepository.EXPECT().Remove(mock.Anything).Return(nil)
+		// A suspension is not a failed attempt.
+		commandRepository.EXPECT().UpdateCommand(mock.Anything, mock.MatchedBy(
+			func(params command.UpdateCommandParams) bool {
+				return params.ID == cmdID &&
+					params.Status == command.StatusQueued &&
+					params.SetStatus &&
+					!params.SetAttempts &&
+					params.StartedAt == nil &&
+					params.SetStartedAt &&
+					!params.SetCompletedAt &&
+					!params.UpdatedAt.IsZero()
+			},
+		)).Return(command.Command{}, nil)
+
+		err := service.Execute(context.Background(), command.Command{
+			ID:     cmdID,
+			Type:   command.CommandTypeStopMovement,
+			Inputs: &command.StopMovementInputs{},
+		})
+		require.NoError(t, err)
+	})
+	t.Run("Should fail command that exceeds its timeout and run the cancel hook", func(t *testing.T) {
+		log := logging.NewNoopLogger()
+		runningCommandRepository := commandmocks.NewFakeRunningCommandRepository(t)
+		commandRepository := commandmocks.NewFakeRepository(t)
+		configService := newFakeConfigService(t, config.Command{
+			Timeout: config.Timeout{
+				PerType: map[string]time.Duration{
+					command.CommandTypeWait.String(): 10 * time.Millisecond,
+				},
+			},
+		})
+		service := newTestService(log, configService, runningCommandRepository, commandRepository, nil)
+		waitExecutor := &blockingFakeExecutor[command.WaitInputs, command.WaitOutputs]{}
+		service.waitExecutor = waitExecutor
+		service.cancelableMap[command.CommandTypeWait] = waitExecutor
+
+		cmdID := int64(1)
+		cmd := command.Command{
+			ID:     cmdID,
+			Type:   command.CommandTypeWait,
+			Inputs: &command.WaitInputs{},
+		}
+
+		commandRepository.EXPECT().UpdateCommand(mock.Anything, mock.MatchedBy(
+			func(params command.UpdateCommandParams) bool {
+				return params.ID == cmdID && params.Status == command.StatusProcessing
+			},
+		)).Return(cmd, nil)
+
+		runningCommandRepository.EXPECT().Add(mock.Anything, mock.Anything).Return(nil)
+		runningCommandRepository.EXPECT().Remove(mock.Anything).Return(nil)
+		commandRepository.EXPECT().UpdateCommand(mock.Anything, mock.MatchedBy(
+			func(params command.UpdateCommandParams) bool {
+				return params.ID == cmdID &&
+					params.Status == command.StatusFailed &&
+					params.SetStatus &&
+					params.Error != nil &&
+					strings.Contains(*params.Error, command.ErrCommandTimedOut.MsgID()) &&
+					params.SetError
+			},
+		)).Return(command.Command{}, nil)
+
+		err := service.Execute(context.Background(), cmd)
+		require.NoError(t, err)
+		require.True(t, waitExecutor.canceled.Load())
+	})
+
+	t.Run("Should use the command timeout over the config timeout", func(t *testing.T) {
+		log := logging.NewNoopLogger()
+		runningCommandRepository := commandmocks.NewFakeRunningCommandRepository(t)
+		commandRepository := commandmocks.NewFakeRepository(t)
+		configService := newFakeConfigService(t, config.Command{
+			Timeout: config.Timeout{
+				Default: time.Hour,
+			},
+		})
+		service := newTestService(log, configService, runningCommandRepository, commandRepository, nil)
+		waitExecutor := &blockingFakeExecutor[command.WaitInputs, command.WaitOutputs]{}
+		service.waitExecutor = waitExecutor
+		service.cancelableMap[command.CommandTypeWait] = waitExecutor
+
+		cmdID := int64(1)
+		cmd := command.Command{
+			ID:      cmdID,
+			Type:    command.CommandTypeWait,
+			Inputs:  &command.WaitInputs{},
+			Timeout: ptr.New(10 * time.Millisecond),
+		}
+
+		commandRepository.EXPECT().UpdateCommand(mock.Anything, mock.MatchedBy(
+			func(params command.UpdateCommandParams) bool {
+				return params.ID == cmdID && params.Status == command.StatusProcessing
+			},
+		)).Return(cmd, nil)
+
+		runningCommandRepository.EXPECT().Add(mock.Anything, mock.Anything).Return(nil)
+		runningCommandRepository.EXPECT().Remove(mock.Anything).Return(nil)
+		commandRepository.EXPECT().UpdateCommand(mock.Anything, mock.MatchedBy(
+			func(params command.UpdateCommandParams) bool {
+				return params.ID == cmdID && params.Status == command.StatusFailed
+			},
+		)).Return(command.Command{}, nil)
+
+		err := service.Execute(context.Background(), cmd)
+		require.NoError(t, err)
+		require.True(t, waitExecutor.canceled.Load())
+	})
+
+	t.Run("Should time out a stuck step of a mission with the timeout of the step type", func(t *testing.T) {
+		log := logging.NewNoopLogger()
+		runningCommandRepository := commandmocks.NewFakeRunningCommandRepository(t)
+		commandRepository := commandmocks.NewFakeRepository(t)
+		configService := newFakeConfigService(t, config.Command{
+			Timeout: config.Timeout{
+				PerType: map[string]time.Duration{
+					command.CommandTypeCargoCheckQR.String(): 10 * time.Millisecond,
+				},
+			},
+		})
+		service := newTestService(log, configService, runningCommandRepository, commandRepository, nil)
+		cargoCheckQRExecutor := &blockingFakeExecutor[command.CargoCheckQRInputs, command.CargoCheckQROutputs]{}
+		service.cargoCheckQRExecutor = cargoCheckQRExecutor
+		service.cancelableMap[command.CommandTypeCargoCheckQR] = cargoCheckQRExecutor
+		service.missionExecutor = newMissionExecutor(log, service)
+
+		cmdID := int64(1)
+		cmd := command.Command{
+			ID:   cmdID,
+			Type: command.CommandTypeMission,
+			Inputs: &command.MissionInputs{
+				Steps: []command.MissionStep{
+					{Inputs: &command.CargoOpenInputs{}},
+					{Inputs: &command.CargoCheckQRInputs{QRCode: "abc"}},
+					{Inputs: &command.CargoCloseInputs{}},
+				},
+			},
+		}
+
+		commandRepository.EXPECT().UpdateCommand(mock.Anything, mock.MatchedBy(
+			func(params command.UpdateCommandParams) bool {
+				return params.ID == cmdID && params.Status == command.StatusProcessing
+			},
+		)).Return(cmd, nil)
+
+		runningCommandRepository.EXPECT().Add(mock.Anything, mock.Anything).Return(nil)
+		runningCommandRepository.EXPECT().Remove(mock.Anything).Return(nil)
+		commandRepository.EXPECT().UpdateCommand(mock.Anything, mock.MatchedBy(
+			func(params command.UpdateCommandParams) bool {
+				return params.ID == cmdID && params.SetAttemptErrors
+			},
+		)).Return(command.Command{}, nil)
+		commandRepository.EXPECT().UpdateCommand(mock.Anything, mock.MatchedBy(
+			func(params command.UpdateCommandParams) bool {
+				if params.ID != cmdID || params.Status != command.StatusFailed || params.Error == nil {
+					return false
+				}
+				outputs, ok := params.Outputs.(command.MissionOutputs)
+				return ok &&
+					strings.Contains(*params.Error, command.ErrCommandTimedOut.MsgID()) &&
+					outputs.Steps[0].Status == command.StatusSucceeded &&
+					outputs.Steps[1].Status == command.StatusFailed &&
+					outputs.Steps[2].Status == command.StatusCanceled
+			},
+		)).Return(command.Command{}, nil)
+
+		done := make(chan error, 1)
+		go func() { done <- service.Execute(context.Background(), cmd) }()
+
+		select {
+		case err := <-done:
+			require.NoError(t, err)
+		case <-time.After(time.Second):
+			t.Fatal("the mission did not time out its stuck step")
+		}
+		require.True(t, cargoCheckQRExecutor.canceled.Load())
+	})
+
+	t.Run("Should complete a mission moving forward then stopping with the obstacle tracking enabled", func(t *testing.T) {
+		log := logging.NewNoopLogger()
+		bus := &eventbus.NoopEventBus{}
+		runningCommandRepository := commandmocks.NewFakeRunningCommandRepository(t)
+		commandRepository := commandmocks.NewFakeRepository(t)
+		driveMotorService := drivemotormocks.NewFakeService(t)
+		locationService := locationmocks.NewFakeService(t)
+		configService := newFakeConfigService(t, config.Command{
+			Drive: config.Drive{
+				ObstacleTracking: config.ObstacleTracking{EnterDistance: 20, ExitDistance: 30},
+			},
+		})
+		service := newTestService(log, configService, runningCommandRepository, commandRepository, nil)
+		service.moveForwardExecutor = newMoveForwardExecutor(log, bus, driveMotorService, locationService,
+			newDriveObstacleTracker(log, bus, configService), progressReporter{})
+		service.stopMovementExecutor = newStopMovementExecutor(driveMotorService)
+		service.missionExecutor = newMissionExecutor(log, service)
+
+		cmdID := int64(1)
+		cmd := command.Command{
+			ID:   cmdID,
+			Type: command.CommandTypeMission,
+			Inputs: &command.MissionInputs{
+				Steps: []command.MissionStep{
+					{Inputs: &command.MoveForwardInputs{MotorSpeed: 50}},
+					{Inputs: &command.StopMovementInputs{}},
+				},
+			},
+		}
+
+		locationService.EXPECT().GetLocation(mock.Anything).Return(location.Location{CurrentLocation: "A"}, nil)
+		driveMotorService.EXPECT().MoveForward(mock.Anything, drivemotor.MoveForwardParams{Speed: 50}).Return(nil).Once()
+		driveMotorService.EXPECT().Stop(mock.Anything).Return(nil).Once()
+		commandRepository.EXPECT().UpdateCommand(mock.Anything, mock.MatchedBy(
+			func(params command.UpdateCommandParams) bool {
+				return params.ID == cmdID && params.Status == command.StatusProcessing
+			},
+		)).Return(cmd, nil)
+
+		runningCommandRepository.EXPECT().Add(mock.Anything, mock.Anything).Return(nil)
+		runningCommandRepository.EXPECT().Remove(mock.Anything).Return(nil)
+		commandRepository.EXPECT().UpdateCommand(mock.Anything, mock.MatchedBy(
+			func(params command.UpdateCommandParams) bool {
+				if params.ID != cmdID || params.Status != command.StatusSucceeded {
+					return false
+				}
+				outputs, ok := params.Outputs.(command.MissionOutputs)
+				return ok &&
+					outputs.Steps[0].Status == command.StatusSucceeded &&
+					outputs.Steps[1].Status == command.StatusSucceeded
+			},
+		)).Return(command.Command{}, nil)
+
+		done := make(chan error, 1)
+		go func() { done <- service.Execute(context.Background(), cmd) }()
+
+		select {
+		case err := <-done:
+			require.NoError(t, err)
+		case <-time.After(time.Second):
+			t.Fatal("the mission is held by MOVE_FORWARD")
+		}
+	})
 }
 
 func newTestService(
 	log *slog.Logger,
+	configService configservice.Service,
 	runningCommandRepository command.RunningCommandRepository,
 	commandRepository command.Repository,
 	expectedReturnErr error,
@@ -195,12 +489,20 @@ func newTestService(
 	cargoLiftExecutor := newFakeExecutor[command.CargoLiftInputs, command.CargoLiftOutputs](expectedReturnErr)
 	cargoLowerExecutor := newFakeExecutor[command.CargoLowerInputs, command.CargoLowerOutputs](expectedReturnErr)
 	cargoCheckQRExecutor := newFakeExecutor[command.CargoCheckQRInputs, command.CargoCheckQROutputs](expectedReturnErr)
+	cargoHomeExecutor := newFakeExecutor[command.CargoHomeInputs, command.CargoHomeOutputs](expectedReturnErr)
 
 	scanLocationExecutor := newFakeExecutor[command.ScanLocationInputs, command.ScanLocationOutputs](expectedReturnErr)
 	waitExecutor := newFakeExecutor[command.WaitInputs, command.WaitOutputs](expectedReturnErr)
 
+	missionExecutor := newFakeExecutor[command.MissionInputs, command.MissionOutputs](expectedReturnErr)
+	deliverExecutor := newFakeExecutor[command.DeliverInputs, command.DeliverOutputs](expectedReturnErr)
+
+	batterySetChargeExecutor := newFakeExecutor[command.BatterySetChargeInputs, command.BatterySetChargeOutputs](expectedReturnErr)
+	batterySetDischargeExecutor := newFakeExecutor[command.BatterySetDischargeInputs, command.BatterySetDischargeOutputs](expectedReturnErr)
+
 	return &service{
 		log:                      log,
+		configService:            configService,
 		runningCommandRepository: runningCommandRepository,
 		commandRepository:        commandRepository,
 
@@ -214,10 +516,17 @@ func newTestService(
 		cargoLiftExecutor:    cargoLiftExecutor,
 		cargoLowerExecutor:   cargoLowerExecutor,
 		cargoCheckQRExecutor: cargoCheckQRExecutor,
+		cargoHomeExecutor:    cargoHomeExecutor,
 
 		scanLocationExecutor: scanLocationExecutor,
 		waitExecutor:         waitExecutor,
 
+		missionExecutor: missionExecutor,
+		deliverExecutor: deliverExecutor,
+
+		batterySetChargeExecutor:    batterySetChargeExecutor,
+		batterySetDischargeExecutor: batterySetDischargeExecutor,
+
 		cancelableMap: map[command.CommandType]Cancelable{
 			command.CommandTypeStopMovement: stopMovementExecutor,
 			command.CommandTypeMoveBackward: moveBackwardExecutor,
@@ -229,13 +538,26 @@ func newTestService(
 			command.CommandTypeCargoLift:    cargoLiftExecutor,
 			command.CommandTypeCargoLower:   cargoLowerExecutor,
 			command.CommandTypeCargoCheckQR: cargoCheckQRExecutor,
+			command.CommandTypeCargoHome:    cargoHomeExecutor,
 
 			command.CommandTypeScanLocation: scanLocationExecutor,
 			command.CommandTypeWait:         waitExecutor,
+
+			command.CommandTypeMission: missionExecutor,
+			command.CommandTypeDeliver: deliverExecutor,
+
+			command.CommandTypeBatterySetCharge:    batterySetChargeExecutor,
+			command.CommandTypeBatterySetDischarge: batterySetDischargeExecutor,
 		},
 	}
 }
 
+func newFakeConfigService(t *testing.T, commandCfg config.Command) configservice.Service {
+	configService := configmocks.NewFakeService(t)
+	configService.EXPECT().GetCommandConfig(mock.Anything).Return(commandCfg, nil).Maybe()
+	return configService
+}
+
 type fakeExecutor[I command.Inputs, O command.Outputs] struct {
 	expectedReturnErr error
 }
@@ -254,3 +576,19 @@ func (e fakeExecutor[I, O]) Execute(_ context.Context, _ I) (O, error) {
 func (e fakeExecutor[I, O]) OnCancel(_ context.Context) error {
 	return nil
 }
+
+// blockingFakeExecutor blocks until the command context is done.
+type blockingFakeExecutor[I command.Inputs, O command.Outputs] struct {
+	canceled atomic.Bool
+}
+
+func (e *blockingFakeExecutor[I, O]) Execute(ctx context.Context, _ I) (O, error) {
+	var zero O
+	<-ctx.Done()
+	return zero, ctx.Err()
+}
+
+func (e *blockingFakeExecutor[I, O]) OnCancel(_ context.Context) error {
+	e.canceled.Store(true)
+	return nil
+}
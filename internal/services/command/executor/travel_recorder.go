@@ -0,0 +1,149 @@
+package executor
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/tbe-team/raybot/internal/events"
+	"github.com/tbe-team/raybot/internal/services/command"
+	"github.com/tbe-team/raybot/pkg/eventbus"
+)
+
+// travelRecorder records the travel of a drive command for its outputs:
+// the tags passed and the motor speed over time.
+type travelRecorder struct {
+	log        *slog.Logger
+	subscriber eventbus.Subscriber
+
+	mu         sync.Mutex
+	startedAt  time.Time
+	tagsPassed []command.PassedTag
+
+	speed        uint8
+	running      bool
+	lastChangeAt time.Time
+	// speedTime is the integral of the motor speed over the running time
+	speedTime   float64
+	runningTime time.Duration
+}
+
+func newTravelRecorder(log *slog.Logger, subscriber eventbus.Subscriber) *travelRecorder {
+	return &travelRecorder{
+		log:        log,
+		subscriber: subscriber,
+		tagsPassed: []command.PassedTag{},
+	}
+}
+
+// start starts the travel and records the tags passed until the context is canceled.
+func (r *travelRecorder) start(ctx context.Context) {
+	r.mu.Lock()
+	r.startedAt = time.Now()
+	r.mu.Unlock()
+
+	r.subscriber.Subscribe(ctx, events.LocationUpdatedTopic, func(msg *eventbus.Message) {
+		ev, ok := msg.Payload.(events.UpdateLocationEvent)
+		if !ok {
+			r.log.Error("invalid event", slog.Any("event", msg.Payload))
+			return
+		}
+
+		r.tagPassed(ev.Location)
+	})
+}
+
+// tagPassed records that the tag at the location is passed.
+// The events are handled concurrently, so a command that acts on a tag records it
+// itself to make sure the tag is in the outputs.
+func (r *travelRecorder) tagPassed(location string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// a tag is read several times while the robot passes it
+	if n := len(r.tagsPassed); n > 0 && r.tagsPassed[n-1].Location == location {
+		return
+	}
+	r.tagsPassed = append(r.tagsPassed, command.PassedTag{
+		Location: location,
+		PassedAt: time.Now(),
+	})
+}
+
+// motorRunning records that the drive motor runs at the speed from now on.
+func (r *travelRecorder) motorRunning(speed uint8) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.accumulate()
+	r.speed = speed
+	r.running = true
+}
+
+// motorStopped records that the drive motor is stopped from now on.
+func (r *travelRecorder) motorStopped() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.accumulate()
+	r.running = false
+}
+
+// lastTag returns the last tag passed, empty if no tag was passed.
+func (r *travelRecorder) lastTag() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.tagsPassed) == 0 {
+		return ""
+	}
+	return r.tagsPassed[len(r.tagsPassed)-1].Location
+}
+
+// outputs returns the travel record up to now.
+func (r *travelRecorder) outputs(
+	startLocation string,
+	finalLocation string,
+	obstaclePauses []command.ObstaclePause,
+) command.MotionOutputs {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.accumulate()
+
+	var averageMotorSpeed float64
+	if r.runningTime > 0 {
+		averageMotorSpeed = r.speedTime / r.runningTime.Seconds()
+	}
+
+	var travelDuration time.Duration
+	if !r.startedAt.IsZero() {
+		travelDuration = time.Since(r.startedAt)
+	}
+
+	if obstaclePauses == nil {
+		obstaclePauses = []command.ObstaclePause{}
+	}
+
+	return command.MotionOutputs{
+		StartLocation:     startLocation,
+		FinalLocation:     finalLocation,
+		TagsPassed:        append([]command.PassedTag{}, r.tagsPassed...),
+		TravelDuration:    travelDuration,
+		AverageMotorSpeed: averageMotorSpeed,
+		ObstaclePauses:    obstaclePauses,
+	}
+}
+
+// accumulate adds the time since the last change to the running time.
+// The caller must hold the lock.
+func (r *travelRecorder) accumulate() {
+	now := time.Now()
+	if r.running {
+		d := now.Sub(r.lastChangeAt)
+		r.runningTime += d
+		r.speedTime += float64(r.speed) * d.Seconds()
+	}
+	r.lastChangeAt = now
+}
@@ -2,18 +2,26 @@ package executor
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/tbe-team/raybot/internal/services/command"
 )
 
-type waitExecutor struct{}
+type waitExecutor struct {
+	progressReporter progressReporter
+}
 
-func newWaitExecutor() CommandExecutor[command.WaitInputs, command.WaitOutputs] {
-	return waitExecutor{}
+func newWaitExecutor(progressReporter progressReporter) CommandExecutor[command.WaitInputs, command.WaitOutputs] {
+	return waitExecutor{
+		progressReporter: progressReporter,
+	}
 }
 
 func (e waitExecutor) Execute(ctx context.Context, inputs command.WaitInputs) (command.WaitOutputs, error) {
+	e.progressReporter.report(ctx, command.ProgressPhaseWaiting, nil,
+		fmt.Sprintf("waiting until %s", time.Now().Add(time.Duration(inputs.DurationMs)*time.Millisecond).Format(time.RFC3339)))
+
 	select {
 	case <-time.After(time.Duration(inputs.DurationMs) * time.Millisecond):
 		return command.WaitOutputs{}, nil
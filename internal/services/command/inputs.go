@@ -15,8 +15,13 @@ var (
 	_ Inputs = (*CargoLiftInputs)(nil)
 	_ Inputs = (*CargoLowerInputs)(nil)
 	_ Inputs = (*CargoCheckQRInputs)(nil)
+	_ Inputs = (*CargoHomeInputs)(nil)
 	_ Inputs = (*ScanLocationInputs)(nil)
 	_ Inputs = (*WaitInputs)(nil)
+	_ Inputs = (*MissionInputs)(nil)
+	_ Inputs = (*DeliverInputs)(nil)
+	_ Inputs = (*BatterySetChargeInputs)(nil)
+	_ Inputs = (*BatterySetDischargeInputs)(nil)
 )
 
 type Inputs interface {
@@ -52,7 +57,7 @@ func (MoveBackwardInputs) isInputs() {}
 type MoveDirection string
 
 func (m MoveDirection) Validate() error {
-	if m != MoveDirectionForward && m != MoveDirectionBackward {
+	if m != MoveDirectionForward && m != MoveDirectionBackward && m != MoveDirectionAuto {
 		return fmt.Errorf("invalid move direction: %s", m)
 	}
 	return nil
@@ -65,11 +70,15 @@ func (m MoveDirection) String() string {
 const (
 	MoveDirectionForward  MoveDirection = "FORWARD"
 	MoveDirectionBackward MoveDirection = "BACKWARD"
+	// MoveDirectionAuto picks the shortest direction using the track map, only valid for MOVE_TO.
+	MoveDirectionAuto MoveDirection = "AUTO"
 )
 
 type MoveToInputs struct {
-	Location   string        `json:"location" validate:"required"`
-	Direction  MoveDirection `json:"direction" validate:"required,enum"`
+	Location string `json:"location" validate:"required"`
+	// Direction is the direction to move in, if empty or AUTO the shortest
+	// direction to the location is picked from the track map.
+	Direction  MoveDirection `json:"direction" validate:"omitempty,enum"`
 	MotorSpeed uint8         `json:"motor_speed" validate:"required,max=100"`
 }
 
@@ -125,6 +134,17 @@ func (CargoCheckQRInputs) CommandType() CommandType {
 }
 func (CargoCheckQRInputs) isInputs() {}
 
+// CargoHomeInputs raises the lift until the top limit switch is pressed
+// to calibrate the zero of the lift positions.
+type CargoHomeInputs struct {
+	MotorSpeed uint8 `json:"motor_speed" validate:"required,max=100"`
+}
+
+func (CargoHomeInputs) CommandType() CommandType {
+	return CommandTypeCargoHome
+}
+func (CargoHomeInputs) isInputs() {}
+
 type ScanLocationInputs struct{}
 
 func (ScanLocationInputs) CommandType() CommandType {
@@ -141,6 +161,109 @@ func (WaitInputs) CommandType() CommandType {
 }
 func (WaitInputs) isInputs() {}
 
+// MissionInputs is an ordered list of steps executed as a single command.
+// The mission stops at the first step that fails.
+type MissionInputs struct {
+	Steps []MissionStep `json:"steps" validate:"required,min=1,dive"`
+}
+
+func (MissionInputs) CommandType() CommandType {
+	return CommandTypeMission
+}
+func (MissionInputs) isInputs() {}
+
+// MissionStep is a single step of a mission.
+// Inputs can be the inputs of any command type except MISSION.
+type MissionStep struct {
+	Inputs Inputs `validate:"required"`
+}
+
+type missionStepJSON struct {
+	Type   CommandType     `json:"type"`
+	Inputs json.RawMessage `json:"inputs"`
+}
+
+func (s MissionStep) MarshalJSON() ([]byte, error) {
+	if s.Inputs == nil {
+		return nil, fmt.Errorf("mission step inputs is nil")
+	}
+
+	inputsBytes, err := json.Marshal(s.Inputs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal mission step inputs: %w", err)
+	}
+
+	return json.Marshal(missionStepJSON{
+		Type:   s.Inputs.CommandType(),
+		Inputs: inputsBytes,
+	})
+}
+
+func (s *MissionStep) UnmarshalJSON(data []byte) error {
+	var temp missionStepJSON
+	if err := json.Unmarshal(data, &temp); err != nil {
+		return fmt.Errorf("failed to unmarshal mission step: %w", err)
+	}
+
+	if temp.Type == CommandTypeMission {
+		return fmt.Errorf("nested mission is not allowed")
+	}
+
+	inputs, err := UnmarshalInputs(temp.Type, temp.Inputs)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal mission step inputs: %w", err)
+	}
+	s.Inputs = inputs
+
+	return nil
+}
+
+// DeliverInputs delivers the cargo at a station: MOVE_TO the station, CARGO_LOWER,
+// CARGO_OPEN, CARGO_CHECK_QR or WAIT, CARGO_CLOSE, then CARGO_LIFT back to the
+// position the cargo had before lowering.
+type DeliverInputs struct {
+	// Station is the location of the station tag, or the station name in the track map.
+	Station string `json:"station" validate:"required"`
+	// Direction is the direction to move to the station in, if empty or AUTO the shortest
+	// direction is picked from the track map, so it is required without a track map.
+	Direction     MoveDirection `json:"direction" validate:"omitempty,enum"`
+	LowerPosition uint16        `json:"lower_position" validate:"required"`
+	// QRCode is checked while the cargo is open. If it is empty,
+	// the robot waits for WaitDurationMs instead.
+	QRCode         string `json:"qr_code"`
+	WaitDurationMs int64  `json:"wait_duration_ms" validate:"required_without=QRCode,min=0"`
+	MoveSpeed      uint8  `json:"move_speed" validate:"required,max=100"`
+	LiftSpeed      uint8  `json:"lift_speed" validate:"required,max=100"`
+	DoorSpeed      uint8  `json:"door_speed" validate:"required,max=100"`
+}
+
+func (DeliverInputs) CommandType() CommandType {
+	return CommandTypeDeliver
+}
+func (DeliverInputs) isInputs() {}
+
+// BatterySetChargeInputs sets the charge current limit and enables or disables charging.
+type BatterySetChargeInputs struct {
+	CurrentLimit uint16 `json:"current_limit" validate:"required_if=Enabled true"`
+	Enabled      bool   `json:"enabled"`
+}
+
+func (BatterySetChargeInputs) CommandType() CommandType {
+	return CommandTypeBatterySetCharge
+}
+func (BatterySetChargeInputs) isInputs() {}
+
+// BatterySetDischargeInputs sets the discharge current limit and enables or disables discharging.
+type BatterySetDischargeInputs struct {
+	CurrentLimit uint16 `json:"current_limit" validate:"required_if=Enabled true"`
+	Enabled      bool   `json:"enabled"`
+}
+
+func (BatterySetDischargeInputs) CommandType() CommandType {
+	return CommandTypeBatterySetDischarge
+}
+func (BatterySetDischargeInputs) isInputs() {}
+
 func UnmarshalInputs(cmdType CommandType, inputsBytes []byte) (Inputs, error) {
 	var inputs Inputs
 
@@ -208,6 +331,13 @@ func UnmarshalInputs(cmdType CommandType, inputsBytes []byte) (Inputs, error) {
 		}
 		inputs = i
 
+	case CommandTypeCargoHome:
+		i := &CargoHomeInputs{}
+		if err := json.Unmarshal(inputsBytes, i); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal cargo home inputs: %w", err)
+		}
+		inputs = i
+
 	case CommandTypeScanLocation:
 		i := &ScanLocationInputs{}
 		if err := json.Unmarshal(inputsBytes, i); err != nil {
@@ -222,6 +352,34 @@ func UnmarshalInputs(cmdType CommandType, inputsBytes []byte) (Inputs, error) {
 		}
 		inputs = i
 
+	case CommandTypeMission:
+		i := &MissionInputs{}
+		if err := json.Unmarshal(inputsBytes, i); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal mission inputs: %w", err)
+		}
+		inputs = i
+
+	case CommandTypeDeliver:
+		i := &DeliverInputs{}
+		if err := json.Unmarshal(inputsBytes, i); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal deliver inputs: %w", err)
+		}
+		inputs = i
+
+	case CommandTypeBatterySetCharge:
+		i := &BatterySetChargeInputs{}
+		if err := json.Unmarshal(inputsBytes, i); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal battery set charge inputs: %w", err)
+		}
+		inputs = i
+
+	case CommandTypeBatterySetDischarge:
+		i := &BatterySetDischargeInputs{}
+		if err := json.Unmarshal(inputsBytes, i); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal battery set discharge inputs: %w", err)
+		}
+		inputs = i
+
 	default:
 		return nil, fmt.Errorf("invalid command type: %s", cmdType)
 	}
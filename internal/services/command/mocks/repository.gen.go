@@ -119,6 +119,64 @@ func (_c *FakeRepository_CancelQueuedAndProcessingCommandsCreatedByCloud_Call) R
 	return _c
 }
 
+// CancelQueuedCommandByID provides a mock function with given fields: ctx, id, canceledAt
+func (_m *FakeRepository) CancelQueuedCommandByID(ctx context.Context, id int64, canceledAt time.Time) (command.Command, error) {
+	ret := _m.Called(ctx, id, canceledAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelQueuedCommandByID")
+	}
+
+	var r0 command.Command
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) (command.Command, error)); ok {
+		return rf(ctx, id, canceledAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) command.Command); ok {
+		r0 = rf(ctx, id, canceledAt)
+	} else {
+		r0 = ret.Get(0).(command.Command)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time) error); ok {
+		r1 = rf(ctx, id, canceledAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeRepository_CancelQueuedCommandByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelQueuedCommandByID'
+type FakeRepository_CancelQueuedCommandByID_Call struct {
+	*mock.Call
+}
+
+// CancelQueuedCommandByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - canceledAt time.Time
+func (_e *FakeRepository_Expecter) CancelQueuedCommandByID(ctx interface{}, id interface{}, canceledAt interface{}) *FakeRepository_CancelQueuedCommandByID_Call {
+	return &FakeRepository_CancelQueuedCommandByID_Call{Call: _e.mock.On("CancelQueuedCommandByID", ctx, id, canceledAt)}
+}
+
+func (_c *FakeRepository_CancelQueuedCommandByID_Call) Run(run func(ctx context.Context, id int64, canceledAt time.Time)) *FakeRepository_CancelQueuedCommandByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *FakeRepository_CancelQueuedCommandByID_Call) Return(_a0 command.Command, _a1 error) *FakeRepository_CancelQueuedCommandByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeRepository_CancelQueuedCommandByID_Call) RunAndReturn(run func(context.Context, int64, time.Time) (command.Command, error)) *FakeRepository_CancelQueuedCommandByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateCommand provides a mock function with given fields: ctx, _a1
 func (_m *FakeRepository) CreateCommand(ctx context.Context, _a1 command.Command) (command.Command, error) {
 	ret := _m.Called(ctx, _a1)
@@ -439,6 +497,176 @@ func (_c *FakeRepository_GetNextExecutableCommand_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// GetNextExecutableCommandBySource provides a mock function with given fields: ctx, source
+func (_m *FakeRepository) GetNextExecutableCommandBySource(ctx context.Context, source command.Source) (command.Command, error) {
+	ret := _m.Called(ctx, source)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNextExecutableCommandBySource")
+	}
+
+	var r0 command.Command
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, command.Source) (command.Command, error)); ok {
+		return rf(ctx, source)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, command.Source) command.Command); ok {
+		r0 = rf(ctx, source)
+	} else {
+		r0 = ret.Get(0).(command.Command)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, command.Source) error); ok {
+		r1 = rf(ctx, source)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeRepository_GetNextExecutableCommandBySource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNextExecutableCommandBySource'
+type FakeRepository_GetNextExecutableCommandBySource_Call struct {
+	*mock.Call
+}
+
+// GetNextExecutableCommandBySource is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source command.Source
+func (_e *FakeRepository_Expecter) GetNextExecutableCommandBySource(ctx interface{}, source interface{}) *FakeRepository_GetNextExecutableCommandBySource_Call {
+	return &FakeRepository_GetNextExecutableCommandBySource_Call{Call: _e.mock.On("GetNextExecutableCommandBySource", ctx, source)}
+}
+
+func (_c *FakeRepository_GetNextExecutableCommandBySource_Call) Run(run func(ctx context.Context, source command.Source)) *FakeRepository_GetNextExecutableCommandBySource_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(command.Source))
+	})
+	return _c
+}
+
+func (_c *FakeRepository_GetNextExecutableCommandBySource_Call) Return(_a0 command.Command, _a1 error) *FakeRepository_GetNextExecutableCommandBySource_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeRepository_GetNextExecutableCommandBySource_Call) RunAndReturn(run func(context.Context, command.Source) (command.Command, error)) *FakeRepository_GetNextExecutableCommandBySource_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetQueuePosition provides a mock function with given fields: ctx, id
+func (_m *FakeRepository) GetQueuePosition(ctx context.Context, id int64) (command.QueuePosition, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetQueuePosition")
+	}
+
+	var r0 command.QueuePosition
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (command.QueuePosition, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) command.QueuePosition); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(command.QueuePosition)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeRepository_GetQueuePosition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQueuePosition'
+type FakeRepository_GetQueuePosition_Call struct {
+	*mock.Call
+}
+
+// GetQueuePosition is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *FakeRepository_Expecter) GetQueuePosition(ctx interface{}, id interface{}) *FakeRepository_GetQueuePosition_Call {
+	return &FakeRepository_GetQueuePosition_Call{Call: _e.mock.On("GetQueuePosition", ctx, id)}
+}
+
+func (_c *FakeRepository_GetQueuePosition_Call) Run(run func(ctx context.Context, id int64)) *FakeRepository_GetQueuePosition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *FakeRepository_GetQueuePosition_Call) Return(_a0 command.QueuePosition, _a1 error) *FakeRepository_GetQueuePosition_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeRepository_GetQueuePosition_Call) RunAndReturn(run func(context.Context, int64) (command.QueuePosition, error)) *FakeRepository_GetQueuePosition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetQueueState provides a mock function with given fields: ctx
+func (_m *FakeRepository) GetQueueState(ctx context.Context) (command.QueueState, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetQueueState")
+	}
+
+	var r0 command.QueueState
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (command.QueueState, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) command.QueueState); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(command.QueueState)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeRepository_GetQueueState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQueueState'
+type FakeRepository_GetQueueState_Call struct {
+	*mock.Call
+}
+
+// GetQueueState is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *FakeRepository_Expecter) GetQueueState(ctx interface{}) *FakeRepository_GetQueueState_Call {
+	return &FakeRepository_GetQueueState_Call{Call: _e.mock.On("GetQueueState", ctx)}
+}
+
+func (_c *FakeRepository_GetQueueState_Call) Run(run func(ctx context.Context)) *FakeRepository_GetQueueState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *FakeRepository_GetQueueState_Call) Return(_a0 command.QueueState, _a1 error) *FakeRepository_GetQueueState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeRepository_GetQueueState_Call) RunAndReturn(run func(context.Context) (command.QueueState, error)) *FakeRepository_GetQueueState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListCommands provides a mock function with given fields: ctx, params
 func (_m *FakeRepository) ListCommands(ctx context.Context, params command.ListCommandsParams) (paging.List[command.Command], error) {
 	ret := _m.Called(ctx, params)
@@ -496,6 +724,123 @@ func (_c *FakeRepository_ListCommands_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// ListPendingCommands provides a mock function with given fields: ctx
+func (_m *FakeRepository) ListPendingCommands(ctx context.Context) ([]command.Command, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPendingCommands")
+	}
+
+	var r0 []command.Command
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]command.Command, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []command.Command); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]command.Command)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeRepository_ListPendingCommands_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPendingCommands'
+type FakeRepository_ListPendingCommands_Call struct {
+	*mock.Call
+}
+
+// ListPendingCommands is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *FakeRepository_Expecter) ListPendingCommands(ctx interface{}) *FakeRepository_ListPendingCommands_Call {
+	return &FakeRepository_ListPendingCommands_Call{Call: _e.mock.On("ListPendingCommands", ctx)}
+}
+
+func (_c *FakeRepository_ListPendingCommands_Call) Run(run func(ctx context.Context)) *FakeRepository_ListPendingCommands_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *FakeRepository_ListPendingCommands_Call) Return(_a0 []command.Command, _a1 error) *FakeRepository_ListPendingCommands_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeRepository_ListPendingCommands_Call) RunAndReturn(run func(context.Context) ([]command.Command, error)) *FakeRepository_ListPendingCommands_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveQueuedCommand provides a mock function with given fields: ctx, id, move, updatedAt
+func (_m *FakeRepository) MoveQueuedCommand(ctx context.Context, id int64, move command.QueueMove, updatedAt time.Time) (command.Command, error) {
+	ret := _m.Called(ctx, id, move, updatedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveQueuedCommand")
+	}
+
+	var r0 command.Command
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, command.QueueMove, time.Time) (command.Command, error)); ok {
+		return rf(ctx, id, move, updatedAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, command.QueueMove, time.Time) command.Command); ok {
+		r0 = rf(ctx, id, move, updatedAt)
+	} else {
+		r0 = ret.Get(0).(command.Command)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, command.QueueMove, time.Time) error); ok {
+		r1 = rf(ctx, id, move, updatedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeRepository_MoveQueuedCommand_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveQueuedCommand'
+type FakeRepository_MoveQueuedCommand_Call struct {
+	*mock.Call
+}
+
+// MoveQueuedCommand is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - move command.QueueMove
+//   - updatedAt time.Time
+func (_e *FakeRepository_Expecter) MoveQueuedCommand(ctx interface{}, id interface{}, move interface{}, updatedAt interface{}) *FakeRepository_MoveQueuedCommand_Call {
+	return &FakeRepository_MoveQueuedCommand_Call{Call: _e.mock.On("MoveQueuedCommand", ctx, id, move, updatedAt)}
+}
+
+func (_c *FakeRepository_MoveQueuedCommand_Call) Run(run func(ctx context.Context, id int64, move command.QueueMove, updatedAt time.Time)) *FakeRepository_MoveQueuedCommand_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(command.QueueMove), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *FakeRepository_MoveQueuedCommand_Call) Return(_a0 command.Command, _a1 error) *FakeRepository_MoveQueuedCommand_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeRepository_MoveQueuedCommand_Call) RunAndReturn(run func(context.Context, int64, command.QueueMove, time.Time) (command.Command, error)) *FakeRepository_MoveQueuedCommand_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateCommand provides a mock function with given fields: ctx, params
 func (_m *FakeRepository) UpdateCommand(ctx context.Context, params command.UpdateCommandParams) (command.Command, error) {
 	ret := _m.Called(ctx, params)
@@ -553,6 +898,63 @@ func (_c *FakeRepository_UpdateCommand_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// UpdateQueueState provides a mock function with given fields: ctx, state
+func (_m *FakeRepository) UpdateQueueState(ctx context.Context, state command.QueueState) (command.QueueState, error) {
+	ret := _m.Called(ctx, state)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateQueueState")
+	}
+
+	var r0 command.QueueState
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, command.QueueState) (command.QueueState, error)); ok {
+		return rf(ctx, state)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, command.QueueState) command.QueueState); ok {
+		r0 = rf(ctx, state)
+	} else {
+		r0 = ret.Get(0).(command.QueueState)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, command.QueueState) error); ok {
+		r1 = rf(ctx, state)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeRepository_UpdateQueueState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateQueueState'
+type FakeRepository_UpdateQueueState_Call struct {
+	*mock.Call
+}
+
+// UpdateQueueState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - state command.QueueState
+func (_e *FakeRepository_Expecter) UpdateQueueState(ctx interface{}, state interface{}) *FakeRepository_UpdateQueueState_Call {
+	return &FakeRepository_UpdateQueueState_Call{Call: _e.mock.On("UpdateQueueState", ctx, state)}
+}
+
+func (_c *FakeRepository_UpdateQueueState_Call) Run(run func(ctx context.Context, state command.QueueState)) *FakeRepository_UpdateQueueState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(command.QueueState))
+	})
+	return _c
+}
+
+func (_c *FakeRepository_UpdateQueueState_Call) Return(_a0 command.QueueState, _a1 error) *FakeRepository_UpdateQueueState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeRepository_UpdateQueueState_Call) RunAndReturn(run func(context.Context, command.QueueState) (command.QueueState, error)) *FakeRepository_UpdateQueueState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewFakeRepository creates a new instance of FakeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewFakeRepository(t interface {
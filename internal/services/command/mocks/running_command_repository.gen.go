@@ -219,6 +219,63 @@ func (_c *FakeRunningCommandRepository_Update_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// UpdateProgress provides a mock function with given fields: ctx, progress
+func (_m *FakeRunningCommandRepository) UpdateProgress(ctx context.Context, progress command.Progress) (command.CancelableCommand, error) {
+	ret := _m.Called(ctx, progress)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProgress")
+	}
+
+	var r0 command.CancelableCommand
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, command.Progress) (command.CancelableCommand, error)); ok {
+		return rf(ctx, progress)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, command.Progress) command.CancelableCommand); ok {
+		r0 = rf(ctx, progress)
+	} else {
+		r0 = ret.Get(0).(command.CancelableCommand)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, command.Progress) error); ok {
+		r1 = rf(ctx, progress)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeRunningCommandRepository_UpdateProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProgress'
+type FakeRunningCommandRepository_UpdateProgress_Call struct {
+	*mock.Call
+}
+
+// UpdateProgress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - progress command.Progress
+func (_e *FakeRunningCommandRepository_Expecter) UpdateProgress(ctx interface{}, progress interface{}) *FakeRunningCommandRepository_UpdateProgress_Call {
+	return &FakeRunningCommandRepository_UpdateProgress_Call{Call: _e.mock.On("UpdateProgress", ctx, progress)}
+}
+
+func (_c *FakeRunningCommandRepository_UpdateProgress_Call) Run(run func(ctx context.Context, progress command.Progress)) *FakeRunningCommandRepository_UpdateProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(command.Progress))
+	})
+	return _c
+}
+
+func (_c *FakeRunningCommandRepository_UpdateProgress_Call) Return(_a0 command.CancelableCommand, _a1 error) *FakeRunningCommandRepository_UpdateProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeRunningCommandRepository_UpdateProgress_Call) RunAndReturn(run func(context.Context, command.Progress) (command.CancelableCommand, error)) *FakeRunningCommandRepository_UpdateProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewFakeRunningCommandRepository creates a new instance of FakeRunningCommandRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewFakeRunningCommandRepository(t interface {
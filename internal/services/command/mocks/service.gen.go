@@ -163,6 +163,63 @@ func (_c *FakeService_CancelCurrentProcessingCommand_Call) RunAndReturn(run func
 	return _c
 }
 
+// CancelQueuedCommandByID provides a mock function with given fields: ctx, params
+func (_m *FakeService) CancelQueuedCommandByID(ctx context.Context, params command.CancelQueuedCommandByIDParams) (command.Command, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelQueuedCommandByID")
+	}
+
+	var r0 command.Command
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, command.CancelQueuedCommandByIDParams) (command.Command, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, command.CancelQueuedCommandByIDParams) command.Command); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(command.Command)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, command.CancelQueuedCommandByIDParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeService_CancelQueuedCommandByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelQueuedCommandByID'
+type FakeService_CancelQueuedCommandByID_Call struct {
+	*mock.Call
+}
+
+// CancelQueuedCommandByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params command.CancelQueuedCommandByIDParams
+func (_e *FakeService_Expecter) CancelQueuedCommandByID(ctx interface{}, params interface{}) *FakeService_CancelQueuedCommandByID_Call {
+	return &FakeService_CancelQueuedCommandByID_Call{Call: _e.mock.On("CancelQueuedCommandByID", ctx, params)}
+}
+
+func (_c *FakeService_CancelQueuedCommandByID_Call) Run(run func(ctx context.Context, params command.CancelQueuedCommandByIDParams)) *FakeService_CancelQueuedCommandByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(command.CancelQueuedCommandByIDParams))
+	})
+	return _c
+}
+
+func (_c *FakeService_CancelQueuedCommandByID_Call) Return(_a0 command.Command, _a1 error) *FakeService_CancelQueuedCommandByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeService_CancelQueuedCommandByID_Call) RunAndReturn(run func(context.Context, command.CancelQueuedCommandByIDParams) (command.Command, error)) *FakeService_CancelQueuedCommandByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateCommand provides a mock function with given fields: ctx, params
 func (_m *FakeService) CreateCommand(ctx context.Context, params command.CreateCommandParams) (command.Command, error) {
 	ret := _m.Called(ctx, params)
@@ -426,6 +483,175 @@ func (_c *FakeService_GetCurrentProcessingCommand_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// GetCurrentProcessingCommandProgress provides a mock function with given fields: ctx
+func (_m *FakeService) GetCurrentProcessingCommandProgress(ctx context.Context) (command.CommandProgress, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCurrentProcessingCommandProgress")
+	}
+
+	var r0 command.CommandProgress
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (command.CommandProgress, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) command.CommandProgress); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(command.CommandProgress)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeService_GetCurrentProcessingCommandProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCurrentProcessingCommandProgress'
+type FakeService_GetCurrentProcessingCommandProgress_Call struct {
+	*mock.Call
+}
+
+// GetCurrentProcessingCommandProgress is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *FakeService_Expecter) GetCurrentProcessingCommandProgress(ctx interface{}) *FakeService_GetCurrentProcessingCommandProgress_Call {
+	return &FakeService_GetCurrentProcessingCommandProgress_Call{Call: _e.mock.On("GetCurrentProcessingCommandProgress", ctx)}
+}
+
+func (_c *FakeService_GetCurrentProcessingCommandProgress_Call) Run(run func(ctx context.Context)) *FakeService_GetCurrentProcessingCommandProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *FakeService_GetCurrentProcessingCommandProgress_Call) Return(_a0 command.CommandProgress, _a1 error) *FakeService_GetCurrentProcessingCommandProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeService_GetCurrentProcessingCommandProgress_Call) RunAndReturn(run func(context.Context) (command.CommandProgress, error)) *FakeService_GetCurrentProcessingCommandProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetQueuePosition provides a mock function with given fields: ctx, params
+func (_m *FakeService) GetQueuePosition(ctx context.Context, params command.GetQueuePositionParams) (command.QueuePosition, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetQueuePosition")
+	}
+
+	var r0 command.QueuePosition
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, command.GetQueuePositionParams) (command.QueuePosition, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, command.GetQueuePositionParams) command.QueuePosition); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(command.QueuePosition)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, command.GetQueuePositionParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeService_GetQueuePosition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQueuePosition'
+type FakeService_GetQueuePosition_Call struct {
+	*mock.Call
+}
+
+// GetQueuePosition is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params command.GetQueuePositionParams
+func (_e *FakeService_Expecter) GetQueuePosition(ctx interface{}, params interface{}) *FakeService_GetQueuePosition_Call {
+	return &FakeService_GetQueuePosition_Call{Call: _e.mock.On("GetQueuePosition", ctx, params)}
+}
+
+func (_c *FakeService_GetQueuePosition_Call) Run(run func(ctx context.Context, params command.GetQueuePositionParams)) *FakeService_GetQueuePosition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(command.GetQueuePositionParams))
+	})
+	return _c
+}
+
+func (_c *FakeService_GetQueuePosition_Call) Return(_a0 command.QueuePosition, _a1 error) *FakeService_GetQueuePosition_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeService_GetQueuePosition_Call) RunAndReturn(run func(context.Context, command.GetQueuePositionParams) (command.QueuePosition, error)) *FakeService_GetQueuePosition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetQueueState provides a mock function with given fields: ctx
+func (_m *FakeService) GetQueueState(ctx context.Context) (command.QueueState, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetQueueState")
+	}
+
+	var r0 command.QueueState
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (command.QueueState, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) command.QueueState); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(command.QueueState)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeService_GetQueueState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQueueState'
+type FakeService_GetQueueState_Call struct {
+	*mock.Call
+}
+
+// GetQueueState is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *FakeService_Expecter) GetQueueState(ctx interface{}) *FakeService_GetQueueState_Call {
+	return &FakeService_GetQueueState_Call{Call: _e.mock.On("GetQueueState", ctx)}
+}
+
+func (_c *FakeService_GetQueueState_Call) Run(run func(ctx context.Context)) *FakeService_GetQueueState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *FakeService_GetQueueState_Call) Return(_a0 command.QueueState, _a1 error) *FakeService_GetQueueState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeService_GetQueueState_Call) RunAndReturn(run func(context.Context) (command.QueueState, error)) *FakeService_GetQueueState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListCommands provides a mock function with given fields: ctx, params
 func (_m *FakeService) ListCommands(ctx context.Context, params command.ListCommandsParams) (paging.List[command.Command], error) {
 	ret := _m.Called(ctx, params)
@@ -483,6 +709,176 @@ func (_c *FakeService_ListCommands_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// MoveQueuedCommand provides a mock function with given fields: ctx, params
+func (_m *FakeService) MoveQueuedCommand(ctx context.Context, params command.MoveQueuedCommandParams) (command.Command, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveQueuedCommand")
+	}
+
+	var r0 command.Command
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, command.MoveQueuedCommandParams) (command.Command, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, command.MoveQueuedCommandParams) command.Command); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(command.Command)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, command.MoveQueuedCommandParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeService_MoveQueuedCommand_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveQueuedCommand'
+type FakeService_MoveQueuedCommand_Call struct {
+	*mock.Call
+}
+
+// MoveQueuedCommand is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params command.MoveQueuedCommandParams
+func (_e *FakeService_Expecter) MoveQueuedCommand(ctx interface{}, params interface{}) *FakeService_MoveQueuedCommand_Call {
+	return &FakeService_MoveQueuedCommand_Call{Call: _e.mock.On("MoveQueuedCommand", ctx, params)}
+}
+
+func (_c *FakeService_MoveQueuedCommand_Call) Run(run func(ctx context.Context, params command.MoveQueuedCommandParams)) *FakeService_MoveQueuedCommand_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(command.MoveQueuedCommandParams))
+	})
+	return _c
+}
+
+func (_c *FakeService_MoveQueuedCommand_Call) Return(_a0 command.Command, _a1 error) *FakeService_MoveQueuedCommand_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeService_MoveQueuedCommand_Call) RunAndReturn(run func(context.Context, command.MoveQueuedCommandParams) (command.Command, error)) *FakeService_MoveQueuedCommand_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PauseQueue provides a mock function with given fields: ctx, params
+func (_m *FakeService) PauseQueue(ctx context.Context, params command.PauseQueueParams) (command.QueueState, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PauseQueue")
+	}
+
+	var r0 command.QueueState
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, command.PauseQueueParams) (command.QueueState, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, command.PauseQueueParams) command.QueueState); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(command.QueueState)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, command.PauseQueueParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeService_PauseQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseQueue'
+type FakeService_PauseQueue_Call struct {
+	*mock.Call
+}
+
+// PauseQueue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params command.PauseQueueParams
+func (_e *FakeService_Expecter) PauseQueue(ctx interface{}, params interface{}) *FakeService_PauseQueue_Call {
+	return &FakeService_PauseQueue_Call{Call: _e.mock.On("PauseQueue", ctx, params)}
+}
+
+func (_c *FakeService_PauseQueue_Call) Run(run func(ctx context.Context, params command.PauseQueueParams)) *FakeService_PauseQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(command.PauseQueueParams))
+	})
+	return _c
+}
+
+func (_c *FakeService_PauseQueue_Call) Return(_a0 command.QueueState, _a1 error) *FakeService_PauseQueue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeService_PauseQueue_Call) RunAndReturn(run func(context.Context, command.PauseQueueParams) (command.QueueState, error)) *FakeService_PauseQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResumeQueue provides a mock function with given fields: ctx
+func (_m *FakeService) ResumeQueue(ctx context.Context) (command.QueueState, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResumeQueue")
+	}
+
+	var r0 command.QueueState
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (command.QueueState, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) command.QueueState); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(command.QueueState)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeService_ResumeQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeQueue'
+type FakeService_ResumeQueue_Call struct {
+	*mock.Call
+}
+
+// ResumeQueue is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *FakeService_Expecter) ResumeQueue(ctx interface{}) *FakeService_ResumeQueue_Call {
+	return &FakeService_ResumeQueue_Call{Call: _e.mock.On("ResumeQueue", ctx)}
+}
+
+func (_c *FakeService_ResumeQueue_Call) Run(run func(ctx context.Context)) *FakeService_ResumeQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *FakeService_ResumeQueue_Call) Return(_a0 command.QueueState, _a1 error) *FakeService_ResumeQueue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeService_ResumeQueue_Call) RunAndReturn(run func(context.Context) (command.QueueState, error)) *FakeService_ResumeQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RunNextExecutableCommand provides a mock function with given fields: ctx
 func (_m *FakeService) RunNextExecutableCommand(ctx context.Context) error {
 	ret := _m.Called(ctx)
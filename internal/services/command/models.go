@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/tbe-team/raybot/internal/config"
 )
 
 //nolint:revive
@@ -19,13 +21,20 @@ func (c CommandType) Validate() error {
 	switch c {
 	case CommandTypeStopMovement, CommandTypeMoveForward, CommandTypeMoveBackward,
 		CommandTypeMoveTo, CommandTypeCargoOpen, CommandTypeCargoClose,
-		CommandTypeCargoLift, CommandTypeCargoLower, CommandTypeCargoCheckQR,
-		CommandTypeScanLocation, CommandTypeWait:
+		CommandTypeCargoLift, CommandTypeCargoLower, CommandTypeCargoCheckQR, CommandTypeCargoHome,
+		CommandTypeScanLocation, CommandTypeWait, CommandTypeMission, CommandTypeDeliver,
+		CommandTypeBatterySetCharge, CommandTypeBatterySetDischarge:
 		return nil
 	}
 	return fmt.Errorf("invalid command type: %s", c)
 }
 
+// IsComposite returns true if the command runs its steps through the other executors,
+// see config.IsCompositeCommandType.
+func (c CommandType) IsComposite() bool {
+	return config.IsCompositeCommandType(c.String())
+}
+
 const (
 	CommandTypeStopMovement CommandType = "STOP_MOVEMENT"
 	CommandTypeMoveForward  CommandType = "MOVE_FORWARD"
@@ -37,9 +46,16 @@ const (
 	CommandTypeCargoLift    CommandType = "CARGO_LIFT"
 	CommandTypeCargoLower   CommandType = "CARGO_LOWER"
 	CommandTypeCargoCheckQR CommandType = "CARGO_CHECK_QR"
+	CommandTypeCargoHome    CommandType = "CARGO_HOME"
 
 	CommandTypeScanLocation CommandType = "SCAN_LOCATION"
 	CommandTypeWait         CommandType = "WAIT"
+
+	CommandTypeMission CommandType = "MISSION"
+	CommandTypeDeliver CommandType = "DELIVER"
+
+	CommandTypeBatterySetCharge    CommandType = "BATTERY_SET_CHARGE"
+	CommandTypeBatterySetDischarge CommandType = "BATTERY_SET_DISCHARGE"
 )
 
 type Source string
@@ -50,15 +66,18 @@ func (s Source) String() string {
 
 func (s Source) Validate() error {
 	switch s {
-	case SourceApp, SourceCloud:
+	case SourceApp, SourceCloud, SourceScheduler, SourceBatteryPolicy:
 		return nil
 	}
 	return fmt.Errorf("invalid source: %s", s)
 }
 
 const (
-	SourceApp   Source = "APP"
-	SourceCloud Source = "CLOUD"
+	SourceApp       Source = "APP"
+	SourceCloud     Source = "CLOUD"
+	SourceScheduler Source = "SCHEDULER"
+	// SourceBatteryPolicy is the source of the commands sending the robot to the charger on a critical battery.
+	SourceBatteryPolicy Source = "BATTERY_POLICY"
 )
 
 type Status string
@@ -84,6 +103,82 @@ const (
 	StatusCanceled   Status = "CANCELED"
 )
 
+// QueueMove is where a queued command is moved to in the queue.
+type QueueMove string
+
+func (m QueueMove) Validate() error {
+	switch m {
+	case QueueMoveFront, QueueMoveBack:
+		return nil
+	}
+	return fmt.Errorf("invalid queue move: %s", m)
+}
+
+func (m QueueMove) String() string {
+	return string(m)
+}
+
+const (
+	// QueueMoveFront moves the command before all other queued commands.
+	QueueMoveFront QueueMove = "FRONT"
+	// QueueMoveBack moves the command after all other queued commands.
+	QueueMoveBack QueueMove = "BACK"
+)
+
+// QueueState is the persistent state of the command queue.
+type QueueState struct {
+	// Paused is true if no new command is started from the queue, except the commands
+	// of the battery policy. The command being processed when the queue is paused still completes.
+	Paused bool
+	// PausedBy is the source that paused the queue.
+	PausedBy *Source
+	// Reason is the reason the queue was paused.
+	Reason    *string
+	PausedAt  *time.Time
+	UpdatedAt time.Time
+}
+
+// QueuePosition is the position of a queued command in the queue.
+type QueuePosition struct {
+	CommandID int64
+	// Position is the 1-based position of the command, 1 is the next command to be executed.
+	Position uint
+	// QueueLength is the number of queued commands.
+	QueueLength uint
+}
+
+// ProgressPhase is the step a running command is in.
+type ProgressPhase string
+
+const (
+	ProgressPhaseMoving     ProgressPhase = "MOVING"
+	ProgressPhaseCorrecting ProgressPhase = "CORRECTING"
+	ProgressPhaseLifting    ProgressPhase = "LIFTING"
+	ProgressPhaseLowering   ProgressPhase = "LOWERING"
+	ProgressPhaseWaiting    ProgressPhase = "WAITING"
+)
+
+func (p ProgressPhase) String() string {
+	return string(p)
+}
+
+// Progress is the live progress of the running command, reported by its executor.
+// The steps of a mission report to the mission.
+type Progress struct {
+	Phase ProgressPhase
+	// Percentage is the completion from 0 to 100, nil if it can not be computed.
+	Percentage *uint8
+	Message    string
+	UpdatedAt  time.Time
+}
+
+// CommandProgress is the progress of the running command.
+type CommandProgress struct {
+	CommandID   int64
+	CommandType CommandType
+	Progress    Progress
+}
+
 type Command struct {
 	ID          int64
 	Type        CommandType
@@ -97,9 +192,81 @@ type Command struct {
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 	RequestID   *string
+	Priority    int64
+
+	// Timeout is the maximum execution time of the command,
+	// it overrides the timeout of the command type in the command config.
+	Timeout *time.Duration
+	// Deadline is the time by which the command must be completed.
+	Deadline *time.Time
+
+	// RetryPolicy overrides the retry policy of the command type in the command config.
+	RetryPolicy *config.RetryPolicy
+	// Attempts is the number of failed executions of the command.
+	// A suspended execution is not a failed one.
+	Attempts uint32
+	// AttemptErrors are the errors of the failed attempts.
+	AttemptErrors []AttemptError
+
+	// Recovery is the decision made for the command when the application
+	// started while the command was pending, nil if the command was not affected.
+	Recovery *Recovery
+}
+
+// AttemptError is the error of a failed attempt to execute a command.
+type AttemptError struct {
+	Attempt  uint32    `json:"attempt"`
+	Error    string    `json:"error"`
+	FailedAt time.Time `json:"failed_at"`
+}
+
+// RecoveryAction is what was done to a pending command on startup.
+type RecoveryAction string
+
+func (a RecoveryAction) String() string {
+	return string(a)
+}
+
+const (
+	// RecoveryActionCanceled means the command was canceled.
+	RecoveryActionCanceled RecoveryAction = "CANCELED"
+	// RecoveryActionKept means the command was kept in the queue.
+	RecoveryActionKept RecoveryAction = "KEPT"
+	// RecoveryActionRequeued means the interrupted command was put back into the queue.
+	RecoveryActionRequeued RecoveryAction = "REQUEUED"
+)
+
+// Recovery is the startup recovery decision recorded on a command.
+type Recovery struct {
+	Action RecoveryAction        `json:"action"`
+	Policy config.RecoveryPolicy `json:"policy"`
+	// PreviousStatus is the status of the command when the application started.
+	PreviousStatus Status    `json:"previous_status"`
+	RecoveredAt    time.Time `json:"recovered_at"`
 }
 
-func NewCommand(source Source, inputs Inputs, requestID *string) Command {
+// ExecutionDeadline returns the time by which the command must be completed
+// when it starts at startedAt. The earliest of the deadline and the timeout applies,
+// the timeout falls back to defaultTimeout. It returns false if the command has neither.
+func (c Command) ExecutionDeadline(startedAt time.Time, defaultTimeout time.Duration) (time.Time, bool) {
+	timeout := defaultTimeout
+	if c.Timeout != nil {
+		timeout = *c.Timeout
+	}
+
+	var deadline time.Time
+	if timeout > 0 {
+		deadline = startedAt.Add(timeout)
+	}
+
+	if c.Deadline != nil && (deadline.IsZero() || c.Deadline.Before(deadline)) {
+		deadline = *c.Deadline
+	}
+
+	return deadline, !deadline.IsZero()
+}
+
+func NewCommand(source Source, inputs Inputs, priority int64, requestID *string) Command {
 	var reqID string
 	if requestID == nil {
 		reqID = uuid.NewString()
@@ -111,6 +278,7 @@ func NewCommand(source Source, inputs Inputs, requestID *string) Command {
 		Status:    StatusQueued,
 		Source:    source,
 		Inputs:    inputs,
+		Priority:  priority,
 		CreatedAt: now,
 		UpdatedAt: now,
 		RequestID: &reqID,
@@ -121,12 +289,15 @@ func NewCommand(source Source, inputs Inputs, requestID *string) Command {
 type CancelableCommand struct {
 	Command
 
+	// Progress is empty until the executor reports it.
+	Progress Progress
+
 	ctx        context.Context
-	cancelFunc context.CancelFunc
+	cancelFunc context.CancelCauseFunc
 }
 
 func NewCancelableCommand(ctx context.Context, cmd Command) CancelableCommand {
-	ctx, cancel := context.WithCancel(ctx)
+	ctx, cancel := context.WithCancelCause(ctx)
 	return CancelableCommand{
 		Command:    cmd,
 		ctx:        ctx,
@@ -137,7 +308,16 @@ func NewCancelableCommand(ctx context.Context, cmd Command) CancelableCommand {
 func (c *CancelableCommand) Cancel() {
 	c.Status = StatusCanceling
 	c.UpdatedAt = time.Now()
-	c.cancelFunc()
+	c.cancelFunc(nil)
+}
+
+// Suspend stops the command and puts it back into the queue.
+// The executor observes ErrCommandSuspended as the cancel cause
+// and re-queues the command instead of marking it as canceled.
+func (c *CancelableCommand) Suspend() {
+	c.Status = StatusCanceling
+	c.UpdatedAt = time.Now()
+	c.cancelFunc(ErrCommandSuspended)
 }
 
 func (c *CancelableCommand) CanBeCanceled() bool {
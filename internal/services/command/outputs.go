@@ -16,8 +16,13 @@ var (
 	_ Outputs = (*CargoLiftOutputs)(nil)
 	_ Outputs = (*CargoLowerOutputs)(nil)
 	_ Outputs = (*CargoCheckQROutputs)(nil)
+	_ Outputs = (*CargoHomeOutputs)(nil)
 	_ Outputs = (*ScanLocationOutputs)(nil)
 	_ Outputs = (*WaitOutputs)(nil)
+	_ Outputs = (*MissionOutputs)(nil)
+	_ Outputs = (*DeliverOutputs)(nil)
+	_ Outputs = (*BatterySetChargeOutputs)(nil)
+	_ Outputs = (*BatterySetDischargeOutputs)(nil)
 )
 
 type Outputs interface {
@@ -32,27 +37,65 @@ func (StopMovementOutputs) CommandType() CommandType {
 }
 func (StopMovementOutputs) isOutputs() {}
 
-type MoveForwardOutputs struct{}
+type MoveForwardOutputs struct {
+	MotionOutputs
+}
 
 func (MoveForwardOutputs) CommandType() CommandType {
 	return CommandTypeMoveForward
 }
 func (MoveForwardOutputs) isOutputs() {}
 
-type MoveBackwardOutputs struct{}
+type MoveBackwardOutputs struct {
+	MotionOutputs
+}
 
 func (MoveBackwardOutputs) CommandType() CommandType {
 	return CommandTypeMoveBackward
 }
 func (MoveBackwardOutputs) isOutputs() {}
 
-type MoveToOutputs struct{}
+type MoveToOutputs struct {
+	MotionOutputs
+	// Corrections is the number of moves back to the target after overshooting it
+	Corrections uint8 `json:"corrections"`
+}
 
 func (MoveToOutputs) CommandType() CommandType {
 	return CommandTypeMoveTo
 }
 func (MoveToOutputs) isOutputs() {}
 
+// MotionOutputs is the travel record of a drive command.
+type MotionOutputs struct {
+	// StartLocation is the location before moving, empty if unknown
+	StartLocation string `json:"start_location"`
+	// FinalLocation is the location the robot stopped at, empty if it did not stop at a known location
+	FinalLocation string      `json:"final_location"`
+	TagsPassed    []PassedTag `json:"tags_passed"`
+	// TravelDuration is the time from starting to stopping, including the obstacle pauses
+	TravelDuration time.Duration `json:"travel_duration"`
+	// AverageMotorSpeed is the time-weighted average motor speed setpoint (0-100) while the motor was running,
+	// not a travel speed
+	AverageMotorSpeed float64         `json:"average_motor_speed"`
+	ObstaclePauses    []ObstaclePause `json:"obstacle_pauses"`
+}
+
+// PassedTag is a tag read while moving.
+type PassedTag struct {
+	Location string    `json:"location"`
+	PassedAt time.Time `json:"passed_at"`
+}
+
+// ObstaclePause is a pause of the drive motor caused by an obstacle in the direction of travel.
+// ResumedAt is nil if the command ended while paused.
+type ObstaclePause struct {
+	// Distance is the distance to the obstacle that caused the pause (cm)
+	Distance  uint16     `json:"distance"`
+	PausedAt  time.Time  `json:"paused_at"`
+	ResumedAt *time.Time `json:"resumed_at"`
+}
+
 type CargoOpenOutputs struct{}
 
 func (CargoOpenOutputs) CommandType() CommandType {
@@ -88,6 +131,17 @@ func (CargoCheckQROutputs) CommandType() CommandType {
 }
 func (CargoCheckQROutputs) isOutputs() {}
 
+type CargoHomeOutputs struct {
+	// ZeroOffset is the down distance read at the top limit switch.
+	ZeroOffset   uint16    `json:"zero_offset"`
+	CalibratedAt time.Time `json:"calibrated_at"`
+}
+
+func (CargoHomeOutputs) CommandType() CommandType {
+	return CommandTypeCargoHome
+}
+func (CargoHomeOutputs) isOutputs() {}
+
 type ScanLocationOutputs struct {
 	Locations []Location `json:"locations"`
 }
@@ -109,6 +163,91 @@ func (WaitOutputs) CommandType() CommandType {
 }
 func (WaitOutputs) isOutputs() {}
 
+type MissionOutputs struct {
+	Steps []MissionStepOutputs `json:"steps"`
+}
+
+func (MissionOutputs) CommandType() CommandType {
+	return CommandTypeMission
+}
+func (MissionOutputs) isOutputs() {}
+
+// MissionStepOutputs holds the execution result of a single mission step.
+// Outputs is nil if the step has not been executed.
+type MissionStepOutputs struct {
+	Type        CommandType `json:"type"`
+	Status      Status      `json:"status"`
+	Outputs     Outputs     `json:"outputs"`
+	Error       *string     `json:"error"`
+	StartedAt   *time.Time  `json:"started_at"`
+	CompletedAt *time.Time  `json:"completed_at"`
+}
+
+func (o *MissionStepOutputs) UnmarshalJSON(data []byte) error {
+	var temp struct {
+		Type        CommandType     `json:"type"`
+		Status      Status          `json:"status"`
+		Outputs     json.RawMessage `json:"outputs"`
+		Error       *string         `json:"error"`
+		StartedAt   *time.Time      `json:"started_at"`
+		CompletedAt *time.Time      `json:"completed_at"`
+	}
+	if err := json.Unmarshal(data, &temp); err != nil {
+		return fmt.Errorf("failed to unmarshal mission step outputs: %w", err)
+	}
+
+	o.Type = temp.Type
+	o.Status = temp.Status
+	o.Error = temp.Error
+	o.StartedAt = temp.StartedAt
+	o.CompletedAt = temp.CompletedAt
+	o.Outputs = nil
+
+	if len(temp.Outputs) > 0 && string(temp.Outputs) != "null" {
+		outputs, err := UnmarshalOutputs(temp.Type, temp.Outputs)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal mission step outputs: %w", err)
+		}
+		o.Outputs = outputs
+	}
+
+	return nil
+}
+
+// DeliverOutputs is the timeline of a delivery. Phases are the steps of the delivery,
+// Rollback are the steps run to close and lift the cargo after the delivery failed.
+type DeliverOutputs struct {
+	// Location is the location of the station.
+	Location string               `json:"location"`
+	Phases   []MissionStepOutputs `json:"phases"`
+	Rollback []MissionStepOutputs `json:"rollback"`
+}
+
+func (DeliverOutputs) CommandType() CommandType {
+	return CommandTypeDeliver
+}
+func (DeliverOutputs) isOutputs() {}
+
+type BatterySetChargeOutputs struct {
+	// ConfirmedAt is when the PIC reported the new setting in its sync state.
+	ConfirmedAt time.Time `json:"confirmed_at"`
+}
+
+func (BatterySetChargeOutputs) CommandType() CommandType {
+	return CommandTypeBatterySetCharge
+}
+func (BatterySetChargeOutputs) isOutputs() {}
+
+type BatterySetDischargeOutputs struct {
+	// ConfirmedAt is when the PIC reported the new setting in its sync state.
+	ConfirmedAt time.Time `json:"confirmed_at"`
+}
+
+func (BatterySetDischargeOutputs) CommandType() CommandType {
+	return CommandTypeBatterySetDischarge
+}
+func (BatterySetDischargeOutputs) isOutputs() {}
+
 func UnmarshalOutputs(cmdType CommandType, outputsBytes []byte) (Outputs, error) {
 	var outputs Outputs
 
@@ -117,13 +256,25 @@ func UnmarshalOutputs(cmdType CommandType, outputsBytes []byte) (Outputs, error)
 		outputs = &StopMovementOutputs{}
 
 	case CommandTypeMoveForward:
-		outputs = &MoveForwardOutputs{}
+		o := &MoveForwardOutputs{}
+		if err := json.Unmarshal(outputsBytes, o); err != nil {
+			return nil, err
+		}
+		outputs = o
 
 	case CommandTypeMoveBackward:
-		outputs = &MoveBackwardOutputs{}
+		o := &MoveBackwardOutputs{}
+		if err := json.Unmarshal(outputsBytes, o); err != nil {
+			return nil, err
+		}
+		outputs = o
 
 	case CommandTypeMoveTo:
-		outputs = &MoveToOutputs{}
+		o := &MoveToOutputs{}
+		if err := json.Unmarshal(outputsBytes, o); err != nil {
+			return nil, err
+		}
+		outputs = o
 
 	case CommandTypeCargoOpen:
 		outputs = &CargoOpenOutputs{}
@@ -140,6 +291,13 @@ func UnmarshalOutputs(cmdType CommandType, outputsBytes []byte) (Outputs, error)
 	case CommandTypeCargoCheckQR:
 		outputs = &CargoCheckQROutputs{}
 
+	case CommandTypeCargoHome:
+		o := &CargoHomeOutputs{}
+		if err := json.Unmarshal(outputsBytes, o); err != nil {
+			return nil, err
+		}
+		outputs = o
+
 	case CommandTypeScanLocation:
 		o := &ScanLocationOutputs{}
 		if err := json.Unmarshal(outputsBytes, o); err != nil {
@@ -150,6 +308,34 @@ func UnmarshalOutputs(cmdType CommandType, outputsBytes []byte) (Outputs, error)
 	case CommandTypeWait:
 		outputs = &WaitOutputs{}
 
+	case CommandTypeMission:
+		o := &MissionOutputs{}
+		if err := json.Unmarshal(outputsBytes, o); err != nil {
+			return nil, err
+		}
+		outputs = o
+
+	case CommandTypeDeliver:
+		o := &DeliverOutputs{}
+		if err := json.Unmarshal(outputsBytes, o); err != nil {
+			return nil, err
+		}
+		outputs = o
+
+	case CommandTypeBatterySetCharge:
+		o := &BatterySetChargeOutputs{}
+		if err := json.Unmarshal(outputsBytes, o); err != nil {
+			return nil, err
+		}
+		outputs = o
+
+	case CommandTypeBatterySetDischarge:
+		o := &BatterySetDischargeOutputs{}
+		if err := json.Unmarshal(outputsBytes, o); err != nil {
+			return nil, err
+		}
+		outputs = o
+
 	default:
 		return nil, fmt.Errorf("unknown command type: %s", cmdType)
 	}
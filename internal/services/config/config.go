@@ -4,6 +4,11 @@ import (
 	"context"
 
 	"github.com/tbe-team/raybot/internal/config"
+	"github.com/tbe-team/raybot/pkg/xerror"
+)
+
+var (
+	ErrChargerLocationNotInTrackMap = xerror.BadRequest(nil, "config.chargerLocationNotInTrackMap", "charger location is not in track map")
 )
 
 type Service interface {
@@ -23,5 +28,7 @@ type Service interface {
 	UpdateWifiConfig(ctx context.Context, wifiCfg config.Wifi) (config.Wifi, error)
 
 	GetCommandConfig(ctx context.Context) (config.Command, error)
+	// UpdateCommandConfig returns ErrChargerLocationNotInTrackMap if the return to the charger
+	// is enabled and the charger location is not in the track map.
 	UpdateCommandConfig(ctx context.Context, commandCfg config.Command) (config.Command, error)
 }
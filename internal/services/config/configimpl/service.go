@@ -10,6 +10,7 @@ import (
 
 	"github.com/tbe-team/raybot/internal/config"
 	configsvc "github.com/tbe-team/raybot/internal/services/config"
+	"github.com/tbe-team/raybot/internal/services/trackmap"
 	"github.com/tbe-team/raybot/internal/storage/file"
 	"github.com/tbe-team/raybot/pkg/xerror"
 )
@@ -18,13 +19,15 @@ type service struct {
 	cfg *config.Config
 	mu  sync.RWMutex
 
-	fileClient file.Client
+	fileClient         file.Client
+	trackMapRepository trackmap.Repository
 }
 
-func NewService(cfg *config.Config, fileClient file.Client) configsvc.Service {
+func NewService(cfg *config.Config, fileClient file.Client, trackMapRepository trackmap.Repository) configsvc.Service {
 	return &service{
-		cfg:        cfg,
-		fileClient: fileClient,
+		cfg:                cfg,
+		fileClient:         fileClient,
+		trackMapRepository: trackMapRepository,
 	}
 }
 
@@ -163,6 +166,9 @@ func (s *service) UpdateCommandConfig(ctx context.Context, commandCfg config.Com
 	if err := commandCfg.Validate(); err != nil {
 		return config.Command{}, xerror.ValidationFailed(err, "invalid command config")
 	}
+	if err := s.checkChargerLocation(ctx, commandCfg.LowBattery); err != nil {
+		return config.Command{}, err
+	}
 
 	cfg := *s.cfg
 	cfg.Command = commandCfg
@@ -178,6 +184,33 @@ func (s *service) UpdateCommandConfig(ctx context.Context, commandCfg config.Com
 	return commandCfg, nil
 }
 
+// checkChargerLocation returns an error if the return to the charger is enabled and the
+// charger location is not in the track map.
+func (s *service) checkChargerLocation(ctx context.Context, lowBattery config.LowBattery) error {
+	// The return to the charger is disabled.
+	if lowBattery.CriticalPercent == 0 {
+		return nil
+	}
+
+	// An unchanged charger location does not block the other command config updates.
+	s.mu.RLock()
+	cur := s.cfg.Command.LowBattery
+	s.mu.RUnlock()
+	if cur.CriticalPercent != 0 && cur.ChargerLocation == lowBattery.ChargerLocation {
+		return nil
+	}
+
+	trackMap, err := s.trackMapRepository.GetTrackMap(ctx)
+	if err != nil {
+		return fmt.Errorf("get track map: %w", err)
+	}
+	if trackMap.IndexOf(lowBattery.ChargerLocation) < 0 {
+		return fmt.Errorf("%w: %s", configsvc.ErrChargerLocationNotInTrackMap, lowBattery.ChargerLocation)
+	}
+
+	return nil
+}
+
 func (s *service) writeConfig(ctx context.Context, cfg config.Config) error {
 	writer, err := s.fileClient.Write(ctx, s.cfg.ConfigFilePath)
 	if err != nil {
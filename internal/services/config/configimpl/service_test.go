@@ -0,0 +1,62 @@
+package configimpl
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tbe-team/raybot/internal/config"
+	configsvc "github.com/tbe-team/raybot/internal/services/config"
+	"github.com/tbe-team/raybot/internal/services/trackmap"
+	trackmapmocks "github.com/tbe-team/raybot/internal/services/trackmap/mocks"
+	"github.com/tbe-team/raybot/internal/storage/file"
+)
+
+func TestService_UpdateCommandConfig(t *testing.T) {
+	lowBattery := config.LowBattery{
+		WarningPercent:     20,
+		CriticalPercent:    10,
+		ChargerLocation:    "C",
+		ChargeCurrentLimit: 500,
+	}
+
+	t.Run("Should reject a charger location not in the track map", func(t *testing.T) {
+		trackMapRepository := trackmapmocks.NewFakeRepository(t)
+		s := NewService(&config.Config{}, nil, trackMapRepository)
+
+		trackMapRepository.EXPECT().GetTrackMap(mock.Anything).Return(trackmap.TrackMap{
+			Tags: []trackmap.Tag{{Location: "A"}},
+		}, nil)
+
+		_, err := s.UpdateCommandConfig(context.Background(), config.Command{LowBattery: lowBattery})
+		require.ErrorIs(t, err, configsvc.ErrChargerLocationNotInTrackMap)
+	})
+
+	t.Run("Should update the config if the charger location is in the track map", func(t *testing.T) {
+		trackMapRepository := trackmapmocks.NewFakeRepository(t)
+		s := NewService(&config.Config{
+			ConfigFilePath: filepath.Join(t.TempDir(), "config.yml"),
+		}, file.NewLocalFileClient(), trackMapRepository)
+
+		trackMapRepository.EXPECT().GetTrackMap(mock.Anything).Return(trackmap.TrackMap{
+			Tags: []trackmap.Tag{{Location: "A"}, {Location: "C"}},
+		}, nil)
+
+		cfg, err := s.UpdateCommandConfig(context.Background(), config.Command{LowBattery: lowBattery})
+		require.NoError(t, err)
+		require.Equal(t, lowBattery.ChargerLocation, cfg.LowBattery.ChargerLocation)
+	})
+
+	t.Run("Should not check an unchanged charger location", func(t *testing.T) {
+		s := NewService(&config.Config{
+			ConfigFilePath: filepath.Join(t.TempDir(), "config.yml"),
+			Command:        config.Command{LowBattery: lowBattery},
+		}, file.NewLocalFileClient(), trackmapmocks.NewFakeRepository(t))
+
+		_, err := s.UpdateCommandConfig(context.Background(), config.Command{LowBattery: lowBattery})
+		require.NoError(t, err)
+	})
+}
@@ -5,7 +5,9 @@ import (
 
 	"github.com/tbe-team/raybot/internal/services/appstate"
 	"github.com/tbe-team/raybot/internal/services/battery"
+	"github.com/tbe-team/raybot/internal/services/batterypolicy"
 	"github.com/tbe-team/raybot/internal/services/cargo"
+	"github.com/tbe-team/raybot/internal/services/command"
 	"github.com/tbe-team/raybot/internal/services/distancesensor"
 	"github.com/tbe-team/raybot/internal/services/drivemotor"
 	"github.com/tbe-team/raybot/internal/services/liftmotor"
@@ -18,11 +20,14 @@ type RobotState struct {
 	BatteryDischarge battery.DischargeSetting
 	DistanceSensor   distancesensor.DistanceSensorState
 	LiftMotor        liftmotor.LiftMotorState
+	LiftCalibration  liftmotor.Calibration
 	DriveMotor       drivemotor.DriveMotorState
 	Location         location.Location
 	Cargo            cargo.Cargo
 	CargoDoorMotor   cargo.DoorMotorState
 	AppState         appstate.AppState
+	CommandQueue     command.QueueState
+	BatteryPolicy    batterypolicy.State
 }
 
 type Service interface {
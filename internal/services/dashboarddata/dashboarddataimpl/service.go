@@ -8,7 +8,9 @@ import (
 
 	"github.com/tbe-team/raybot/internal/services/appstate"
 	"github.com/tbe-team/raybot/internal/services/battery"
+	"github.com/tbe-team/raybot/internal/services/batterypolicy"
 	"github.com/tbe-team/raybot/internal/services/cargo"
+	"github.com/tbe-team/raybot/internal/services/command"
 	"github.com/tbe-team/raybot/internal/services/dashboarddata"
 	"github.com/tbe-team/raybot/internal/services/distancesensor"
 	"github.com/tbe-team/raybot/internal/services/drivemotor"
@@ -17,14 +19,17 @@ import (
 )
 
 type service struct {
-	batteryStateRepo   battery.BatteryStateRepository
-	batterySettingRepo battery.SettingRepository
-	distanceSensorRepo distancesensor.DistanceSensorStateRepository
-	liftMotorRepo      liftmotor.LiftMotorStateRepository
-	driveMotorRepo     drivemotor.DriveMotorStateRepository
-	locationRepo       location.Repository
-	cargoRepo          cargo.Repository
-	appStateRepo       appstate.Repository
+	batteryStateRepo    battery.BatteryStateRepository
+	batterySettingRepo  battery.SettingRepository
+	distanceSensorRepo  distancesensor.DistanceSensorStateRepository
+	liftMotorRepo       liftmotor.LiftMotorStateRepository
+	liftCalibrationRepo liftmotor.CalibrationRepository
+	driveMotorRepo      drivemotor.DriveMotorStateRepository
+	locationRepo        location.Repository
+	cargoRepo           cargo.Repository
+	appStateRepo        appstate.Repository
+	commandRepo         command.Repository
+	batteryPolicyRepo   batterypolicy.Repository
 }
 
 func NewService(
@@ -32,20 +37,26 @@ func NewService(
 	batterySettingRepo battery.SettingRepository,
 	distanceSensorRepo distancesensor.DistanceSensorStateRepository,
 	liftMotorRepo liftmotor.LiftMotorStateRepository,
+	liftCalibrationRepo liftmotor.CalibrationRepository,
 	driveMotorRepo drivemotor.DriveMotorStateRepository,
 	locationRepo location.Repository,
 	cargoRepo cargo.Repository,
 	appStateRepo appstate.Repository,
+	commandRepo command.Repository,
+	batteryPolicyRepo batterypolicy.Repository,
 ) dashboarddata.Service {
 	return &service{
-		batteryStateRepo:   batteryStateRepo,
-		batterySettingRepo: batterySettingRepo,
-		distanceSensorRepo: distanceSensorRepo,
-		liftMotorRepo:      liftMotorRepo,
-		driveMotorRepo:     driveMotorRepo,
-		locationRepo:       locationRepo,
-		cargoRepo:          cargoRepo,
-		appStateRepo:       appStateRepo,
+		batteryStateRepo:    batteryStateRepo,
+		batterySettingRepo:  batterySettingRepo,
+		distanceSensorRepo:  distanceSensorRepo,
+		liftMotorRepo:       liftMotorRepo,
+		liftCalibrationRepo: liftCalibrationRepo,
+		driveMotorRepo:      driveMotorRepo,
+		locationRepo:        locationRepo,
+		cargoRepo:           cargoRepo,
+		appStateRepo:        appStateRepo,
+		commandRepo:         commandRepo,
+		batteryPolicyRepo:   batteryPolicyRepo,
 	}
 }
 
@@ -83,6 +94,12 @@ func (s *service) GetRobotState(ctx context.Context) (dashboarddata.RobotState,
 		return err
 	})
 
+	g.Go(func() error {
+		var err error
+		ret.LiftCalibration, err = s.liftCalibrationRepo.GetCalibration(ctx)
+		return err
+	})
+
 	g.Go(func() error {
 		var err error
 		ret.DriveMotor, err = s.driveMotorRepo.GetDriveMotorState(ctx)
@@ -113,6 +130,18 @@ func (s *service) GetRobotState(ctx context.Context) (dashboarddata.RobotState,
 		return err
 	})
 
+	g.Go(func() error {
+		var err error
+		ret.CommandQueue, err = s.commandRepo.GetQueueState(ctx)
+		return err
+	})
+
+	g.Go(func() error {
+		var err error
+		ret.BatteryPolicy, err = s.batteryPolicyRepo.GetState(ctx)
+		return err
+	})
+
 	if err := g.Wait(); err != nil {
 		return dashboarddata.RobotState{}, fmt.Errorf("error group wait: %w", err)
 	}
@@ -25,6 +25,10 @@ type SetCargoPositionParams struct {
 	Position   uint16
 }
 
+type UpdateCalibrationParams struct {
+	ZeroOffset uint16
+}
+
 type Service interface {
 	// UpdateLiftMotorState updates the desired state of the lift motor.
 	// This does not directly interact with the hardware, it just updates the internal state.
@@ -36,6 +40,12 @@ type Service interface {
 
 	// Stop stops the cargo motor using hardware control.
 	Stop(ctx context.Context) error
+
+	// GetCalibration returns the persisted lift calibration.
+	GetCalibration(ctx context.Context) (Calibration, error)
+
+	// UpdateCalibration persists the zero offset found by homing the lift.
+	UpdateCalibration(ctx context.Context, params UpdateCalibrationParams) (Calibration, error)
 }
 
 //nolint:revive
@@ -43,3 +53,8 @@ type LiftMotorStateRepository interface {
 	GetLiftMotorState(ctx context.Context) (LiftMotorState, error)
 	UpdateLiftMotorState(ctx context.Context, params UpdateLiftMotorStateParams) error
 }
+
+type CalibrationRepository interface {
+	GetCalibration(ctx context.Context) (Calibration, error)
+	UpdateCalibration(ctx context.Context, calibration Calibration) (Calibration, error)
+}
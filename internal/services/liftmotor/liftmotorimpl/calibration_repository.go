@@ -0,0 +1,72 @@
+package liftmotorimpl
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/tbe-team/raybot/internal/services/liftmotor"
+	"github.com/tbe-team/raybot/internal/storage/db"
+	"github.com/tbe-team/raybot/internal/storage/db/sqlc"
+	"github.com/tbe-team/raybot/pkg/ptr"
+)
+
+type calibrationRepository struct {
+	db      db.DB
+	queries *sqlc.Queries
+}
+
+func NewCalibrationRepository(db db.DB, queries *sqlc.Queries) liftmotor.CalibrationRepository {
+	return &calibrationRepository{
+		db:      db,
+		queries: queries,
+	}
+}
+
+func (r calibrationRepository) GetCalibration(ctx context.Context) (liftmotor.Calibration, error) {
+	row, err := r.queries.LiftMotorCalibrationGet(ctx, r.db)
+	if err != nil {
+		return liftmotor.Calibration{}, fmt.Errorf("failed to get lift motor calibration: %w", err)
+	}
+	return r.convertRowToCalibration(row)
+}
+
+func (r calibrationRepository) UpdateCalibration(ctx context.Context, calibration liftmotor.Calibration) (liftmotor.Calibration, error) {
+	var calibratedAt *string
+	if calibration.CalibratedAt != nil {
+		calibratedAt = ptr.New(calibration.CalibratedAt.Format(time.RFC3339Nano))
+	}
+
+	row, err := r.queries.LiftMotorCalibrationUpdate(ctx, r.db, sqlc.LiftMotorCalibrationUpdateParams{
+		ZeroOffset:   int64(calibration.ZeroOffset),
+		CalibratedAt: calibratedAt,
+		UpdatedAt:    calibration.UpdatedAt.Format(time.RFC3339Nano),
+	})
+	if err != nil {
+		return liftmotor.Calibration{}, fmt.Errorf("failed to update lift motor calibration: %w", err)
+	}
+	return r.convertRowToCalibration(row)
+}
+
+func (calibrationRepository) convertRowToCalibration(row sqlc.LiftMotorCalibration) (liftmotor.Calibration, error) {
+	updatedAt, err := time.Parse(time.RFC3339Nano, row.UpdatedAt)
+	if err != nil {
+		return liftmotor.Calibration{}, fmt.Errorf("failed to parse updated at: %w", err)
+	}
+
+	//nolint:gosec
+	ret := liftmotor.Calibration{
+		ZeroOffset: uint16(row.ZeroOffset),
+		UpdatedAt:  updatedAt,
+	}
+
+	if row.CalibratedAt != nil {
+		calibratedAt, err := time.Parse(time.RFC3339Nano, *row.CalibratedAt)
+		if err != nil {
+			return liftmotor.Calibration{}, fmt.Errorf("failed to parse calibrated at: %w", err)
+		}
+		ret.CalibratedAt = &calibratedAt
+	}
+
+	return ret, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/tbe-team/raybot/internal/hardware/controller"
 	"github.com/tbe-team/raybot/internal/hardware/picserial"
@@ -19,17 +20,20 @@ type service struct {
 	validator validator.Validator
 
 	liftMotorStateRepo  liftmotor.LiftMotorStateRepository
+	calibrationRepo     liftmotor.CalibrationRepository
 	liftMotorController controller.LiftMotorController
 }
 
 func NewService(
 	validator validator.Validator,
 	liftMotorStateRepo liftmotor.LiftMotorStateRepository,
+	calibrationRepo liftmotor.CalibrationRepository,
 	liftMotorController controller.LiftMotorController,
 ) liftmotor.Service {
 	return &service{
 		validator:           validator,
 		liftMotorStateRepo:  liftMotorStateRepo,
+		calibrationRepo:     calibrationRepo,
 		liftMotorController: liftMotorController,
 	}
 }
@@ -67,3 +71,20 @@ func (s *service) Stop(ctx context.Context) error {
 
 	return nil
 }
+
+func (s *service) GetCalibration(ctx context.Context) (liftmotor.Calibration, error) {
+	return s.calibrationRepo.GetCalibration(ctx)
+}
+
+func (s *service) UpdateCalibration(ctx context.Context, params liftmotor.UpdateCalibrationParams) (liftmotor.Calibration, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return liftmotor.Calibration{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	now := time.Now()
+	return s.calibrationRepo.UpdateCalibration(ctx, liftmotor.Calibration{
+		ZeroOffset:   params.ZeroOffset,
+		CalibratedAt: &now,
+		UpdatedAt:    now,
+	})
+}
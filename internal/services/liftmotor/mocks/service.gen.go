@@ -5,8 +5,9 @@ package mocks
 import (
 	context "context"
 
-	mock "github.com/stretchr/testify/mock"
 	liftmotor "github.com/tbe-team/raybot/internal/services/liftmotor"
+
+	mock "github.com/stretchr/testify/mock"
 )
 
 // FakeService is an autogenerated mock type for the Service type
@@ -22,6 +23,62 @@ func (_m *FakeService) EXPECT() *FakeService_Expecter {
 	return &FakeService_Expecter{mock: &_m.Mock}
 }
 
+// GetCalibration provides a mock function with given fields: ctx
+func (_m *FakeService) GetCalibration(ctx context.Context) (liftmotor.Calibration, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCalibration")
+	}
+
+	var r0 liftmotor.Calibration
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (liftmotor.Calibration, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) liftmotor.Calibration); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(liftmotor.Calibration)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeService_GetCalibration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCalibration'
+type FakeService_GetCalibration_Call struct {
+	*mock.Call
+}
+
+// GetCalibration is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *FakeService_Expecter) GetCalibration(ctx interface{}) *FakeService_GetCalibration_Call {
+	return &FakeService_GetCalibration_Call{Call: _e.mock.On("GetCalibration", ctx)}
+}
+
+func (_c *FakeService_GetCalibration_Call) Run(run func(ctx context.Context)) *FakeService_GetCalibration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *FakeService_GetCalibration_Call) Return(_a0 liftmotor.Calibration, _a1 error) *FakeService_GetCalibration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeService_GetCalibration_Call) RunAndReturn(run func(context.Context) (liftmotor.Calibration, error)) *FakeService_GetCalibration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetCargoPosition provides a mock function with given fields: ctx, params
 func (_m *FakeService) SetCargoPosition(ctx context.Context, params liftmotor.SetCargoPositionParams) error {
 	ret := _m.Called(ctx, params)
@@ -115,6 +172,63 @@ func (_c *FakeService_Stop_Call) RunAndReturn(run func(context.Context) error) *
 	return _c
 }
 
+// UpdateCalibration provides a mock function with given fields: ctx, params
+func (_m *FakeService) UpdateCalibration(ctx context.Context, params liftmotor.UpdateCalibrationParams) (liftmotor.Calibration, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCalibration")
+	}
+
+	var r0 liftmotor.Calibration
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, liftmotor.UpdateCalibrationParams) (liftmotor.Calibration, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, liftmotor.UpdateCalibrationParams) liftmotor.Calibration); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(liftmotor.Calibration)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, liftmotor.UpdateCalibrationParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FakeService_UpdateCalibration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCalibration'
+type FakeService_UpdateCalibration_Call struct {
+	*mock.Call
+}
+
+// UpdateCalibration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params liftmotor.UpdateCalibrationParams
+func (_e *FakeService_Expecter) UpdateCalibration(ctx interface{}, params interface{}) *FakeService_UpdateCalibration_Call {
+	return &FakeService_UpdateCalibration_Call{Call: _e.mock.On("UpdateCalibration", ctx, params)}
+}
+
+func (_c *FakeService_UpdateCalibration_Call) Run(run func(ctx context.Context, params liftmotor.UpdateCalibrationParams)) *FakeService_UpdateCalibration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(liftmotor.UpdateCalibrationParams))
+	})
+	return _c
+}
+
+func (_c *FakeService_UpdateCalibration_Call) Return(_a0 liftmotor.Calibration, _a1 error) *FakeService_UpdateCalibration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FakeService_UpdateCalibration_Call) RunAndReturn(run func(context.Context, liftmotor.UpdateCalibrationParams) (liftmotor.Calibration, error)) *FakeService_UpdateCalibration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateLiftMotorState provides a mock function with given fields: ctx, params
 func (_m *FakeService) UpdateLiftMotorState(ctx context.Context, params liftmotor.UpdateLiftMotorStateParams) error {
 	ret := _m.Called(ctx, params)
@@ -1,6 +1,9 @@
 package liftmotor
 
-import "time"
+import (
+	"math"
+	"time"
+)
 
 //nolint:revive
 type LiftMotorState struct {
@@ -10,3 +13,25 @@ type LiftMotorState struct {
 	Enabled         bool
 	UpdatedAt       time.Time
 }
+
+// Calibration is the lift position zero found by homing the lift to the top limit switch.
+// The positions of the lift commands are relative to ZeroOffset.
+type Calibration struct {
+	// ZeroOffset is the down distance read when the lift is at the top limit switch.
+	ZeroOffset   uint16
+	CalibratedAt *time.Time
+	UpdatedAt    time.Time
+}
+
+// Calibrated reports whether the lift was homed at least once.
+func (c Calibration) Calibrated() bool {
+	return c.CalibratedAt != nil
+}
+
+// RawPosition returns the down distance of a position relative to the zero offset.
+func (c Calibration) RawPosition(position uint16) uint16 {
+	if uint32(position)+uint32(c.ZeroOffset) > math.MaxUint16 {
+		return math.MaxUint16
+	}
+	return position + c.ZeroOffset
+}
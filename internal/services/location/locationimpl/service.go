@@ -29,6 +29,10 @@ func NewService(
 	}
 }
 
+func (s *service) GetLocation(ctx context.Context) (location.Location, error) {
+	return s.locationRepo.GetLocation(ctx)
+}
+
 func (s *service) UpdateLocation(ctx context.Context, params location.UpdateLocationParams) error {
 	if err := s.validator.Validate(params); err != nil {
 		return fmt.Errorf("validate params: %w", err)
@@ -0,0 +1,47 @@
+package schedule
+
+import (
+	"time"
+
+	"github.com/tbe-team/raybot/internal/services/command"
+)
+
+// Schedule enqueues a command at a specific time (RunAt)
+// or on a recurring cron schedule (CronExpr).
+type Schedule struct {
+	ID       int64
+	Name     string
+	Inputs   command.Inputs
+	Priority int64
+
+	// RunAt is set for a one-time schedule.
+	RunAt *time.Time
+	// CronExpr is set for a recurring schedule.
+	CronExpr *string
+
+	Enabled bool
+
+	// NextRunAt is nil when the schedule is disabled
+	// or a one-time schedule has already run.
+	NextRunAt     *time.Time
+	LastRunAt     *time.Time
+	LastCommandID *int64
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// CommandType returns the type of the command created by the schedule.
+func (s Schedule) CommandType() command.CommandType {
+	return s.Inputs.CommandType()
+}
+
+// IsRecurring returns true if the schedule runs on a cron schedule.
+func (s Schedule) IsRecurring() bool {
+	return s.CronExpr != nil
+}
+
+// IsDue returns true if the schedule should create its command at the given time.
+func (s Schedule) IsDue(now time.Time) bool {
+	return s.Enabled && s.NextRunAt != nil && !s.NextRunAt.After(now)
+}
@@ -0,0 +1,80 @@
+package schedule
+
+import (
+	"context"
+	"time"
+
+	"github.com/tbe-team/raybot/internal/services/command"
+	"github.com/tbe-team/raybot/pkg/paging"
+	"github.com/tbe-team/raybot/pkg/xerror"
+)
+
+var (
+	ErrScheduleNotFound      = xerror.NotFound(nil, "schedule.notFound", "schedule not found")
+	ErrInvalidCronExpression = xerror.BadRequest(nil, "schedule.invalidCronExpression", "invalid cron expression")
+	ErrRunAtInThePast        = xerror.BadRequest(nil, "schedule.runAtInThePast", "run at must be in the future")
+)
+
+type GetScheduleByIDParams struct {
+	ScheduleID int64 `validate:"required,min=1"`
+}
+
+type ListSchedulesParams struct {
+	PagingParams paging.Params `validate:"required"`
+}
+
+// CreateScheduleParams creates a schedule.
+// Exactly one of RunAt and CronExpr must be set.
+type CreateScheduleParams struct {
+	Name     string         `validate:"required,max=100"`
+	Inputs   command.Inputs `validate:"required"`
+	Priority int64          `validate:"min=0,max=100"`
+	RunAt    *time.Time     `validate:"required_without=CronExpr,excluded_with=CronExpr"`
+	CronExpr *string        `validate:"required_without=RunAt,excluded_with=RunAt"`
+	Enabled  bool
+}
+
+// UpdateScheduleParams replaces a schedule.
+// Exactly one of RunAt and CronExpr must be set.
+type UpdateScheduleParams struct {
+	ScheduleID int64          `validate:"required,min=1"`
+	Name       string         `validate:"required,max=100"`
+	Inputs     command.Inputs `validate:"required"`
+	Priority   int64          `validate:"min=0,max=100"`
+	RunAt      *time.Time     `validate:"required_without=CronExpr,excluded_with=CronExpr"`
+	CronExpr   *string        `validate:"required_without=RunAt,excluded_with=RunAt"`
+	Enabled    bool
+}
+
+type DeleteScheduleByIDParams struct {
+	ScheduleID int64 `validate:"required,min=1"`
+}
+
+type ListNextRunTimesParams struct {
+	ScheduleID int64 `validate:"required,min=1"`
+	Count      uint  `validate:"required,min=1,max=100"`
+}
+
+type Service interface {
+	GetScheduleByID(ctx context.Context, params GetScheduleByIDParams) (Schedule, error)
+	ListSchedules(ctx context.Context, params ListSchedulesParams) (paging.List[Schedule], error)
+	CreateSchedule(ctx context.Context, params CreateScheduleParams) (Schedule, error)
+	UpdateSchedule(ctx context.Context, params UpdateScheduleParams) (Schedule, error)
+	DeleteScheduleByID(ctx context.Context, params DeleteScheduleByIDParams) error
+
+	// ListNextRunTimes returns the upcoming run times of a schedule.
+	// A disabled schedule or a one-time schedule that has already run has no run times.
+	ListNextRunTimes(ctx context.Context, params ListNextRunTimesParams) ([]time.Time, error)
+
+	// RunDueSchedules creates a command for every enabled schedule whose next run time has passed.
+	RunDueSchedules(ctx context.Context) error
+}
+
+type Repository interface {
+	ListSchedules(ctx context.Context, params ListSchedulesParams) (paging.List[Schedule], error)
+	ListEnabledSchedules(ctx context.Context) ([]Schedule, error)
+	GetScheduleByID(ctx context.Context, id int64) (Schedule, error)
+	CreateSchedule(ctx context.Context, schedule Schedule) (Schedule, error)
+	UpdateSchedule(ctx context.Context, schedule Schedule) (Schedule, error)
+	DeleteScheduleByID(ctx context.Context, id int64) error
+}
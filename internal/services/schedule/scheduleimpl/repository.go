@@ -0,0 +1,236 @@
+package scheduleimpl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/tbe-team/raybot/internal/services/command"
+	"github.com/tbe-team/raybot/internal/services/schedule"
+	"github.com/tbe-team/raybot/internal/storage/db"
+	"github.com/tbe-team/raybot/internal/storage/db/sqlc"
+	"github.com/tbe-team/raybot/pkg/paging"
+	"github.com/tbe-team/raybot/pkg/ptr"
+)
+
+type repository struct {
+	db      db.DB
+	queries *sqlc.Queries
+}
+
+func NewScheduleRepository(db db.DB, queries *sqlc.Queries) schedule.Repository {
+	return &repository{
+		db:      db,
+		queries: queries,
+	}
+}
+
+func (r repository) ListSchedules(ctx context.Context, params schedule.ListSchedulesParams) (paging.List[schedule.Schedule], error) {
+	var ret paging.List[schedule.Schedule]
+	g, ctx := errgroup.WithContext(ctx)
+
+	g.Go(func() error {
+		//nolint:gosec
+		rows, err := r.queries.ScheduleList(ctx, r.db, sqlc.ScheduleListParams{
+			Offset: int64(params.PagingParams.Offset()),
+			Limit:  int64(params.PagingParams.Limit()),
+		})
+		if err != nil {
+			return fmt.Errorf("queries list schedules: %w", err)
+		}
+
+		for _, row := range rows {
+			s, err := r.convertRowToSchedule(row)
+			if err != nil {
+				return fmt.Errorf("convert row to schedule: %w", err)
+			}
+			ret.Items = append(ret.Items, s)
+		}
+
+		return nil
+	})
+
+	g.Go(func() error {
+		count, err := r.queries.ScheduleCount(ctx, r.db)
+		if err != nil {
+			return fmt.Errorf("queries count schedules: %w", err)
+		}
+		ret.TotalItems = count
+		return nil
+	})
+
+	if err := g.Wait(); err != nil {
+		return paging.List[schedule.Schedule]{}, fmt.Errorf("errgroup wait: %w", err)
+	}
+
+	return ret, nil
+}
+
+func (r repository) ListEnabledSchedules(ctx context.Context) ([]schedule.Schedule, error) {
+	rows, err := r.queries.ScheduleListEnabled(ctx, r.db)
+	if err != nil {
+		return nil, fmt.Errorf("queries list enabled schedules: %w", err)
+	}
+
+	ret := make([]schedule.Schedule, 0, len(rows))
+	for _, row := range rows {
+		s, err := r.convertRowToSchedule(row)
+		if err != nil {
+			return nil, fmt.Errorf("convert row to schedule: %w", err)
+		}
+		ret = append(ret, s)
+	}
+
+	return ret, nil
+}
+
+func (r repository) GetScheduleByID(ctx context.Context, id int64) (schedule.Schedule, error) {
+	row, err := r.queries.ScheduleGetByID(ctx, r.db, id)
+	if err != nil {
+		if db.IsNoRowsError(err) {
+			return schedule.Schedule{}, schedule.ErrScheduleNotFound
+		}
+		return schedule.Schedule{}, fmt.Errorf("failed to get schedule by id: %w", err)
+	}
+	return r.convertRowToSchedule(row)
+}
+
+func (r repository) CreateSchedule(ctx context.Context, s schedule.Schedule) (schedule.Schedule, error) {
+	inputsBytes, err := json.Marshal(s.Inputs)
+	if err != nil {
+		return schedule.Schedule{}, fmt.Errorf("failed to marshal inputs: %w", err)
+	}
+
+	row, err := r.queries.ScheduleCreate(ctx, r.db, sqlc.ScheduleCreateParams{
+		Name:          s.Name,
+		CommandType:   s.CommandType().String(),
+		Inputs:        string(inputsBytes),
+		Priority:      s.Priority,
+		RunAt:         formatTime(s.RunAt),
+		CronExpr:      s.CronExpr,
+		Enabled:       boolToInt64(s.Enabled),
+		NextRunAt:     formatTime(s.NextRunAt),
+		LastRunAt:     formatTime(s.LastRunAt),
+		LastCommandID: s.LastCommandID,
+		CreatedAt:     s.CreatedAt.Format(time.RFC3339Nano),
+		UpdatedAt:     s.UpdatedAt.Format(time.RFC3339Nano),
+	})
+	if err != nil {
+		return schedule.Schedule{}, fmt.Errorf("queries create schedule: %w", err)
+	}
+
+	return r.convertRowToSchedule(row)
+}
+
+func (r repository) UpdateSchedule(ctx context.Context, s schedule.Schedule) (schedule.Schedule, error) {
+	inputsBytes, err := json.Marshal(s.Inputs)
+	if err != nil {
+		return schedule.Schedule{}, fmt.Errorf("failed to marshal inputs: %w", err)
+	}
+
+	row, err := r.queries.ScheduleUpdate(ctx, r.db, sqlc.ScheduleUpdateParams{
+		ID:            s.ID,
+		Name:          s.Name,
+		CommandType:   s.CommandType().String(),
+		Inputs:        string(inputsBytes),
+		Priority:      s.Priority,
+		RunAt:         formatTime(s.RunAt),
+		CronExpr:      s.CronExpr,
+		Enabled:       boolToInt64(s.Enabled),
+		NextRunAt:     formatTime(s.NextRunAt),
+		LastRunAt:     formatTime(s.LastRunAt),
+		LastCommandID: s.LastCommandID,
+		UpdatedAt:     s.UpdatedAt.Format(time.RFC3339Nano),
+	})
+	if err != nil {
+		if db.IsNoRowsError(err) {
+			return schedule.Schedule{}, schedule.ErrScheduleNotFound
+		}
+		return schedule.Schedule{}, fmt.Errorf("queries update schedule: %w", err)
+	}
+
+	return r.convertRowToSchedule(row)
+}
+
+func (r repository) DeleteScheduleByID(ctx context.Context, id int64) error {
+	affected, err := r.queries.ScheduleDeleteByID(ctx, r.db, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete schedule by id: %w", err)
+	}
+	if affected == 0 {
+		return schedule.ErrScheduleNotFound
+	}
+	return nil
+}
+
+func (repository) convertRowToSchedule(row sqlc.Schedule) (schedule.Schedule, error) {
+	ret := schedule.Schedule{
+		ID:            row.ID,
+		Name:          row.Name,
+		Priority:      row.Priority,
+		CronExpr:      row.CronExpr,
+		Enabled:       row.Enabled == 1,
+		LastCommandID: row.LastCommandID,
+	}
+	var err error
+
+	ret.Inputs, err = command.UnmarshalInputs(command.CommandType(row.CommandType), []byte(row.Inputs))
+	if err != nil {
+		return schedule.Schedule{}, fmt.Errorf("failed to unmarshal inputs: %w", err)
+	}
+
+	ret.RunAt, err = parseTime(row.RunAt)
+	if err != nil {
+		return schedule.Schedule{}, fmt.Errorf("failed to parse run at: %w", err)
+	}
+
+	ret.NextRunAt, err = parseTime(row.NextRunAt)
+	if err != nil {
+		return schedule.Schedule{}, fmt.Errorf("failed to parse next run at: %w", err)
+	}
+
+	ret.LastRunAt, err = parseTime(row.LastRunAt)
+	if err != nil {
+		return schedule.Schedule{}, fmt.Errorf("failed to parse last run at: %w", err)
+	}
+
+	ret.CreatedAt, err = time.Parse(time.RFC3339Nano, row.CreatedAt)
+	if err != nil {
+		return schedule.Schedule{}, fmt.Errorf("failed to parse created at: %w", err)
+	}
+
+	ret.UpdatedAt, err = time.Parse(time.RFC3339Nano, row.UpdatedAt)
+	if err != nil {
+		return schedule.Schedule{}, fmt.Errorf("failed to parse updated at: %w", err)
+	}
+
+	return ret, nil
+}
+
+func formatTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	return ptr.New(t.Format(time.RFC3339Nano))
+}
+
+func parseTime(s *string) (*time.Time, error) {
+	if s == nil {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, *s)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func boolToInt64(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,267 @@
+package scheduleimpl
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	"github.com/tbe-team/raybot/internal/services/command"
+	"github.com/tbe-team/raybot/internal/services/schedule"
+	"github.com/tbe-team/raybot/pkg/paging"
+	"github.com/tbe-team/raybot/pkg/ptr"
+	"github.com/tbe-team/raybot/pkg/validator"
+)
+
+type service struct {
+	log       *slog.Logger
+	validator validator.Validator
+
+	scheduleRepository schedule.Repository
+	commandService     command.Service
+}
+
+func NewService(
+	log *slog.Logger,
+	validator validator.Validator,
+	scheduleRepository schedule.Repository,
+	commandService command.Service,
+) schedule.Service {
+	return &service{
+		log:                log.With("service", "schedule"),
+		validator:          validator,
+		scheduleRepository: scheduleRepository,
+		commandService:     commandService,
+	}
+}
+
+func (s *service) GetScheduleByID(ctx context.Context, params schedule.GetScheduleByIDParams) (schedule.Schedule, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return schedule.Schedule{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	return s.scheduleRepository.GetScheduleByID(ctx, params.ScheduleID)
+}
+
+func (s *service) ListSchedules(ctx context.Context, params schedule.ListSchedulesParams) (paging.List[schedule.Schedule], error) {
+	if err := s.validator.Validate(params); err != nil {
+		return paging.List[schedule.Schedule]{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	return s.scheduleRepository.ListSchedules(ctx, params)
+}
+
+func (s *service) CreateSchedule(ctx context.Context, params schedule.CreateScheduleParams) (schedule.Schedule, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return schedule.Schedule{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	now := time.Now()
+	sch := schedule.Schedule{
+		Name:      params.Name,
+		Inputs:    params.Inputs,
+		Priority:  params.Priority,
+		RunAt:     params.RunAt,
+		CronExpr:  params.CronExpr,
+		Enabled:   params.Enabled,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if sch.RunAt != nil && sch.RunAt.Before(now) {
+		return schedule.Schedule{}, schedule.ErrRunAtInThePast
+	}
+
+	nextRunAt, err := computeNextRunAt(sch, now)
+	if err != nil {
+		return schedule.Schedule{}, err
+	}
+	sch.NextRunAt = nextRunAt
+
+	sch, err = s.scheduleRepository.CreateSchedule(ctx, sch)
+	if err != nil {
+		return schedule.Schedule{}, fmt.Errorf("create schedule: %w", err)
+	}
+
+	return sch, nil
+}
+
+func (s *service) UpdateSchedule(ctx context.Context, params schedule.UpdateScheduleParams) (schedule.Schedule, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return schedule.Schedule{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	sch, err := s.scheduleRepository.GetScheduleByID(ctx, params.ScheduleID)
+	if err != nil {
+		return schedule.Schedule{}, fmt.Errorf("get schedule by id: %w", err)
+	}
+
+	now := time.Now()
+	if params.RunAt != nil && params.RunAt.Before(now) {
+		return schedule.Schedule{}, schedule.ErrRunAtInThePast
+	}
+
+	sch.Name = params.Name
+	sch.Inputs = params.Inputs
+	sch.Priority = params.Priority
+	sch.RunAt = params.RunAt
+	sch.CronExpr = params.CronExpr
+	sch.Enabled = params.Enabled
+	sch.UpdatedAt = now
+
+	nextRunAt, err := computeNextRunAt(sch, now)
+	if err != nil {
+		return schedule.Schedule{}, err
+	}
+	sch.NextRunAt = nextRunAt
+
+	sch, err = s.scheduleRepository.UpdateSchedule(ctx, sch)
+	if err != nil {
+		return schedule.Schedule{}, fmt.Errorf("update schedule: %w", err)
+	}
+
+	return sch, nil
+}
+
+func (s *service) DeleteScheduleByID(ctx context.Context, params schedule.DeleteScheduleByIDParams) error {
+	if err := s.validator.Validate(params); err != nil {
+		return fmt.Errorf("validate params: %w", err)
+	}
+
+	return s.scheduleRepository.DeleteScheduleByID(ctx, params.ScheduleID)
+}
+
+func (s *service) ListNextRunTimes(ctx context.Context, params schedule.ListNextRunTimesParams) ([]time.Time, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return nil, fmt.Errorf("validate params: %w", err)
+	}
+
+	sch, err := s.scheduleRepository.GetScheduleByID(ctx, params.ScheduleID)
+	if err != nil {
+		return nil, fmt.Errorf("get schedule by id: %w", err)
+	}
+
+	ret := []time.Time{}
+	if sch.NextRunAt == nil {
+		return ret, nil
+	}
+
+	ret = append(ret, *sch.NextRunAt)
+	if !sch.IsRecurring() {
+		return ret, nil
+	}
+
+	cronSchedule, err := parseCronExpr(*sch.CronExpr)
+	if err != nil {
+		return nil, err
+	}
+
+	next := *sch.NextRunAt
+	for uint(len(ret)) < params.Count {
+		next = cronSchedule.Next(next)
+		ret = append(ret, next)
+	}
+
+	return ret, nil
+}
+
+func (s *service) RunDueSchedules(ctx context.Context) error {
+	schedules, err := s.scheduleRepository.ListEnabledSchedules(ctx)
+	if err != nil {
+		return fmt.Errorf("list enabled schedules: %w", err)
+	}
+
+	now := time.Now()
+	for _, sch := range schedules {
+		if !sch.IsDue(now) {
+			continue
+		}
+
+		if err := s.runSchedule(ctx, sch, now); err != nil {
+			s.log.Error("failed to run schedule",
+				slog.Int64("schedule_id", sch.ID),
+				slog.Any("error", err))
+		}
+	}
+
+	return nil
+}
+
+// runSchedule creates the command of the schedule and moves the schedule to its next run time.
+// The next run time is computed from now, so runs missed while the robot was off are skipped.
+func (s *service) runSchedule(ctx context.Context, sch schedule.Schedule, now time.Time) error {
+	cmd, err := s.commandService.CreateCommand(ctx, command.CreateCommandParams{
+		Source:   command.SourceScheduler,
+		Inputs:   sch.Inputs,
+		Priority: sch.Priority,
+	})
+	if err != nil {
+		// The schedule is still moved forward, otherwise it would be retried on every tick.
+		s.log.Error("failed to create scheduled command",
+			slog.Int64("schedule_id", sch.ID),
+			slog.Any("error", err))
+	} else {
+		s.log.Info("scheduled command created",
+			slog.Int64("schedule_id", sch.ID),
+			slog.Int64("command_id", cmd.ID),
+			slog.String("command_type", cmd.Type.String()))
+		sch.LastCommandID = ptr.New(cmd.ID)
+	}
+
+	sch.LastRunAt = ptr.New(now)
+	sch.UpdatedAt = now
+	sch.NextRunAt, err = computeNextRunAt(sch, now)
+	if err != nil {
+		return fmt.Errorf("compute next run at: %w", err)
+	}
+
+	if _, err := s.scheduleRepository.UpdateSchedule(ctx, sch); err != nil {
+		return fmt.Errorf("update schedule: %w", err)
+	}
+
+	return nil
+}
+
+// computeNextRunAt returns the first run time of the schedule after the given time.
+// It returns nil if the schedule is disabled or a one-time schedule has already run.
+// The cron expression is checked even if the schedule is disabled.
+func computeNextRunAt(sch schedule.Schedule, after time.Time) (*time.Time, error) {
+	var cronSchedule cron.Schedule
+	if sch.IsRecurring() {
+		var err error
+		cronSchedule, err = parseCronExpr(*sch.CronExpr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !sch.Enabled {
+		return nil, nil
+	}
+
+	if cronSchedule != nil {
+		return ptr.New(cronSchedule.Next(after)), nil
+	}
+
+	if sch.RunAt == nil {
+		return nil, nil
+	}
+
+	if sch.LastRunAt != nil && !sch.RunAt.After(*sch.LastRunAt) {
+		return nil, nil
+	}
+
+	return sch.RunAt, nil
+}
+
+// parseCronExpr parses a standard 5-field cron expression.
+// Descriptors like "@daily" and "@every 1h" are also supported.
+func parseCronExpr(expr string) (cron.Schedule, error) {
+	cronSchedule, err := cron.ParseStandard(expr)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", schedule.ErrInvalidCronExpression, err.Error())
+	}
+	return cronSchedule, nil
+}
@@ -0,0 +1,298 @@
+package scheduleimpl
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tbe-team/raybot/internal/logging"
+	"github.com/tbe-team/raybot/internal/services/command"
+	commandmocks "github.com/tbe-team/raybot/internal/services/command/mocks"
+	"github.com/tbe-team/raybot/internal/services/schedule"
+	schedulemocks "github.com/tbe-team/raybot/internal/services/schedule/mocks"
+	"github.com/tbe-team/raybot/pkg/ptr"
+	"github.com/tbe-team/raybot/pkg/validator"
+)
+
+func TestService_CreateSchedule(t *testing.T) {
+	t.Run("Should compute next run at from cron expression", func(t *testing.T) {
+		scheduleRepository := schedulemocks.NewFakeRepository(t)
+		s := NewService(logging.NewNoopLogger(), validator.New(), scheduleRepository, nil)
+
+		scheduleRepository.EXPECT().CreateSchedule(mock.Anything,
+			mock.MatchedBy(func(sch schedule.Schedule) bool {
+				return sch.NextRunAt != nil &&
+					sch.NextRunAt.After(time.Now()) &&
+					sch.NextRunAt.Minute() == 0 &&
+					sch.NextRunAt.Hour() == 8
+			}),
+		).RunAndReturn(func(_ context.Context, sch schedule.Schedule) (schedule.Schedule, error) {
+			return sch, nil
+		})
+
+		_, err := s.CreateSchedule(context.Background(), schedule.CreateScheduleParams{
+			Name:     "morning",
+			Inputs:   &command.StopMovementInputs{},
+			CronExpr: ptr.New("0 8 * * *"),
+			Enabled:  true,
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("Should not set next run at if schedule is disabled", func(t *testing.T) {
+		scheduleRepository := schedulemocks.NewFakeRepository(t)
+		s := NewService(logging.NewNoopLogger(), validator.New(), scheduleRepository, nil)
+
+		scheduleRepository.EXPECT().CreateSchedule(mock.Anything,
+			mock.MatchedBy(func(sch schedule.Schedule) bool {
+				return sch.NextRunAt == nil
+			}),
+		).Return(schedule.Schedule{}, nil)
+
+		_, err := s.CreateSchedule(context.Background(), schedule.CreateScheduleParams{
+			Name:    "once",
+			Inputs:  &command.StopMovementInputs{},
+			RunAt:   ptr.New(time.Now().Add(time.Hour)),
+			Enabled: false,
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("Should return error if cron expression is invalid", func(t *testing.T) {
+		s := NewService(logging.NewNoopLogger(), validator.New(), nil, nil)
+
+		_, err := s.CreateSchedule(context.Background(), schedule.CreateScheduleParams{
+			Name:     "invalid",
+			Inputs:   &command.StopMovementInputs{},
+			CronExpr: ptr.New("every morning"),
+			Enabled:  true,
+		})
+		require.ErrorIs(t, err, schedule.ErrInvalidCronExpression)
+	})
+
+	t.Run("Should return error if cron expression is invalid and schedule is disabled", func(t *testing.T) {
+		s := NewService(logging.NewNoopLogger(), validator.New(), nil, nil)
+
+		_, err := s.CreateSchedule(context.Background(), schedule.CreateScheduleParams{
+			Name:     "invalid",
+			Inputs:   &command.StopMovementInputs{},
+			CronExpr: ptr.New("every morning"),
+			Enabled:  false,
+		})
+		require.ErrorIs(t, err, schedule.ErrInvalidCronExpression)
+	})
+
+	t.Run("Should return error if run at is in the past", func(t *testing.T) {
+		s := NewService(logging.NewNoopLogger(), validator.New(), nil, nil)
+
+		_, err := s.CreateSchedule(context.Background(), schedule.CreateScheduleParams{
+			Name:    "past",
+			Inputs:  &command.StopMovementInputs{},
+			RunAt:   ptr.New(time.Now().Add(-time.Hour)),
+			Enabled: true,
+		})
+		require.ErrorIs(t, err, schedule.ErrRunAtInThePast)
+	})
+
+	t.Run("Should return validation error if both run at and cron expression are set", func(t *testing.T) {
+		s := NewService(logging.NewNoopLogger(), validator.New(), nil, nil)
+
+		_, err := s.CreateSchedule(context.Background(), schedule.CreateScheduleParams{
+			Name:     "both",
+			Inputs:   &command.StopMovementInputs{},
+			RunAt:    ptr.New(time.Now().Add(time.Hour)),
+			CronExpr: ptr.New("0 8 * * *"),
+			Enabled:  true,
+		})
+		require.Error(t, err)
+	})
+}
+
+func TestService_UpdateSchedule(t *testing.T) {
+	t.Run("Should return error if cron expression is invalid and schedule is disabled", func(t *testing.T) {
+		scheduleRepository := schedulemocks.NewFakeRepository(t)
+		s := NewService(logging.NewNoopLogger(), validator.New(), scheduleRepository, nil)
+
+		scheduleRepository.EXPECT().GetScheduleByID(mock.Anything, int64(1)).Return(schedule.Schedule{
+			ID:       1,
+			Name:     "daily",
+			Inputs:   &command.StopMovementInputs{},
+			CronExpr: ptr.New("0 8 * * *"),
+			Enabled:  true,
+		}, nil)
+
+		_, err := s.UpdateSchedule(context.Background(), schedule.UpdateScheduleParams{
+			ScheduleID: 1,
+			Name:       "daily",
+			Inputs:     &command.StopMovementInputs{},
+			CronExpr:   ptr.New("every morning"),
+			Enabled:    false,
+		})
+		require.ErrorIs(t, err, schedule.ErrInvalidCronExpression)
+	})
+}
+
+func TestService_ListNextRunTimes(t *testing.T) {
+	t.Run("Should list run times of recurring schedule", func(t *testing.T) {
+		scheduleRepository := schedulemocks.NewFakeRepository(t)
+		s := NewService(logging.NewNoopLogger(), validator.New(), scheduleRepository, nil)
+
+		next := time.Date(2025, 1, 1, 8, 0, 0, 0, time.UTC)
+		scheduleRepository.EXPECT().GetScheduleByID(mock.Anything, int64(1)).Return(schedule.Schedule{
+			ID:        1,
+			CronExpr:  ptr.New("0 8 * * *"),
+			Enabled:   true,
+			NextRunAt: &next,
+		}, nil)
+
+		runTimes, err := s.ListNextRunTimes(context.Background(), schedule.ListNextRunTimesParams{
+			ScheduleID: 1,
+			Count:      3,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []time.Time{
+			next,
+			next.AddDate(0, 0, 1),
+			next.AddDate(0, 0, 2),
+		}, runTimes)
+	})
+
+	t.Run("Should list single run time of one-time schedule", func(t *testing.T) {
+		scheduleRepository := schedulemocks.NewFakeRepository(t)
+		s := NewService(logging.NewNoopLogger(), validator.New(), scheduleRepository, nil)
+
+		runAt := time.Now().Add(time.Hour)
+		scheduleRepository.EXPECT().GetScheduleByID(mock.Anything, int64(1)).Return(schedule.Schedule{
+			ID:        1,
+			RunAt:     &runAt,
+			Enabled:   true,
+			NextRunAt: &runAt,
+		}, nil)
+
+		runTimes, err := s.ListNextRunTimes(context.Background(), schedule.ListNextRunTimesParams{
+			ScheduleID: 1,
+			Count:      3,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []time.Time{runAt}, runTimes)
+	})
+
+	t.Run("Should list no run time if schedule will not run", func(t *testing.T) {
+		scheduleRepository := schedulemocks.NewFakeRepository(t)
+		s := NewService(logging.NewNoopLogger(), validator.New(), scheduleRepository, nil)
+
+		scheduleRepository.EXPECT().GetScheduleByID(mock.Anything, int64(1)).Return(schedule.Schedule{
+			ID:       1,
+			CronExpr: ptr.New("0 8 * * *"),
+			Enabled:  false,
+		}, nil)
+
+		runTimes, err := s.ListNextRunTimes(context.Background(), schedule.ListNextRunTimesParams{
+			ScheduleID: 1,
+			Count:      3,
+		})
+		require.NoError(t, err)
+		require.Empty(t, runTimes)
+	})
+}
+
+func TestService_RunDueSchedules(t *testing.T) {
+	t.Run("Should create commands for due schedules", func(t *testing.T) {
+		scheduleRepository := schedulemocks.NewFakeRepository(t)
+		commandService := commandmocks.NewFakeService(t)
+		s := NewService(logging.NewNoopLogger(), validator.New(), scheduleRepository, commandService)
+
+		past := time.Now().Add(-time.Second)
+		future := time.Now().Add(time.Hour)
+		scheduleRepository.EXPECT().ListEnabledSchedules(mock.Anything).Return([]schedule.Schedule{
+			{
+				ID:        1,
+				Inputs:    &command.StopMovementInputs{},
+				Priority:  10,
+				RunAt:     &past,
+				Enabled:   true,
+				NextRunAt: &past,
+			},
+			{
+				ID:        2,
+				Inputs:    &command.StopMovementInputs{},
+				CronExpr:  ptr.New("@every 1m"),
+				Enabled:   true,
+				NextRunAt: &past,
+			},
+			{
+				ID:        3,
+				Inputs:    &command.StopMovementInputs{},
+				CronExpr:  ptr.New("@every 1m"),
+				Enabled:   true,
+				NextRunAt: &future,
+			},
+		}, nil)
+
+		commandService.EXPECT().CreateCommand(mock.Anything,
+			mock.MatchedBy(func(params command.CreateCommandParams) bool {
+				return params.Source == command.SourceScheduler && params.Priority == 10
+			}),
+		).Return(command.Command{ID: 100}, nil).Once()
+		commandService.EXPECT().CreateCommand(mock.Anything,
+			mock.MatchedBy(func(params command.CreateCommandParams) bool {
+				return params.Source == command.SourceScheduler && params.Priority == 0
+			}),
+		).Return(command.Command{ID: 101}, nil).Once()
+
+		// one-time schedule will not run again
+		scheduleRepository.EXPECT().UpdateSchedule(mock.Anything,
+			mock.MatchedBy(func(sch schedule.Schedule) bool {
+				return sch.ID == 1 &&
+					sch.NextRunAt == nil &&
+					sch.LastRunAt != nil &&
+					*sch.LastCommandID == 100
+			}),
+		).Return(schedule.Schedule{}, nil).Once()
+		// recurring schedule moves to its next run time
+		scheduleRepository.EXPECT().UpdateSchedule(mock.Anything,
+			mock.MatchedBy(func(sch schedule.Schedule) bool {
+				return sch.ID == 2 &&
+					sch.NextRunAt != nil &&
+					sch.NextRunAt.After(time.Now()) &&
+					*sch.LastCommandID == 101
+			}),
+		).Return(schedule.Schedule{}, nil).Once()
+
+		err := s.RunDueSchedules(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("Should move schedule forward if creating command failed", func(t *testing.T) {
+		scheduleRepository := schedulemocks.NewFakeRepository(t)
+		commandService := commandmocks.NewFakeService(t)
+		s := NewService(logging.NewNoopLogger(), validator.New(), scheduleRepository, commandService)
+
+		past := time.Now().Add(-time.Second)
+		scheduleRepository.EXPECT().ListEnabledSchedules(mock.Anything).Return([]schedule.Schedule{
+			{
+				ID:        1,
+				Inputs:    &command.StopMovementInputs{},
+				CronExpr:  ptr.New("@every 1m"),
+				Enabled:   true,
+				NextRunAt: &past,
+			},
+		}, nil)
+		commandService.EXPECT().CreateCommand(mock.Anything, mock.Anything).
+			Return(command.Command{}, errors.New("create failed"))
+		scheduleRepository.EXPECT().UpdateSchedule(mock.Anything,
+			mock.MatchedBy(func(sch schedule.Schedule) bool {
+				return sch.LastCommandID == nil &&
+					sch.NextRunAt != nil &&
+					sch.NextRunAt.After(time.Now())
+			}),
+		).Return(schedule.Schedule{}, nil)
+
+		err := s.RunDueSchedules(context.Background())
+		require.NoError(t, err)
+	})
+}
@@ -11,4 +11,22 @@ type Info struct {
 	// Total memory in MB
 	TotalMemory uint64
 	Uptime      time.Duration
+	PICLink     LinkStats
+	ESPLink     LinkStats
+}
+
+// LinkStats are the command delivery statistics of a board serial link.
+type LinkStats struct {
+	CommandsSent  uint64
+	CommandsAcked uint64
+	// Commands not acknowledged after all the resends
+	CommandsLost uint64
+	Resends      uint64
+	// Measured from the first send of a command to its ACK
+	AvgACKLatency time.Duration
+	MaxACKLatency time.Duration
+	// Frames received from the board, only counted with the CRC16 framing
+	FramesReceived    uint64
+	FramesRejected    uint64
+	FrameSequenceGaps uint64
 }
@@ -8,6 +8,7 @@ import (
 	"os/exec"
 	"time"
 
+	"github.com/tbe-team/raybot/internal/hardware/controller"
 	"github.com/tbe-team/raybot/internal/services/command"
 	"github.com/tbe-team/raybot/internal/services/drivemotor"
 	"github.com/tbe-team/raybot/internal/services/liftmotor"
@@ -21,7 +22,8 @@ type service struct {
 	driveMotorService drivemotor.Service
 	liftMotorService  liftmotor.Service
 
-	systemInfoRepo system.Repository
+	linkStatsController controller.LinkStatsController
+	systemInfoRepo      system.Repository
 }
 
 func NewService(
@@ -29,14 +31,16 @@ func NewService(
 	commandService command.Service,
 	driveMotorService drivemotor.Service,
 	liftMotorService liftmotor.Service,
+	linkStatsController controller.LinkStatsController,
 	systemInfoRepo system.Repository,
 ) system.Service {
 	return &service{
-		log:               log,
-		commandService:    commandService,
-		driveMotorService: driveMotorService,
-		liftMotorService:  liftMotorService,
-		systemInfoRepo:    systemInfoRepo,
+		log:                 log,
+		commandService:      commandService,
+		driveMotorService:   driveMotorService,
+		liftMotorService:    liftMotorService,
+		linkStatsController: linkStatsController,
+		systemInfoRepo:      systemInfoRepo,
 	}
 }
 
@@ -73,5 +77,29 @@ func (s service) StopEmergency(ctx context.Context) error {
 }
 
 func (s service) GetInfo(ctx context.Context) (system.Info, error) {
-	return s.systemInfoRepo.GetInfo(ctx)
+	info, err := s.systemInfoRepo.GetInfo(ctx)
+	if err != nil {
+		return system.Info{}, fmt.Errorf("get system info: %w", err)
+	}
+
+	// The link stats are kept by the hardware controller, they are read on demand
+	// rather than collected, so they are always up to date.
+	info.PICLink = convertLinkStats(s.linkStatsController.GetPICLinkStats())
+	info.ESPLink = convertLinkStats(s.linkStatsController.GetESPLinkStats())
+
+	return info, nil
+}
+
+func convertLinkStats(stats controller.LinkStats) system.LinkStats {
+	return system.LinkStats{
+		CommandsSent:      stats.CommandsSent,
+		CommandsAcked:     stats.CommandsAcked,
+		CommandsLost:      stats.CommandsLost,
+		Resends:           stats.Resends,
+		AvgACKLatency:     stats.AvgACKLatency,
+		MaxACKLatency:     stats.MaxACKLatency,
+		FramesReceived:    stats.Frames.Received,
+		FramesRejected:    stats.Frames.Rejected,
+		FrameSequenceGaps: stats.Frames.SequenceGaps,
+	}
 }
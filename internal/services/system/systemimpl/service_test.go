@@ -3,13 +3,17 @@ package systemimpl
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/tbe-team/raybot/internal/hardware/controller"
+	"github.com/tbe-team/raybot/internal/hardware/serialframe"
 	"github.com/tbe-team/raybot/internal/logging"
 	commandmocks "github.com/tbe-team/raybot/internal/services/command/mocks"
 	drivemotormocks "github.com/tbe-team/raybot/internal/services/drivemotor/mocks"
 	liftmotormocks "github.com/tbe-team/raybot/internal/services/liftmotor/mocks"
+	"github.com/tbe-team/raybot/internal/services/system"
 )
 
 func TestService(t *testing.T) {
@@ -98,4 +102,56 @@ func TestService(t *testing.T) {
 			assert.Error(t, err)
 		})
 	})
+	t.Run("Get info", func(t *testing.T) {
+		t.Run("Should include the link stats of the boards", func(t *testing.T) {
+			repo := NewRepository()
+			err := repo.UpdateInfo(context.Background(), system.UpdateInfoParams{
+				LocalIP:    "192.168.1.2",
+				SetLocalIP: true,
+			})
+			assert.NoError(t, err)
+
+			service := service{
+				log: logging.NewNoopLogger(),
+				linkStatsController: fakeLinkStatsController{
+					pic: controller.LinkStats{
+						CommandsSent:  10,
+						CommandsAcked: 9,
+						CommandsLost:  1,
+						Resends:       3,
+						AvgACKLatency: 20 * time.Millisecond,
+						MaxACKLatency: 50 * time.Millisecond,
+						Frames:        serialframe.Stats{Received: 100, Rejected: 2, SequenceGaps: 1},
+					},
+					esp: controller.LinkStats{CommandsSent: 4, CommandsAcked: 4},
+				},
+				systemInfoRepo: repo,
+			}
+
+			info, err := service.GetInfo(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, "192.168.1.2", info.LocalIP)
+			assert.Equal(t, system.LinkStats{
+				CommandsSent:      10,
+				CommandsAcked:     9,
+				CommandsLost:      1,
+				Resends:           3,
+				AvgACKLatency:     20 * time.Millisecond,
+				MaxACKLatency:     50 * time.Millisecond,
+				FramesReceived:    100,
+				FramesRejected:    2,
+				FrameSequenceGaps: 1,
+			}, info.PICLink)
+			assert.Equal(t, system.LinkStats{CommandsSent: 4, CommandsAcked: 4}, info.ESPLink)
+		})
+	})
+}
+
+type fakeLinkStatsController struct {
+	pic controller.LinkStats
+	esp controller.LinkStats
 }
+
+func (f fakeLinkStatsController) GetPICLinkStats() controller.LinkStats { return f.pic }
+
+func (f fakeLinkStatsController) GetESPLinkStats() controller.LinkStats { return f.esp }
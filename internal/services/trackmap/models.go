@@ -0,0 +1,199 @@
+package trackmap
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Topology is the shape of the rail.
+type Topology string
+
+func (t Topology) Validate() error {
+	switch t {
+	case TopologyLoop, TopologyLinear:
+		return nil
+	}
+	return fmt.Errorf("invalid topology: %s", t)
+}
+
+func (t Topology) String() string {
+	return string(t)
+}
+
+const (
+	// TopologyLoop is a closed rail, the last tag is followed by the first tag.
+	TopologyLoop Topology = "LOOP"
+	// TopologyLinear is an open rail with two ends.
+	TopologyLinear Topology = "LINEAR"
+)
+
+// Direction is the travel direction along the track map.
+// FORWARD follows the order of the tags, BACKWARD the reverse order.
+type Direction string
+
+func (d Direction) String() string {
+	return string(d)
+}
+
+const (
+	DirectionForward  Direction = "FORWARD"
+	DirectionBackward Direction = "BACKWARD"
+)
+
+// Format is the file format of an exported track map.
+type Format string
+
+func (f Format) Validate() error {
+	switch f {
+	case FormatJSON, FormatYAML:
+		return nil
+	}
+	return fmt.Errorf("invalid format: %s", f)
+}
+
+func (f Format) String() string {
+	return string(f)
+}
+
+const (
+	FormatJSON Format = "JSON"
+	FormatYAML Format = "YAML"
+)
+
+// Tag is an RFID tag on the rail.
+type Tag struct {
+	Location string
+	// Name is the human-readable station name of the tag, empty if the tag is not a station.
+	Name string
+	// DistanceToNext is the length in cm of the segment to the next tag,
+	// 0 if unknown. It is ignored for the last tag of a linear track.
+	DistanceToNext uint32
+}
+
+// TrackMap is the rail layout, the tags are ordered
+// as they are passed when the robot moves forward.
+type TrackMap struct {
+	Topology  Topology
+	Tags      []Tag
+	UpdatedAt time.Time
+}
+
+// IndexOf returns the index of the tag with the location, -1 if the map has no such tag.
+func (m TrackMap) IndexOf(location string) int {
+	return slices.IndexFunc(m.Tags, func(t Tag) bool {
+		return t.Location == location
+	})
+}
+
+// IndexOfName returns the index of the tag with the station name, -1 if the map has no such station.
+func (m TrackMap) IndexOfName(name string) int {
+	if name == "" {
+		return -1
+	}
+	return slices.IndexFunc(m.Tags, func(t Tag) bool {
+		return t.Name == name
+	})
+}
+
+// ShortestDirection returns the direction with the shortest distance
+// when moving from one location to another. The tag count is used instead of
+// the distance if any segment length is unknown. On a loop a tie is resolved
+// in favour of FORWARD, moving to the same location also returns FORWARD.
+func (m TrackMap) ShortestDirection(from, to string) (Direction, error) {
+	if len(m.Tags) == 0 {
+		return "", ErrTrackMapEmpty
+	}
+
+	i := m.IndexOf(from)
+	if i < 0 {
+		return "", fmt.Errorf("%w: %s", ErrTagNotFound, from)
+	}
+	j := m.IndexOf(to)
+	if j < 0 {
+		return "", fmt.Errorf("%w: %s", ErrTagNotFound, to)
+	}
+
+	if m.Topology == TopologyLinear {
+		if j >= i {
+			return DirectionForward, nil
+		}
+		return DirectionBackward, nil
+	}
+
+	forward := m.distance(i, j)
+	backward := m.distance(j, i)
+	if forward <= backward {
+		return DirectionForward, nil
+	}
+	return DirectionBackward, nil
+}
+
+// TagBefore returns the tag that is passed right before reaching the location
+// when moving in the direction. It returns false if the location is not in the map
+// or is the first tag reached from the end of a linear track.
+func (m TrackMap) TagBefore(location string, direction Direction) (Tag, bool) {
+	i := m.IndexOf(location)
+	if i < 0 || len(m.Tags) < 2 {
+		return Tag{}, false
+	}
+
+	n := len(m.Tags)
+	j := i - 1
+	if direction == DirectionBackward {
+		j = i + 1
+	}
+
+	if j < 0 || j >= n {
+		if m.Topology == TopologyLinear {
+			return Tag{}, false
+		}
+		j = (j + n) % n
+	}
+
+	return m.Tags[j], true
+}
+
+// TagsBetween returns the number of tags passed when moving from one location
+// to another in the direction, counting the target but not the start.
+// It returns false if a location is not in the map or the target is behind
+// the start on a linear track.
+func (m TrackMap) TagsBetween(from, to string, direction Direction) (int, bool) {
+	i := m.IndexOf(from)
+	j := m.IndexOf(to)
+	if i < 0 || j < 0 {
+		return 0, false
+	}
+
+	steps := j - i
+	if direction == DirectionBackward {
+		steps = i - j
+	}
+
+	if steps < 0 {
+		if m.Topology == TopologyLinear {
+			return 0, false
+		}
+		steps += len(m.Tags)
+	}
+
+	return steps, true
+}
+
+// distance returns the length of the path moving forward from tag i to tag j on a loop.
+func (m TrackMap) distance(i, j int) uint64 {
+	knownLengths := !slices.ContainsFunc(m.Tags, func(t Tag) bool {
+		return t.DistanceToNext == 0
+	})
+
+	n := len(m.Tags)
+	var d uint64
+	for k := i; k != j; k = (k + 1) % n {
+		if knownLengths {
+			d += uint64(m.Tags[k].DistanceToNext)
+		} else {
+			d++
+		}
+	}
+	return d
+}
@@ -0,0 +1,121 @@
+package trackmap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrackMap_ShortestDirection(t *testing.T) {
+	tags := []Tag{{Location: "A"}, {Location: "B"}, {Location: "C"}, {Location: "D"}, {Location: "E"}}
+
+	tests := []struct {
+		name     string
+		topology Topology
+		from     string
+		to       string
+		want     Direction
+	}{
+		{name: "loop forward", topology: TopologyLoop, from: "A", to: "C", want: DirectionForward},
+		{name: "loop backward across the end", topology: TopologyLoop, from: "A", to: "E", want: DirectionBackward},
+		{name: "loop forward across the end", topology: TopologyLoop, from: "E", to: "B", want: DirectionForward},
+		{name: "loop backward", topology: TopologyLoop, from: "D", to: "B", want: DirectionBackward},
+		{name: "linear forward", topology: TopologyLinear, from: "A", to: "E", want: DirectionForward},
+		{name: "linear backward", topology: TopologyLinear, from: "E", to: "A", want: DirectionBackward},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			m := TrackMap{Topology: tc.topology, Tags: tags}
+			got, err := m.ShortestDirection(tc.from, tc.to)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+
+	t.Run("loop uses segment lengths when all are known", func(t *testing.T) {
+		m := TrackMap{
+			Topology: TopologyLoop,
+			Tags: []Tag{
+				{Location: "A", DistanceToNext: 1000},
+				{Location: "B", DistanceToNext: 100},
+				{Location: "C", DistanceToNext: 100},
+				{Location: "D", DistanceToNext: 100},
+			},
+		}
+		// 1 tag but 1000 cm forward, 3 tags but 300 cm backward.
+		got, err := m.ShortestDirection("A", "B")
+		require.NoError(t, err)
+		require.Equal(t, DirectionBackward, got)
+	})
+
+	t.Run("empty track map", func(t *testing.T) {
+		_, err := TrackMap{Topology: TopologyLoop}.ShortestDirection("A", "B")
+		require.ErrorIs(t, err, ErrTrackMapEmpty)
+	})
+
+	t.Run("unknown tag", func(t *testing.T) {
+		_, err := TrackMap{Topology: TopologyLoop, Tags: tags}.ShortestDirection("A", "Z")
+		require.ErrorIs(t, err, ErrTagNotFound)
+	})
+}
+
+func TestTrackMap_TagBefore(t *testing.T) {
+	tags := []Tag{{Location: "A"}, {Location: "B"}, {Location: "C"}}
+
+	tests := []struct {
+		name      string
+		topology  Topology
+		location  string
+		direction Direction
+		want      string
+		wantOK    bool
+	}{
+		{name: "forward", topology: TopologyLinear, location: "B", direction: DirectionForward, want: "A", wantOK: true},
+		{name: "backward", topology: TopologyLinear, location: "B", direction: DirectionBackward, want: "C", wantOK: true},
+		{name: "loop forward across the end", topology: TopologyLoop, location: "A", direction: DirectionForward, want: "C", wantOK: true},
+		{name: "loop backward across the end", topology: TopologyLoop, location: "C", direction: DirectionBackward, want: "A", wantOK: true},
+		{name: "linear first tag", topology: TopologyLinear, location: "A", direction: DirectionForward},
+		{name: "unknown tag", topology: TopologyLoop, location: "Z", direction: DirectionForward},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			m := TrackMap{Topology: tc.topology, Tags: tags}
+			got, ok := m.TagBefore(tc.location, tc.direction)
+			require.Equal(t, tc.wantOK, ok)
+			require.Equal(t, tc.want, got.Location)
+		})
+	}
+}
+
+func TestTrackMap_TagsBetween(t *testing.T) {
+	tags := []Tag{{Location: "A"}, {Location: "B"}, {Location: "C"}, {Location: "D"}}
+
+	tests := []struct {
+		name      string
+		topology  Topology
+		from      string
+		to        string
+		direction Direction
+		want      int
+		wantOK    bool
+	}{
+		{name: "forward", topology: TopologyLinear, from: "A", to: "C", direction: DirectionForward, want: 2, wantOK: true},
+		{name: "backward", topology: TopologyLinear, from: "D", to: "B", direction: DirectionBackward, want: 2, wantOK: true},
+		{name: "same location", topology: TopologyLinear, from: "B", to: "B", direction: DirectionForward, want: 0, wantOK: true},
+		{name: "loop forward across the end", topology: TopologyLoop, from: "C", to: "A", direction: DirectionForward, want: 2, wantOK: true},
+		{name: "loop backward across the end", topology: TopologyLoop, from: "B", to: "D", direction: DirectionBackward, want: 2, wantOK: true},
+		{name: "linear target behind", topology: TopologyLinear, from: "C", to: "A", direction: DirectionForward},
+		{name: "unknown tag", topology: TopologyLoop, from: "A", to: "Z", direction: DirectionForward},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			m := TrackMap{Topology: tc.topology, Tags: tags}
+			got, ok := m.TagsBetween(tc.from, tc.to, tc.direction)
+			require.Equal(t, tc.wantOK, ok)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package trackmap
+
+import (
+	"context"
+
+	"github.com/tbe-team/raybot/pkg/xerror"
+)
+
+var (
+	ErrTrackMapEmpty          = xerror.NotFound(nil, "trackMap.empty", "track map has no tags")
+	ErrTagNotFound            = xerror.NotFound(nil, "trackMap.tagNotFound", "tag not found in track map")
+	ErrDuplicateTag           = xerror.BadRequest(nil, "trackMap.duplicateTag", "duplicate tag in track map")
+	ErrDuplicateStationName   = xerror.BadRequest(nil, "trackMap.duplicateStationName", "duplicate station name in track map")
+	ErrInvalidTrackMapFile    = xerror.BadRequest(nil, "trackMap.invalidFile", "invalid track map file")
+	ErrInvalidScanCommand     = xerror.BadRequest(nil, "trackMap.invalidScanCommand", "command is not a succeeded SCAN_LOCATION command")
+	ErrScanCommandHasNoResult = xerror.BadRequest(nil, "trackMap.scanCommandHasNoResult", "SCAN_LOCATION command has no scanned locations")
+	ErrChargerTagRemoved      = xerror.BadRequest(nil, "trackMap.chargerTagRemoved", "charger location of the low battery config can not be removed from track map")
+)
+
+type TagParams struct {
+	Location string `validate:"required,max=255"`
+	Name     string `validate:"max=100"`
+	// DistanceToNext is the length in cm of the segment to the next tag, 0 if unknown.
+	DistanceToNext uint32
+}
+
+// UpdateTrackMapParams replaces the track map.
+// The tags are given in the order they are passed when the robot moves forward.
+type UpdateTrackMapParams struct {
+	Topology Topology    `validate:"enum"`
+	Tags     []TagParams `validate:"dive"`
+}
+
+type CreateTagParams struct {
+	Tag TagParams
+	// Position is the 0-based index the tag is inserted at, the tag is appended if nil.
+	Position *uint
+}
+
+type UpdateTagParams struct {
+	Location       string `validate:"required,max=255"`
+	Name           string `validate:"max=100"`
+	DistanceToNext uint32
+}
+
+type DeleteTagParams struct {
+	Location string `validate:"required,max=255"`
+}
+
+type ExportTrackMapParams struct {
+	Format Format `validate:"enum"`
+}
+
+type ImportTrackMapParams struct {
+	Format Format `validate:"enum"`
+	Data   []byte `validate:"required"`
+}
+
+// ImportFromScanLocationParams imports the track map from the locations scanned by a SCAN_LOCATION command.
+// The locations are taken in scan order, the scan is expected to be done moving forward.
+type ImportFromScanLocationParams struct {
+	CommandID int64    `validate:"required,min=1"`
+	Topology  Topology `validate:"enum"`
+}
+
+type Service interface {
+	GetTrackMap(ctx context.Context) (TrackMap, error)
+	UpdateTrackMap(ctx context.Context, params UpdateTrackMapParams) (TrackMap, error)
+	// DeleteTrackMap deletes all tags of the track map.
+	DeleteTrackMap(ctx context.Context) error
+
+	CreateTag(ctx context.Context, params CreateTagParams) (TrackMap, error)
+	UpdateTag(ctx context.Context, params UpdateTagParams) (TrackMap, error)
+	DeleteTag(ctx context.Context, params DeleteTagParams) (TrackMap, error)
+
+	ExportTrackMap(ctx context.Context, params ExportTrackMapParams) ([]byte, error)
+	ImportTrackMap(ctx context.Context, params ImportTrackMapParams) (TrackMap, error)
+	ImportFromScanLocation(ctx context.Context, params ImportFromScanLocationParams) (TrackMap, error)
+}
+
+type Repository interface {
+	GetTrackMap(ctx context.Context) (TrackMap, error)
+	// UpdateTrackMap replaces the topology and all tags of the track map.
+	UpdateTrackMap(ctx context.Context, trackMap TrackMap) (TrackMap, error)
+}
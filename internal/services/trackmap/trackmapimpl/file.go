@@ -0,0 +1,76 @@
+package trackmapimpl
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/tbe-team/raybot/internal/services/trackmap"
+)
+
+// trackMapFile is the exported representation of a track map.
+type trackMapFile struct {
+	Topology string    `json:"topology" yaml:"topology"`
+	Tags     []tagFile `json:"tags" yaml:"tags"`
+}
+
+type tagFile struct {
+	Location       string `json:"location" yaml:"location"`
+	Name           string `json:"name,omitempty" yaml:"name,omitempty"`
+	DistanceToNext uint32 `json:"distance_to_next" yaml:"distance_to_next"`
+}
+
+func encodeFile(trackMap trackmap.TrackMap, format trackmap.Format) ([]byte, error) {
+	f := trackMapFile{
+		Topology: trackMap.Topology.String(),
+		Tags:     make([]tagFile, 0, len(trackMap.Tags)),
+	}
+	for _, tag := range trackMap.Tags {
+		f.Tags = append(f.Tags, tagFile{
+			Location:       tag.Location,
+			Name:           tag.Name,
+			DistanceToNext: tag.DistanceToNext,
+		})
+	}
+
+	switch format {
+	case trackmap.FormatJSON:
+		return json.MarshalIndent(f, "", "  ")
+	case trackmap.FormatYAML:
+		return yaml.Marshal(f)
+	default:
+		return nil, fmt.Errorf("unsupported format: %s", format)
+	}
+}
+
+func decodeFile(data []byte, format trackmap.Format) (trackmap.UpdateTrackMapParams, error) {
+	var f trackMapFile
+	var err error
+	switch format {
+	case trackmap.FormatJSON:
+		err = json.Unmarshal(data, &f)
+	case trackmap.FormatYAML:
+		err = yaml.Unmarshal(data, &f)
+	default:
+		return trackmap.UpdateTrackMapParams{}, fmt.Errorf("unsupported format: %s", format)
+	}
+	if err != nil {
+		return trackmap.UpdateTrackMapParams{}, fmt.Errorf("%w: %s", trackmap.ErrInvalidTrackMapFile, err.Error())
+	}
+
+	params := trackmap.UpdateTrackMapParams{
+		Topology: trackmap.Topology(strings.ToUpper(f.Topology)),
+		Tags:     make([]trackmap.TagParams, 0, len(f.Tags)),
+	}
+	for _, tag := range f.Tags {
+		params.Tags = append(params.Tags, trackmap.TagParams{
+			Location:       tag.Location,
+			Name:           tag.Name,
+			DistanceToNext: tag.DistanceToNext,
+		})
+	}
+
+	return params, nil
+}
@@ -0,0 +1,101 @@
+package trackmapimpl
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/tbe-team/raybot/internal/services/trackmap"
+	"github.com/tbe-team/raybot/internal/storage/db"
+	"github.com/tbe-team/raybot/internal/storage/db/sqlc"
+)
+
+type repository struct {
+	db      db.Provider
+	queries *sqlc.Queries
+}
+
+func NewTrackMapRepository(db db.Provider, queries *sqlc.Queries) trackmap.Repository {
+	return &repository{
+		db:      db,
+		queries: queries,
+	}
+}
+
+func (r repository) GetTrackMap(ctx context.Context) (trackmap.TrackMap, error) {
+	row, err := r.queries.TrackMapGet(ctx, r.db)
+	if err != nil {
+		return trackmap.TrackMap{}, fmt.Errorf("queries get track map: %w", err)
+	}
+
+	tagRows, err := r.queries.TrackMapListTags(ctx, r.db)
+	if err != nil {
+		return trackmap.TrackMap{}, fmt.Errorf("queries list track map tags: %w", err)
+	}
+
+	return r.convertRowsToTrackMap(row, tagRows)
+}
+
+func (r repository) UpdateTrackMap(ctx context.Context, trackMap trackmap.TrackMap) (trackmap.TrackMap, error) {
+	var ret trackmap.TrackMap
+	err := r.db.WithTX(ctx, func(tx db.DB) error {
+		row, err := r.queries.TrackMapUpdate(ctx, tx, sqlc.TrackMapUpdateParams{
+			Topology:  trackMap.Topology.String(),
+			UpdatedAt: trackMap.UpdatedAt.Format(time.RFC3339Nano),
+		})
+		if err != nil {
+			return fmt.Errorf("queries update track map: %w", err)
+		}
+
+		if err := r.queries.TrackMapDeleteTags(ctx, tx); err != nil {
+			return fmt.Errorf("queries delete track map tags: %w", err)
+		}
+
+		for i, tag := range trackMap.Tags {
+			if err := r.queries.TrackMapCreateTag(ctx, tx, sqlc.TrackMapCreateTagParams{
+				Position:       int64(i),
+				Location:       tag.Location,
+				Name:           tag.Name,
+				DistanceToNext: int64(tag.DistanceToNext),
+			}); err != nil {
+				return fmt.Errorf("queries create track map tag: %w", err)
+			}
+		}
+
+		tagRows, err := r.queries.TrackMapListTags(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("queries list track map tags: %w", err)
+		}
+
+		ret, err = r.convertRowsToTrackMap(row, tagRows)
+		return err
+	})
+	if err != nil {
+		return trackmap.TrackMap{}, fmt.Errorf("with tx: %w", err)
+	}
+
+	return ret, nil
+}
+
+func (repository) convertRowsToTrackMap(row sqlc.TrackMap, tagRows []sqlc.TrackMapTag) (trackmap.TrackMap, error) {
+	updatedAt, err := time.Parse(time.RFC3339Nano, row.UpdatedAt)
+	if err != nil {
+		return trackmap.TrackMap{}, fmt.Errorf("failed to parse updated at: %w", err)
+	}
+
+	tags := make([]trackmap.Tag, 0, len(tagRows))
+	for _, tagRow := range tagRows {
+		//nolint:gosec
+		tags = append(tags, trackmap.Tag{
+			Location:       tagRow.Location,
+			Name:           tagRow.Name,
+			DistanceToNext: uint32(tagRow.DistanceToNext),
+		})
+	}
+
+	return trackmap.TrackMap{
+		Topology:  trackmap.Topology(row.Topology),
+		Tags:      tags,
+		UpdatedAt: updatedAt,
+	}, nil
+}
@@ -0,0 +1,248 @@
+package trackmapimpl
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/tbe-team/raybot/internal/services/command"
+	configservice "github.com/tbe-team/raybot/internal/services/config"
+	"github.com/tbe-team/raybot/internal/services/trackmap"
+	"github.com/tbe-team/raybot/pkg/validator"
+)
+
+type service struct {
+	validator validator.Validator
+
+	configService      configservice.Service
+	trackMapRepository trackmap.Repository
+	commandRepository  command.Repository
+}
+
+func NewService(
+	validator validator.Validator,
+	configService configservice.Service,
+	trackMapRepository trackmap.Repository,
+	commandRepository command.Repository,
+) trackmap.Service {
+	return &service{
+		validator:          validator,
+		configService:      configService,
+		trackMapRepository: trackMapRepository,
+		commandRepository:  commandRepository,
+	}
+}
+
+func (s *service) GetTrackMap(ctx context.Context) (trackmap.TrackMap, error) {
+	return s.trackMapRepository.GetTrackMap(ctx)
+}
+
+func (s *service) UpdateTrackMap(ctx context.Context, params trackmap.UpdateTrackMapParams) (trackmap.TrackMap, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return trackmap.TrackMap{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	tags := make([]trackmap.Tag, 0, len(params.Tags))
+	for _, tag := range params.Tags {
+		tags = append(tags, trackmap.Tag{
+			Location:       tag.Location,
+			Name:           tag.Name,
+			DistanceToNext: tag.DistanceToNext,
+		})
+	}
+
+	return s.saveTrackMap(ctx, params.Topology, tags)
+}
+
+func (s *service) DeleteTrackMap(ctx context.Context) error {
+	trackMap, err := s.trackMapRepository.GetTrackMap(ctx)
+	if err != nil {
+		return fmt.Errorf("get track map: %w", err)
+	}
+
+	if _, err := s.saveTrackMap(ctx, trackMap.Topology, nil); err != nil {
+		return fmt.Errorf("save track map: %w", err)
+	}
+
+	return nil
+}
+
+func (s *service) CreateTag(ctx context.Context, params trackmap.CreateTagParams) (trackmap.TrackMap, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return trackmap.TrackMap{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	trackMap, err := s.trackMapRepository.GetTrackMap(ctx)
+	if err != nil {
+		return trackmap.TrackMap{}, fmt.Errorf("get track map: %w", err)
+	}
+
+	pos := len(trackMap.Tags)
+	if params.Position != nil && int(*params.Position) < pos {
+		pos = int(*params.Position)
+	}
+
+	tags := slices.Insert(slices.Clone(trackMap.Tags), pos, trackmap.Tag{
+		Location:       params.Tag.Location,
+		Name:           params.Tag.Name,
+		DistanceToNext: params.Tag.DistanceToNext,
+	})
+
+	return s.saveTrackMap(ctx, trackMap.Topology, tags)
+}
+
+func (s *service) UpdateTag(ctx context.Context, params trackmap.UpdateTagParams) (trackmap.TrackMap, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return trackmap.TrackMap{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	trackMap, err := s.trackMapRepository.GetTrackMap(ctx)
+	if err != nil {
+		return trackmap.TrackMap{}, fmt.Errorf("get track map: %w", err)
+	}
+
+	i := trackMap.IndexOf(params.Location)
+	if i < 0 {
+		return trackmap.TrackMap{}, fmt.Errorf("%w: %s", trackmap.ErrTagNotFound, params.Location)
+	}
+
+	tags := slices.Clone(trackMap.Tags)
+	tags[i].Name = params.Name
+	tags[i].DistanceToNext = params.DistanceToNext
+
+	return s.saveTrackMap(ctx, trackMap.Topology, tags)
+}
+
+func (s *service) DeleteTag(ctx context.Context, params trackmap.DeleteTagParams) (trackmap.TrackMap, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return trackmap.TrackMap{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	trackMap, err := s.trackMapRepository.GetTrackMap(ctx)
+	if err != nil {
+		return trackmap.TrackMap{}, fmt.Errorf("get track map: %w", err)
+	}
+
+	i := trackMap.IndexOf(params.Location)
+	if i < 0 {
+		return trackmap.TrackMap{}, fmt.Errorf("%w: %s", trackmap.ErrTagNotFound, params.Location)
+	}
+
+	tags := slices.Delete(slices.Clone(trackMap.Tags), i, i+1)
+
+	return s.saveTrackMap(ctx, trackMap.Topology, tags)
+}
+
+func (s *service) ExportTrackMap(ctx context.Context, params trackmap.ExportTrackMapParams) ([]byte, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return nil, fmt.Errorf("validate params: %w", err)
+	}
+
+	trackMap, err := s.trackMapRepository.GetTrackMap(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get track map: %w", err)
+	}
+
+	return encodeFile(trackMap, params.Format)
+}
+
+func (s *service) ImportTrackMap(ctx context.Context, params trackmap.ImportTrackMapParams) (trackmap.TrackMap, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return trackmap.TrackMap{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	updateParams, err := decodeFile(params.Data, params.Format)
+	if err != nil {
+		return trackmap.TrackMap{}, fmt.Errorf("decode file: %w", err)
+	}
+
+	return s.UpdateTrackMap(ctx, updateParams)
+}
+
+func (s *service) ImportFromScanLocation(ctx context.Context, params trackmap.ImportFromScanLocationParams) (trackmap.TrackMap, error) {
+	if err := s.validator.Validate(params); err != nil {
+		return trackmap.TrackMap{}, fmt.Errorf("validate params: %w", err)
+	}
+
+	cmd, err := s.commandRepository.GetCommandByID(ctx, params.CommandID)
+	if err != nil {
+		return trackmap.TrackMap{}, fmt.Errorf("get command by id: %w", err)
+	}
+
+	outputs, ok := cmd.Outputs.(*command.ScanLocationOutputs)
+	if cmd.Type != command.CommandTypeScanLocation || cmd.Status != command.StatusSucceeded || !ok {
+		return trackmap.TrackMap{}, trackmap.ErrInvalidScanCommand
+	}
+
+	// A tag is read several times while the robot passes it and the scan may go
+	// around a loop more than once, so only the first read of every tag is kept.
+	tags := make([]trackmap.Tag, 0, len(outputs.Locations))
+	for _, loc := range outputs.Locations {
+		if loc.Location == "" || slices.ContainsFunc(tags, func(t trackmap.Tag) bool {
+			return t.Location == loc.Location
+		}) {
+			continue
+		}
+		tags = append(tags, trackmap.Tag{Location: loc.Location})
+	}
+	if len(tags) == 0 {
+		return trackmap.TrackMap{}, trackmap.ErrScanCommandHasNoResult
+	}
+
+	return s.saveTrackMap(ctx, params.Topology, tags)
+}
+
+// saveTrackMap checks the tags and replaces the track map.
+func (s *service) saveTrackMap(ctx context.Context, topology trackmap.Topology, tags []trackmap.Tag) (trackmap.TrackMap, error) {
+	trackMap := trackmap.TrackMap{
+		Topology:  topology,
+		Tags:      make([]trackmap.Tag, 0, len(tags)),
+		UpdatedAt: time.Now(),
+	}
+	for _, tag := range tags {
+		if trackMap.IndexOf(tag.Location) >= 0 {
+			return trackmap.TrackMap{}, fmt.Errorf("%w: %s", trackmap.ErrDuplicateTag, tag.Location)
+		}
+		if trackMap.IndexOfName(tag.Name) >= 0 {
+			return trackmap.TrackMap{}, fmt.Errorf("%w: %s", trackmap.ErrDuplicateStationName, tag.Name)
+		}
+		trackMap.Tags = append(trackMap.Tags, tag)
+	}
+
+	if err := s.checkChargerLocation(ctx, trackMap); err != nil {
+		return trackmap.TrackMap{}, err
+	}
+
+	return s.trackMapRepository.UpdateTrackMap(ctx, trackMap)
+}
+
+// checkChargerLocation returns an error if the new track map removes the charger location,
+// which the return to the charger on a critical battery moves to.
+func (s *service) checkChargerLocation(ctx context.Context, trackMap trackmap.TrackMap) error {
+	cfg, err := s.configService.GetCommandConfig(ctx)
+	if err != nil {
+		return fmt.Errorf("get command config: %w", err)
+	}
+
+	// The return to the charger is disabled.
+	if cfg.LowBattery.CriticalPercent == 0 {
+		return nil
+	}
+
+	location := cfg.LowBattery.ChargerLocation
+	if trackMap.IndexOf(location) >= 0 {
+		return nil
+	}
+
+	// A track map without the charger location can still be edited until the charger tag is added.
+	cur, err := s.trackMapRepository.GetTrackMap(ctx)
+	if err != nil {
+		return fmt.Errorf("get track map: %w", err)
+	}
+	if cur.IndexOf(location) >= 0 {
+		return fmt.Errorf("%w: %s", trackmap.ErrChargerTagRemoved, location)
+	}
+
+	return nil
+}
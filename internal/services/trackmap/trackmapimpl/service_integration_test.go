@@ -0,0 +1,67 @@
+package trackmapimpl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tbe-team/raybot/internal/config"
+	"github.com/tbe-team/raybot/internal/services/config/configimpl"
+	"github.com/tbe-team/raybot/internal/services/trackmap"
+	"github.com/tbe-team/raybot/internal/storage/db"
+	"github.com/tbe-team/raybot/internal/storage/db/sqlc"
+	"github.com/tbe-team/raybot/pkg/validator"
+)
+
+func TestIntegrationTrackMapService(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	t.Run("Track map should be stored and edited in track order", func(t *testing.T) {
+		db, err := db.NewTestDB()
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, db.Close())
+		}()
+		err = db.AutoMigrate()
+		require.NoError(t, err)
+		s := NewService(validator.New(), configimpl.NewService(&config.Config{}, nil, nil), NewTrackMapRepository(db, sqlc.New()), nil)
+
+		m, err := s.GetTrackMap(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, trackmap.TopologyLoop, m.Topology)
+		require.Empty(t, m.Tags)
+
+		_, err = s.UpdateTrackMap(context.Background(), trackmap.UpdateTrackMapParams{
+			Topology: trackmap.TopologyLinear,
+			Tags: []trackmap.TagParams{
+				{Location: "C", Name: "charger", DistanceToNext: 200},
+				{Location: "A"},
+			},
+		})
+		require.NoError(t, err)
+
+		_, err = s.UpdateTag(context.Background(), trackmap.UpdateTagParams{
+			Location:       "A",
+			Name:           "dock",
+			DistanceToNext: 50,
+		})
+		require.NoError(t, err)
+
+		m, err = s.DeleteTag(context.Background(), trackmap.DeleteTagParams{Location: "C"})
+		require.NoError(t, err)
+		require.Equal(t, []trackmap.Tag{{Location: "A", Name: "dock", DistanceToNext: 50}}, m.Tags)
+
+		m, err = s.GetTrackMap(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, trackmap.TopologyLinear, m.Topology)
+		require.Equal(t, []trackmap.Tag{{Location: "A", Name: "dock", DistanceToNext: 50}}, m.Tags)
+
+		require.NoError(t, s.DeleteTrackMap(context.Background()))
+		m, err = s.GetTrackMap(context.Background())
+		require.NoError(t, err)
+		require.Empty(t, m.Tags)
+	})
+}
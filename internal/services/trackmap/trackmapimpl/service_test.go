@@ -0,0 +1,194 @@
+package trackmapimpl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tbe-team/raybot/internal/config"
+	"github.com/tbe-team/raybot/internal/services/command"
+	commandmocks "github.com/tbe-team/raybot/internal/services/command/mocks"
+	"github.com/tbe-team/raybot/internal/services/config/configimpl"
+	"github.com/tbe-team/raybot/internal/services/trackmap"
+	trackmapmocks "github.com/tbe-team/raybot/internal/services/trackmap/mocks"
+	"github.com/tbe-team/raybot/pkg/ptr"
+	"github.com/tbe-team/raybot/pkg/validator"
+)
+
+func returnTrackMap(_ context.Context, m trackmap.TrackMap) (trackmap.TrackMap, error) {
+	return m, nil
+}
+
+func TestService_UpdateTrackMap(t *testing.T) {
+	t.Run("Should reject duplicate tags", func(t *testing.T) {
+		s := NewService(validator.New(), configimpl.NewService(&config.Config{}, nil, nil), trackmapmocks.NewFakeRepository(t), nil)
+
+		_, err := s.UpdateTrackMap(context.Background(), trackmap.UpdateTrackMapParams{
+			Topology: trackmap.TopologyLoop,
+			Tags:     []trackmap.TagParams{{Location: "A"}, {Location: "B"}, {Location: "A"}},
+		})
+		require.ErrorIs(t, err, trackmap.ErrDuplicateTag)
+	})
+
+	t.Run("Should reject duplicate station names", func(t *testing.T) {
+		s := NewService(validator.New(), configimpl.NewService(&config.Config{}, nil, nil), trackmapmocks.NewFakeRepository(t), nil)
+
+		_, err := s.UpdateTrackMap(context.Background(), trackmap.UpdateTrackMapParams{
+			Topology: trackmap.TopologyLoop,
+			Tags:     []trackmap.TagParams{{Location: "A", Name: "dock"}, {Location: "B", Name: "dock"}},
+		})
+		require.ErrorIs(t, err, trackmap.ErrDuplicateStationName)
+	})
+
+	chargerConfig := &config.Config{
+		Command: config.Command{
+			LowBattery: config.LowBattery{CriticalPercent: 10, ChargerLocation: "C"},
+		},
+	}
+
+	t.Run("Should reject removing the charger location", func(t *testing.T) {
+		trackMapRepository := trackmapmocks.NewFakeRepository(t)
+		s := NewService(validator.New(), configimpl.NewService(chargerConfig, nil, nil), trackMapRepository, nil)
+
+		trackMapRepository.EXPECT().GetTrackMap(mock.Anything).Return(trackmap.TrackMap{
+			Tags: []trackmap.Tag{{Location: "A"}, {Location: "C"}},
+		}, nil)
+
+		_, err := s.UpdateTrackMap(context.Background(), trackmap.UpdateTrackMapParams{
+			Topology: trackmap.TopologyLoop,
+			Tags:     []trackmap.TagParams{{Location: "A"}, {Location: "B"}},
+		})
+		require.ErrorIs(t, err, trackmap.ErrChargerTagRemoved)
+	})
+
+	t.Run("Should allow editing a track map without the charger location", func(t *testing.T) {
+		trackMapRepository := trackmapmocks.NewFakeRepository(t)
+		s := NewService(validator.New(), configimpl.NewService(chargerConfig, nil, nil), trackMapRepository, nil)
+
+		trackMapRepository.EXPECT().GetTrackMap(mock.Anything).Return(trackmap.TrackMap{
+			Tags: []trackmap.Tag{{Location: "A"}},
+		}, nil)
+		trackMapRepository.EXPECT().UpdateTrackMap(mock.Anything, mock.Anything).RunAndReturn(returnTrackMap)
+
+		m, err := s.UpdateTrackMap(context.Background(), trackmap.UpdateTrackMapParams{
+			Topology: trackmap.TopologyLoop,
+			Tags:     []trackmap.TagParams{{Location: "A"}, {Location: "B"}},
+		})
+		require.NoError(t, err)
+		require.Len(t, m.Tags, 2)
+	})
+}
+
+func TestService_CreateTag(t *testing.T) {
+	trackMapRepository := trackmapmocks.NewFakeRepository(t)
+	s := NewService(validator.New(), configimpl.NewService(&config.Config{}, nil, nil), trackMapRepository, nil)
+
+	trackMapRepository.EXPECT().GetTrackMap(mock.Anything).Return(trackmap.TrackMap{
+		Topology: trackmap.TopologyLinear,
+		Tags:     []trackmap.Tag{{Location: "A"}, {Location: "C"}},
+	}, nil)
+	trackMapRepository.EXPECT().UpdateTrackMap(mock.Anything, mock.Anything).RunAndReturn(returnTrackMap)
+
+	m, err := s.CreateTag(context.Background(), trackmap.CreateTagParams{
+		Tag:      trackmap.TagParams{Location: "B", Name: "station b", DistanceToNext: 120},
+		Position: ptr.New(uint(1)),
+	})
+	require.NoError(t, err)
+	require.Equal(t, trackmap.TopologyLinear, m.Topology)
+	require.Equal(t, []trackmap.Tag{
+		{Location: "A"},
+		{Location: "B", Name: "station b", DistanceToNext: 120},
+		{Location: "C"},
+	}, m.Tags)
+}
+
+func TestService_ExportImportTrackMap(t *testing.T) {
+	stored := trackmap.TrackMap{
+		Topology: trackmap.TopologyLoop,
+		Tags: []trackmap.Tag{
+			{Location: "A", Name: "dock", DistanceToNext: 100},
+			{Location: "B", DistanceToNext: 250},
+		},
+	}
+
+	for _, format := range []trackmap.Format{trackmap.FormatJSON, trackmap.FormatYAML} {
+		t.Run(format.String(), func(t *testing.T) {
+			trackMapRepository := trackmapmocks.NewFakeRepository(t)
+			s := NewService(validator.New(), configimpl.NewService(&config.Config{}, nil, nil), trackMapRepository, nil)
+
+			trackMapRepository.EXPECT().GetTrackMap(mock.Anything).Return(stored, nil)
+			trackMapRepository.EXPECT().UpdateTrackMap(mock.Anything, mock.Anything).RunAndReturn(returnTrackMap)
+
+			data, err := s.ExportTrackMap(context.Background(), trackmap.ExportTrackMapParams{Format: format})
+			require.NoError(t, err)
+
+			m, err := s.ImportTrackMap(context.Background(), trackmap.ImportTrackMapParams{Format: format, Data: data})
+			require.NoError(t, err)
+			require.Equal(t, stored.Topology, m.Topology)
+			require.Equal(t, stored.Tags, m.Tags)
+		})
+	}
+
+	t.Run("Should reject invalid file", func(t *testing.T) {
+		s := NewService(validator.New(), configimpl.NewService(&config.Config{}, nil, nil), trackmapmocks.NewFakeRepository(t), nil)
+
+		_, err := s.ImportTrackMap(context.Background(), trackmap.ImportTrackMapParams{
+			Format: trackmap.FormatJSON,
+			Data:   []byte("{"),
+		})
+		require.ErrorIs(t, err, trackmap.ErrInvalidTrackMapFile)
+	})
+}
+
+func TestService_ImportFromScanLocation(t *testing.T) {
+	t.Run("Should import unique locations in scan order", func(t *testing.T) {
+		trackMapRepository := trackmapmocks.NewFakeRepository(t)
+		commandRepository := commandmocks.NewFakeRepository(t)
+		s := NewService(validator.New(), configimpl.NewService(&config.Config{}, nil, nil), trackMapRepository, commandRepository)
+
+		now := time.Now()
+		commandRepository.EXPECT().GetCommandByID(mock.Anything, int64(1)).Return(command.Command{
+			ID:     1,
+			Type:   command.CommandTypeScanLocation,
+			Status: command.StatusSucceeded,
+			Outputs: &command.ScanLocationOutputs{
+				Locations: []command.Location{
+					{Location: "A", ScannedAt: now},
+					{Location: "A", ScannedAt: now},
+					{Location: "B", ScannedAt: now},
+					{Location: "C", ScannedAt: now},
+					{Location: "A", ScannedAt: now},
+				},
+			},
+		}, nil)
+		trackMapRepository.EXPECT().UpdateTrackMap(mock.Anything, mock.Anything).RunAndReturn(returnTrackMap)
+
+		m, err := s.ImportFromScanLocation(context.Background(), trackmap.ImportFromScanLocationParams{
+			CommandID: 1,
+			Topology:  trackmap.TopologyLoop,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []trackmap.Tag{{Location: "A"}, {Location: "B"}, {Location: "C"}}, m.Tags)
+	})
+
+	t.Run("Should reject command that is not a succeeded scan location", func(t *testing.T) {
+		commandRepository := commandmocks.NewFakeRepository(t)
+		s := NewService(validator.New(), configimpl.NewService(&config.Config{}, nil, nil), trackmapmocks.NewFakeRepository(t), commandRepository)
+
+		commandRepository.EXPECT().GetCommandByID(mock.Anything, int64(1)).Return(command.Command{
+			ID:      1,
+			Type:    command.CommandTypeScanLocation,
+			Status:  command.StatusFailed,
+			Outputs: &command.ScanLocationOutputs{},
+		}, nil)
+
+		_, err := s.ImportFromScanLocation(context.Background(), trackmap.ImportFromScanLocationParams{
+			CommandID: 1,
+			Topology:  trackmap.TopologyLoop,
+		})
+		require.ErrorIs(t, err, trackmap.ErrInvalidScanCommand)
+	})
+}
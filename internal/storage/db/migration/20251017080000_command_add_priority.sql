@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE commands
+ADD COLUMN priority INTEGER NOT NULL DEFAULT 0;
+
+CREATE INDEX idx_commands_status_priority ON commands(status, priority DESC, created_at ASC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_commands_status_priority;
+
+ALTER TABLE commands
+DROP COLUMN priority;
+-- +goose StatementEnd
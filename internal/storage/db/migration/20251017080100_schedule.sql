@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE schedules (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	name TEXT NOT NULL,
+	command_type TEXT NOT NULL,
+	inputs TEXT NOT NULL DEFAULT '{}',
+	priority INTEGER NOT NULL DEFAULT 0,
+	run_at TEXT,
+	cron_expr TEXT,
+	enabled INTEGER NOT NULL,
+	next_run_at TEXT,
+	last_run_at TEXT,
+	last_command_id INTEGER,
+	created_at TEXT NOT NULL,
+	updated_at TEXT NOT NULL
+);
+
+CREATE INDEX idx_schedules_enabled ON schedules(enabled);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_schedules_enabled;
+
+DROP TABLE schedules;
+
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE commands
+ADD COLUMN timeout_ms INTEGER;
+
+ALTER TABLE commands
+ADD COLUMN deadline TEXT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE commands
+DROP COLUMN deadline;
+
+ALTER TABLE commands
+DROP COLUMN timeout_ms;
+-- +goose StatementEnd
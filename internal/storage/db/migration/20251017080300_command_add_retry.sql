@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE commands
+ADD COLUMN retry_policy TEXT;
+
+ALTER TABLE commands
+ADD COLUMN attempts INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE commands
+ADD COLUMN attempt_errors TEXT NOT NULL DEFAULT '[]';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE commands
+DROP COLUMN attempt_errors;
+
+ALTER TABLE commands
+DROP COLUMN attempts;
+
+ALTER TABLE commands
+DROP COLUMN retry_policy;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE commands
+ADD COLUMN queue_order INTEGER NOT NULL DEFAULT 0;
+
+UPDATE commands
+SET queue_order = id;
+
+DROP INDEX idx_commands_status_priority;
+
+CREATE INDEX idx_commands_status_priority ON commands(status, priority DESC, queue_order ASC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_commands_status_priority;
+
+CREATE INDEX idx_commands_status_priority ON commands(status, priority DESC, created_at ASC);
+
+ALTER TABLE commands
+DROP COLUMN queue_order;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE command_queue_state (
+	id INTEGER PRIMARY KEY CHECK (id = 1),
+	paused INTEGER NOT NULL,
+	paused_by TEXT,
+	reason TEXT,
+	paused_at TEXT,
+	updated_at TEXT NOT NULL
+);
+
+INSERT INTO
+	command_queue_state (id, paused, updated_at)
+VALUES
+	(1, false, '2025-01-01T00:00:00Z');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE command_queue_state;
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE track_map (
+	id INTEGER PRIMARY KEY CHECK (id = 1),
+	topology TEXT NOT NULL,
+	updated_at TEXT NOT NULL
+);
+
+CREATE TABLE track_map_tags (
+	position INTEGER PRIMARY KEY,
+	location TEXT NOT NULL UNIQUE
+);
+
+INSERT INTO
+	track_map (id, topology, updated_at)
+VALUES
+	(1, 'LOOP', '2025-01-01T00:00:00Z');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE track_map_tags;
+
+DROP TABLE track_map;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE track_map_tags
+ADD COLUMN name TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE track_map_tags
+ADD COLUMN distance_to_next INTEGER NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE track_map_tags
+DROP COLUMN distance_to_next;
+
+ALTER TABLE track_map_tags
+DROP COLUMN name;
+-- +goose StatementEnd
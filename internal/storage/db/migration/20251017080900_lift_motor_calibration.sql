@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE lift_motor_calibration (
+	id INTEGER PRIMARY KEY CHECK (id = 1),
+	zero_offset INTEGER NOT NULL,
+	calibrated_at TEXT,
+	updated_at TEXT NOT NULL
+);
+
+INSERT INTO
+	lift_motor_calibration (id, zero_offset, updated_at)
+VALUES
+	(1, 0, '2025-01-01T00:00:00Z');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE lift_motor_calibration;
+-- +goose StatementEnd
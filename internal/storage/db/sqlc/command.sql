@@ -19,6 +19,8 @@ LIMIT
 	1;
 
 -- name: CommandGetNextExecutable :one
+-- It returns the queued command with the highest priority,
+-- commands with the same priority are returned by their queue order.
 SELECT
 	*
 FROM
@@ -26,10 +28,72 @@ FROM
 WHERE
 	status = 'QUEUED'
 ORDER BY
-	created_at ASC
+	priority DESC,
+	queue_order ASC
 LIMIT
 	1;
 
+-- name: CommandGetNextExecutableBySource :one
+-- It returns the queued command of the source with the highest priority,
+-- commands with the same priority are returned by their queue order.
+SELECT
+	*
+FROM
+	commands
+WHERE
+	status = 'QUEUED'
+	AND source = @source
+ORDER BY
+	priority DESC,
+	queue_order ASC
+LIMIT
+	1;
+
+-- name: CommandListPending :many
+-- It returns the commands with the status QUEUED, PROCESSING or CANCELING.
+SELECT
+	*
+FROM
+	commands
+WHERE
+	status IN ('QUEUED', 'PROCESSING', 'CANCELING')
+ORDER BY
+	id ASC;
+
+-- name: CommandGetQueuePosition :one
+-- It returns the number of queued commands executed before the given command
+-- and the total number of queued commands.
+SELECT
+	CAST(
+		COALESCE(
+			SUM(
+				CASE
+					WHEN c.priority > t.priority
+					OR (
+						c.priority = t.priority
+						AND c.queue_order < t.queue_order
+					) THEN 1
+					ELSE 0
+				END
+			),
+			0
+		) AS INTEGER
+	) AS ahead,
+	COUNT(*) AS total
+FROM
+	commands c,
+	(
+		SELECT
+			p.priority,
+			p.queue_order
+		FROM
+			commands p
+		WHERE
+			p.id = @id
+	) t
+WHERE
+	c.status = 'QUEUED';
+
 -- name: CommandCreate :one
 INSERT INTO
 	commands (
@@ -42,7 +106,12 @@ INSERT INTO
 		created_at,
 		updated_at,
 		completed_at,
-		request_id
+		request_id,
+		priority,
+		timeout_ms,
+		deadline,
+		retry_policy,
+		queue_order
 	)
 VALUES
 	(
@@ -55,7 +124,17 @@ VALUES
 		@created_at,
 		@updated_at,
 		@completed_at,
-		@request_id
+		@request_id,
+		@priority,
+		@timeout_ms,
+		@deadline,
+		@retry_policy,
+		(
+			SELECT
+				COALESCE(MAX(queue_order), 0) + 1
+			FROM
+				commands
+		)
 	) RETURNING id,
 	outputs;
 
@@ -83,10 +162,94 @@ SET
 		WHEN @set_completed_at = 1 THEN @completed_at
 		ELSE completed_at
 	END,
+	attempts = CASE
+		WHEN @set_attempts = 1 THEN @attempts
+		ELSE attempts
+	END,
+	attempt_errors = CASE
+		WHEN @set_attempt_errors = 1 THEN @attempt_errors
+		ELSE attempt_errors
+	END,
+	recovery = CASE
+		WHEN @set_recovery = 1 THEN @recovery
+		ELSE recovery
+	END,
 	updated_at = @updated_at
 WHERE
 	id = @id RETURNING *;
 
+-- name: CommandCancelQueuedByID :one
+-- It only cancels the command if the status is QUEUED.
+UPDATE
+	commands
+SET
+	status = 'CANCELED',
+	completed_at = @completed_at,
+	updated_at = @updated_at
+WHERE
+	id = @id
+	AND status = 'QUEUED' RETURNING *;
+
+-- name: CommandMoveQueuedToFront :one
+-- It moves the queued command before all other queued commands,
+-- raising its priority to the highest queued priority if needed.
+UPDATE
+	commands
+SET
+	priority = MAX(
+		priority,
+		(
+			SELECT
+				COALESCE(MAX(q.priority), 0)
+			FROM
+				commands q
+			WHERE
+				q.status = 'QUEUED'
+		)
+	),
+	queue_order = (
+		SELECT
+			COALESCE(MIN(q.queue_order), 0) - 1
+		FROM
+			commands q
+		WHERE
+			q.status = 'QUEUED'
+	),
+	updated_at = @updated_at
+WHERE
+	commands.id = @id
+	AND commands.status = 'QUEUED' RETURNING *;
+
+-- name: CommandMoveQueuedToBack :one
+-- It moves the queued command after all other queued commands,
+-- lowering its priority to the lowest queued priority if needed.
+UPDATE
+	commands
+SET
+	priority = MIN(
+		priority,
+		(
+			SELECT
+				COALESCE(MIN(q.priority), 0)
+			FROM
+				commands q
+			WHERE
+				q.status = 'QUEUED'
+		)
+	),
+	queue_order = (
+		SELECT
+			COALESCE(MAX(q.queue_order), 0) + 1
+		FROM
+			commands q
+		WHERE
+			q.status = 'QUEUED'
+	),
+	updated_at = @updated_at
+WHERE
+	commands.id = @id
+	AND commands.status = 'QUEUED' RETURNING *;
+
 -- name: CommandCancelByStatusQueuedAndProcessingAndCanceling :exec
 UPDATE
 	commands
@@ -119,3 +282,23 @@ DELETE FROM
 WHERE
 	created_at < @created_at
 	AND status NOT IN ('QUEUED', 'PROCESSING', 'CANCELING');
+
+-- name: CommandQueueStateGet :one
+SELECT
+	*
+FROM
+	command_queue_state
+WHERE
+	id = 1;
+
+-- name: CommandQueueStateUpdate :one
+UPDATE
+	command_queue_state
+SET
+	paused = @paused,
+	paused_by = @paused_by,
+	reason = @reason,
+	paused_at = @paused_at,
+	updated_at = @updated_at
+WHERE
+	id = 1 RETURNING *;
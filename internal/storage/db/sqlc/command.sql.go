@@ -38,6 +38,53 @@ func (q *Queries) CommandCancelByStatusQueuedAndProcessingAndCreatedByCloud(ctx
 	return err
 }
 
+const commandCancelQueuedByID = `-- name: CommandCancelQueuedByID :one
+UPDATE
+	commands
+SET
+	status = 'CANCELED',
+	completed_at = ?1,
+	updated_at = ?2
+WHERE
+	id = ?3
+	AND status = 'QUEUED' RETURNING id, type, status, source, inputs, error, completed_at, created_at, updated_at, started_at, outputs, request_id, priority, timeout_ms, deadline, retry_policy, attempts, attempt_errors, queue_order, recovery
+`
+
+type CommandCancelQueuedByIDParams struct {
+	CompletedAt *string `json:"completed_at"`
+	UpdatedAt   string  `json:"updated_at"`
+	ID          int64   `json:"id"`
+}
+
+// It only cancels the command if the status is QUEUED.
+func (q *Queries) CommandCancelQueuedByID(ctx context.Context, db DBTX, arg CommandCancelQueuedByIDParams) (Command, error) {
+	row := db.QueryRowContext(ctx, commandCancelQueuedByID, arg.CompletedAt, arg.UpdatedAt, arg.ID)
+	var i Command
+	err := row.Scan(
+		&i.ID,
+		&i.Type,
+		&i.Status,
+		&i.Source,
+		&i.Inputs,
+		&i.Error,
+		&i.CompletedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.StartedAt,
+		&i.Outputs,
+		&i.RequestID,
+		&i.Priority,
+		&i.TimeoutMs,
+		&i.Deadline,
+		&i.RetryPolicy,
+		&i.Attempts,
+		&i.AttemptErrors,
+		&i.QueueOrder,
+		&i.Recovery,
+	)
+	return i, err
+}
+
 const commandCreate = `-- name: CommandCreate :one
 INSERT INTO
 	commands (
@@ -50,7 +97,12 @@ INSERT INTO
 		created_at,
 		updated_at,
 		completed_at,
-		request_id
+		request_id,
+		priority,
+		timeout_ms,
+		deadline,
+		retry_policy,
+		queue_order
 	)
 VALUES
 	(
@@ -63,7 +115,17 @@ VALUES
 		?7,
 		?8,
 		?9,
-		?10
+		?10,
+		?11,
+		?12,
+		?13,
+		?14,
+		(
+			SELECT
+				COALESCE(MAX(queue_order), 0) + 1
+			FROM
+				commands
+		)
 	) RETURNING id,
 	outputs
 `
@@ -79,6 +141,10 @@ type CommandCreateParams struct {
 	UpdatedAt   string  `json:"updated_at"`
 	CompletedAt *string `json:"completed_at"`
 	RequestID   *string `json:"request_id"`
+	Priority    int64   `json:"priority"`
+	TimeoutMs   *int64  `json:"timeout_ms"`
+	Deadline    *string `json:"deadline"`
+	RetryPolicy *string `json:"retry_policy"`
 }
 
 type CommandCreateRow struct {
@@ -98,6 +164,10 @@ func (q *Queries) CommandCreate(ctx context.Context, db DBTX, arg CommandCreateP
 		arg.UpdatedAt,
 		arg.CompletedAt,
 		arg.RequestID,
+		arg.Priority,
+		arg.TimeoutMs,
+		arg.Deadline,
+		arg.RetryPolicy,
 	)
 	var i CommandCreateRow
 	err := row.Scan(&i.ID, &i.Outputs)
@@ -140,7 +210,7 @@ func (q *Queries) CommandDeleteOldCommands(ctx context.Context, db DBTX, created
 
 const commandGetByID = `-- name: CommandGetByID :one
 SELECT
-	id, type, status, source, inputs, error, completed_at, created_at, updated_at, started_at, outputs, request_id
+	id, type, status, source, inputs, error, completed_at, created_at, updated_at, started_at, outputs, request_id, priority, timeout_ms, deadline, retry_policy, attempts, attempt_errors, queue_order, recovery
 FROM
 	commands
 WHERE
@@ -163,13 +233,21 @@ func (q *Queries) CommandGetByID(ctx context.Context, db DBTX, id int64) (Comman
 		&i.StartedAt,
 		&i.Outputs,
 		&i.RequestID,
+		&i.Priority,
+		&i.TimeoutMs,
+		&i.Deadline,
+		&i.RetryPolicy,
+		&i.Attempts,
+		&i.AttemptErrors,
+		&i.QueueOrder,
+		&i.Recovery,
 	)
 	return i, err
 }
 
 const commandGetCurrentProcessing = `-- name: CommandGetCurrentProcessing :one
 SELECT
-	id, type, status, source, inputs, error, completed_at, created_at, updated_at, started_at, outputs, request_id
+	id, type, status, source, inputs, error, completed_at, created_at, updated_at, started_at, outputs, request_id, priority, timeout_ms, deadline, retry_policy, attempts, attempt_errors, queue_order, recovery
 FROM
 	commands
 WHERE
@@ -196,23 +274,34 @@ func (q *Queries) CommandGetCurrentProcessing(ctx context.Context, db DBTX) (Com
 		&i.StartedAt,
 		&i.Outputs,
 		&i.RequestID,
+		&i.Priority,
+		&i.TimeoutMs,
+		&i.Deadline,
+		&i.RetryPolicy,
+		&i.Attempts,
+		&i.AttemptErrors,
+		&i.QueueOrder,
+		&i.Recovery,
 	)
 	return i, err
 }
 
 const commandGetNextExecutable = `-- name: CommandGetNextExecutable :one
 SELECT
-	id, type, status, source, inputs, error, completed_at, created_at, updated_at, started_at, outputs, request_id
+	id, type, status, source, inputs, error, completed_at, created_at, updated_at, started_at, outputs, request_id, priority, timeout_ms, deadline, retry_policy, attempts, attempt_errors, queue_order, recovery
 FROM
 	commands
 WHERE
 	status = 'QUEUED'
 ORDER BY
-	created_at ASC
+	priority DESC,
+	queue_order ASC
 LIMIT
 	1
 `
 
+// It returns the queued command with the highest priority,
+// commands with the same priority are returned by their queue order.
 func (q *Queries) CommandGetNextExecutable(ctx context.Context, db DBTX) (Command, error) {
 	row := db.QueryRowContext(ctx, commandGetNextExecutable)
 	var i Command
@@ -229,6 +318,354 @@ func (q *Queries) CommandGetNextExecutable(ctx context.Context, db DBTX) (Comman
 		&i.StartedAt,
 		&i.Outputs,
 		&i.RequestID,
+		&i.Priority,
+		&i.TimeoutMs,
+		&i.Deadline,
+		&i.RetryPolicy,
+		&i.Attempts,
+		&i.AttemptErrors,
+		&i.QueueOrder,
+		&i.Recovery,
+	)
+	return i, err
+}
+
+const commandGetNextExecutableBySource = `-- name: CommandGetNextExecutableBySource :one
+SELECT
+	id, type, status, source, inputs, error, completed_at, created_at, updated_at, started_at, outputs, request_id, priority, timeout_ms, deadline, retry_policy, attempts, attempt_errors, queue_order, recovery
+FROM
+	commands
+WHERE
+	status = 'QUEUED'
+	AND source = ?1
+ORDER BY
+	priority DESC,
+	queue_order ASC
+LIMIT
+	1
+`
+
+// It returns the queued command of the source with the highest priority,
+// commands with the same priority are returned by their queue order.
+func (q *Queries) CommandGetNextExecutableBySource(ctx context.Context, db DBTX, source string) (Command, error) {
+	row := db.QueryRowContext(ctx, commandGetNextExecutableBySource, source)
+	var i Command
+	err := row.Scan(
+		&i.ID,
+		&i.Type,
+		&i.Status,
+		&i.Source,
+		&i.Inputs,
+		&i.Error,
+		&i.CompletedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.StartedAt,
+		&i.Outputs,
+		&i.RequestID,
+		&i.Priority,
+		&i.TimeoutMs,
+		&i.Deadline,
+		&i.RetryPolicy,
+		&i.Attempts,
+		&i.AttemptErrors,
+		&i.QueueOrder,
+		&i.Recovery,
+	)
+	return i, err
+}
+
+const commandGetQueuePosition = `-- name: CommandGetQueuePosition :one
+SELECT
+	CAST(
+		COALESCE(
+			SUM(
+				CASE
+					WHEN c.priority > t.priority
+					OR (
+						c.priority = t.priority
+						AND c.queue_order < t.queue_order
+					) THEN 1
+					ELSE 0
+				END
+			),
+			0
+		) AS INTEGER
+	) AS ahead,
+	COUNT(*) AS total
+FROM
+	commands c,
+	(
+		SELECT
+			p.priority,
+			p.queue_order
+		FROM
+			commands p
+		WHERE
+			p.id = ?1
+	) t
+WHERE
+	c.status = 'QUEUED'
+`
+
+type CommandGetQueuePositionRow struct {
+	Ahead int64 `json:"ahead"`
+	Total int64 `json:"total"`
+}
+
+// It returns the number of queued commands executed before the given command
+// and the total number of queued commands.
+func (q *Queries) CommandGetQueuePosition(ctx context.Context, db DBTX, id int64) (CommandGetQueuePositionRow, error) {
+	row := db.QueryRowContext(ctx, commandGetQueuePosition, id)
+	var i CommandGetQueuePositionRow
+	err := row.Scan(&i.Ahead, &i.Total)
+	return i, err
+}
+
+const commandListPending = `-- name: CommandListPending :many
+SELECT
+	id, type, status, source, inputs, error, completed_at, created_at, updated_at, started_at, outputs, request_id, priority, timeout_ms, deadline, retry_policy, attempts, attempt_errors, queue_order, recovery
+FROM
+	commands
+WHERE
+	status IN ('QUEUED', 'PROCESSING', 'CANCELING')
+ORDER BY
+	id ASC
+`
+
+// It returns the commands with the status QUEUED, PROCESSING or CANCELING.
+func (q *Queries) CommandListPending(ctx context.Context, db DBTX) ([]Command, error) {
+	rows, err := db.QueryContext(ctx, commandListPending)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Command{}
+	for rows.Next() {
+		var i Command
+		if err := rows.Scan(
+			&i.ID,
+			&i.Type,
+			&i.Status,
+			&i.Source,
+			&i.Inputs,
+			&i.Error,
+			&i.CompletedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.StartedAt,
+			&i.Outputs,
+			&i.RequestID,
+			&i.Priority,
+			&i.TimeoutMs,
+			&i.Deadline,
+			&i.RetryPolicy,
+			&i.Attempts,
+			&i.AttemptErrors,
+			&i.QueueOrder,
+			&i.Recovery,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const commandMoveQueuedToBack = `-- name: CommandMoveQueuedToBack :one
+UPDATE
+	commands
+SET
+	priority = MIN(
+		priority,
+		(
+			SELECT
+				COALESCE(MIN(q.priority), 0)
+			FROM
+				commands q
+			WHERE
+				q.status = 'QUEUED'
+		)
+	),
+	queue_order = (
+		SELECT
+			COALESCE(MAX(q.queue_order), 0) + 1
+		FROM
+			commands q
+		WHERE
+			q.status = 'QUEUED'
+	),
+	updated_at = ?1
+WHERE
+	commands.id = ?2
+	AND commands.status = 'QUEUED' RETURNING id, type, status, source, inputs, error, completed_at, created_at, updated_at, started_at, outputs, request_id, priority, timeout_ms, deadline, retry_policy, attempts, attempt_errors, queue_order, recovery
+`
+
+type CommandMoveQueuedToBackParams struct {
+	UpdatedAt string `json:"updated_at"`
+	ID        int64  `json:"id"`
+}
+
+// It moves the queued command after all other queued commands,
+// lowering its priority to the lowest queued priority if needed.
+func (q *Queries) CommandMoveQueuedToBack(ctx context.Context, db DBTX, arg CommandMoveQueuedToBackParams) (Command, error) {
+	row := db.QueryRowContext(ctx, commandMoveQueuedToBack, arg.UpdatedAt, arg.ID)
+	var i Command
+	err := row.Scan(
+		&i.ID,
+		&i.Type,
+		&i.Status,
+		&i.Source,
+		&i.Inputs,
+		&i.Error,
+		&i.CompletedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.StartedAt,
+		&i.Outputs,
+		&i.RequestID,
+		&i.Priority,
+		&i.TimeoutMs,
+		&i.Deadline,
+		&i.RetryPolicy,
+		&i.Attempts,
+		&i.AttemptErrors,
+		&i.QueueOrder,
+		&i.Recovery,
+	)
+	return i, err
+}
+
+const commandMoveQueuedToFront = `-- name: CommandMoveQueuedToFront :one
+UPDATE
+	commands
+SET
+	priority = MAX(
+		priority,
+		(
+			SELECT
+				COALESCE(MAX(q.priority), 0)
+			FROM
+				commands q
+			WHERE
+				q.status = 'QUEUED'
+		)
+	),
+	queue_order = (
+		SELECT
+			COALESCE(MIN(q.queue_order), 0) - 1
+		FROM
+			commands q
+		WHERE
+			q.status = 'QUEUED'
+	),
+	updated_at = ?1
+WHERE
+	commands.id = ?2
+	AND commands.status = 'QUEUED' RETURNING id, type, status, source, inputs, error, completed_at, created_at, updated_at, started_at, outputs, request_id, priority, timeout_ms, deadline, retry_policy, attempts, attempt_errors, queue_order, recovery
+`
+
+type CommandMoveQueuedToFrontParams struct {
+	UpdatedAt string `json:"updated_at"`
+	ID        int64  `json:"id"`
+}
+
+// It moves the queued command before all other queued commands,
+// raising its priority to the highest queued priority if needed.
+func (q *Queries) CommandMoveQueuedToFront(ctx context.Context, db DBTX, arg CommandMoveQueuedToFrontParams) (Command, error) {
+	row := db.QueryRowContext(ctx, commandMoveQueuedToFront, arg.UpdatedAt, arg.ID)
+	var i Command
+	err := row.Scan(
+		&i.ID,
+		&i.Type,
+		&i.Status,
+		&i.Source,
+		&i.Inputs,
+		&i.Error,
+		&i.CompletedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.StartedAt,
+		&i.Outputs,
+		&i.RequestID,
+		&i.Priority,
+		&i.TimeoutMs,
+		&i.Deadline,
+		&i.RetryPolicy,
+		&i.Attempts,
+		&i.AttemptErrors,
+		&i.QueueOrder,
+		&i.Recovery,
+	)
+	return i, err
+}
+
+const commandQueueStateGet = `-- name: CommandQueueStateGet :one
+SELECT
+	id, paused, paused_by, reason, paused_at, updated_at
+FROM
+	command_queue_state
+WHERE
+	id = 1
+`
+
+func (q *Queries) CommandQueueStateGet(ctx context.Context, db DBTX) (CommandQueueState, error) {
+	row := db.QueryRowContext(ctx, commandQueueStateGet)
+	var i CommandQueueState
+	err := row.Scan(
+		&i.ID,
+		&i.Paused,
+		&i.PausedBy,
+		&i.Reason,
+		&i.PausedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const commandQueueStateUpdate = `-- name: CommandQueueStateUpdate :one
+UPDATE
+	command_queue_state
+SET
+	paused = ?1,
+	paused_by = ?2,
+	reason = ?3,
+	paused_at = ?4,
+	updated_at = ?5
+WHERE
+	id = 1 RETURNING id, paused, paused_by, reason, paused_at, updated_at
+`
+
+type CommandQueueStateUpdateParams struct {
+	Paused    int64   `json:"paused"`
+	PausedBy  *string `json:"paused_by"`
+	Reason    *string `json:"reason"`
+	PausedAt  *string `json:"paused_at"`
+	UpdatedAt string  `json:"updated_at"`
+}
+
+func (q *Queries) CommandQueueStateUpdate(ctx context.Context, db DBTX, arg CommandQueueStateUpdateParams) (CommandQueueState, error) {
+	row := db.QueryRowContext(ctx, commandQueueStateUpdate,
+		arg.Paused,
+		arg.PausedBy,
+		arg.Reason,
+		arg.PausedAt,
+		arg.UpdatedAt,
+	)
+	var i CommandQueueState
+	err := row.Scan(
+		&i.ID,
+		&i.Paused,
+		&i.PausedBy,
+		&i.Reason,
+		&i.PausedAt,
+		&i.UpdatedAt,
 	)
 	return i, err
 }
@@ -257,24 +694,42 @@ SET
 		WHEN ?9 = 1 THEN ?10
 		ELSE completed_at
 	END,
-	updated_at = ?11
+	attempts = CASE
+		WHEN ?11 = 1 THEN ?12
+		ELSE attempts
+	END,
+	attempt_errors = CASE
+		WHEN ?13 = 1 THEN ?14
+		ELSE attempt_errors
+	END,
+	recovery = CASE
+		WHEN ?15 = 1 THEN ?16
+		ELSE recovery
+	END,
+	updated_at = ?17
 WHERE
-	id = ?12 RETURNING id, type, status, source, inputs, error, completed_at, created_at, updated_at, started_at, outputs, request_id
+	id = ?18 RETURNING id, type, status, source, inputs, error, completed_at, created_at, updated_at, started_at, outputs, request_id, priority, timeout_ms, deadline, retry_policy, attempts, attempt_errors, queue_order, recovery
 `
 
 type CommandUpdateParams struct {
-	SetStatus      interface{} `json:"set_status"`
-	Status         string      `json:"status"`
-	SetOutputs     interface{} `json:"set_outputs"`
-	Outputs        string      `json:"outputs"`
-	SetError       interface{} `json:"set_error"`
-	Error          *string     `json:"error"`
-	SetStartedAt   interface{} `json:"set_started_at"`
-	StartedAt      *string     `json:"started_at"`
-	SetCompletedAt interface{} `json:"set_completed_at"`
-	CompletedAt    *string     `json:"completed_at"`
-	UpdatedAt      string      `json:"updated_at"`
-	ID             int64       `json:"id"`
+	SetStatus        interface{} `json:"set_status"`
+	Status           string      `json:"status"`
+	SetOutputs       interface{} `json:"set_outputs"`
+	Outputs          string      `json:"outputs"`
+	SetError         interface{} `json:"set_error"`
+	Error            *string     `json:"error"`
+	SetStartedAt     interface{} `json:"set_started_at"`
+	StartedAt        *string     `json:"started_at"`
+	SetCompletedAt   interface{} `json:"set_completed_at"`
+	CompletedAt      *string     `json:"completed_at"`
+	SetAttempts      interface{} `json:"set_attempts"`
+	Attempts         int64       `json:"attempts"`
+	SetAttemptErrors interface{} `json:"set_attempt_errors"`
+	AttemptErrors    string      `json:"attempt_errors"`
+	SetRecovery      interface{} `json:"set_recovery"`
+	Recovery         *string     `json:"recovery"`
+	UpdatedAt        string      `json:"updated_at"`
+	ID               int64       `json:"id"`
 }
 
 func (q *Queries) CommandUpdate(ctx context.Context, db DBTX, arg CommandUpdateParams) (Command, error) {
@@ -289,6 +744,12 @@ func (q *Queries) CommandUpdate(ctx context.Context, db DBTX, arg CommandUpdateP
 		arg.StartedAt,
 		arg.SetCompletedAt,
 		arg.CompletedAt,
+		arg.SetAttempts,
+		arg.Attempts,
+		arg.SetAttemptErrors,
+		arg.AttemptErrors,
+		arg.SetRecovery,
+		arg.Recovery,
 		arg.UpdatedAt,
 		arg.ID,
 	)
@@ -306,6 +767,14 @@ func (q *Queries) CommandUpdate(ctx context.Context, db DBTX, arg CommandUpdateP
 		&i.StartedAt,
 		&i.Outputs,
 		&i.RequestID,
+		&i.Priority,
+		&i.TimeoutMs,
+		&i.Deadline,
+		&i.RetryPolicy,
+		&i.Attempts,
+		&i.AttemptErrors,
+		&i.QueueOrder,
+		&i.Recovery,
 	)
 	return i, err
 }
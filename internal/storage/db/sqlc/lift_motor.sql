@@ -0,0 +1,17 @@
+-- name: LiftMotorCalibrationGet :one
+SELECT
+	*
+FROM
+	lift_motor_calibration
+WHERE
+	id = 1;
+
+-- name: LiftMotorCalibrationUpdate :one
+UPDATE
+	lift_motor_calibration
+SET
+	zero_offset = @zero_offset,
+	calibrated_at = @calibrated_at,
+	updated_at = @updated_at
+WHERE
+	id = 1 RETURNING *;
@@ -0,0 +1,60 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: lift_motor.sql
+
+package sqlc
+
+import (
+	"context"
+)
+
+const liftMotorCalibrationGet = `-- name: LiftMotorCalibrationGet :one
+SELECT
+	id, zero_offset, calibrated_at, updated_at
+FROM
+	lift_motor_calibration
+WHERE
+	id = 1
+`
+
+func (q *Queries) LiftMotorCalibrationGet(ctx context.Context, db DBTX) (LiftMotorCalibration, error) {
+	row := db.QueryRowContext(ctx, liftMotorCalibrationGet)
+	var i LiftMotorCalibration
+	err := row.Scan(
+		&i.ID,
+		&i.ZeroOffset,
+		&i.CalibratedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const liftMotorCalibrationUpdate = `-- name: LiftMotorCalibrationUpdate :one
+UPDATE
+	lift_motor_calibration
+SET
+	zero_offset = ?1,
+	calibrated_at = ?2,
+	updated_at = ?3
+WHERE
+	id = 1 RETURNING id, zero_offset, calibrated_at, updated_at
+`
+
+type LiftMotorCalibrationUpdateParams struct {
+	ZeroOffset   int64   `json:"zero_offset"`
+	CalibratedAt *string `json:"calibrated_at"`
+	UpdatedAt    string  `json:"updated_at"`
+}
+
+func (q *Queries) LiftMotorCalibrationUpdate(ctx context.Context, db DBTX, arg LiftMotorCalibrationUpdateParams) (LiftMotorCalibration, error) {
+	row := db.QueryRowContext(ctx, liftMotorCalibrationUpdate, arg.ZeroOffset, arg.CalibratedAt, arg.UpdatedAt)
+	var i LiftMotorCalibration
+	err := row.Scan(
+		&i.ID,
+		&i.ZeroOffset,
+		&i.CalibratedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
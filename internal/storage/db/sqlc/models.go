@@ -36,18 +36,42 @@ type CargoDoorMotor struct {
 }
 
 type Command struct {
-	ID          int64   `json:"id"`
-	Type        string  `json:"type"`
-	Status      string  `json:"status"`
-	Source      string  `json:"source"`
-	Inputs      string  `json:"inputs"`
-	Error       *string `json:"error"`
-	CompletedAt *string `json:"completed_at"`
-	CreatedAt   string  `json:"created_at"`
-	UpdatedAt   string  `json:"updated_at"`
-	StartedAt   *string `json:"started_at"`
-	Outputs     string  `json:"outputs"`
-	RequestID   *string `json:"request_id"`
+	ID            int64   `json:"id"`
+	Type          string  `json:"type"`
+	Status        string  `json:"status"`
+	Source        string  `json:"source"`
+	Inputs        string  `json:"inputs"`
+	Error         *string `json:"error"`
+	CompletedAt   *string `json:"completed_at"`
+	CreatedAt     string  `json:"created_at"`
+	UpdatedAt     string  `json:"updated_at"`
+	StartedAt     *string `json:"started_at"`
+	Outputs       string  `json:"outputs"`
+	RequestID     *string `json:"request_id"`
+	Priority      int64   `json:"priority"`
+	TimeoutMs     *int64  `json:"timeout_ms"`
+	Deadline      *string `json:"deadline"`
+	RetryPolicy   *string `json:"retry_policy"`
+	Attempts      int64   `json:"attempts"`
+	AttemptErrors string  `json:"attempt_errors"`
+	QueueOrder    int64   `json:"queue_order"`
+	Recovery      *string `json:"recovery"`
+}
+
+type CommandQueueState struct {
+	ID        int64   `json:"id"`
+	Paused    int64   `json:"paused"`
+	PausedBy  *string `json:"paused_by"`
+	Reason    *string `json:"reason"`
+	PausedAt  *string `json:"paused_at"`
+	UpdatedAt string  `json:"updated_at"`
+}
+
+type LiftMotorCalibration struct {
+	ID           int64   `json:"id"`
+	ZeroOffset   int64   `json:"zero_offset"`
+	CalibratedAt *string `json:"calibrated_at"`
+	UpdatedAt    string  `json:"updated_at"`
 }
 
 type Location struct {
@@ -59,3 +83,32 @@ type Location struct {
 type Robot struct {
 	ID int64 `json:"id"`
 }
+
+type Schedule struct {
+	ID            int64   `json:"id"`
+	Name          string  `json:"name"`
+	CommandType   string  `json:"command_type"`
+	Inputs        string  `json:"inputs"`
+	Priority      int64   `json:"priority"`
+	RunAt         *string `json:"run_at"`
+	CronExpr      *string `json:"cron_expr"`
+	Enabled       int64   `json:"enabled"`
+	NextRunAt     *string `json:"next_run_at"`
+	LastRunAt     *string `json:"last_run_at"`
+	LastCommandID *int64  `json:"last_command_id"`
+	CreatedAt     string  `json:"created_at"`
+	UpdatedAt     string  `json:"updated_at"`
+}
+
+type TrackMap struct {
+	ID        int64  `json:"id"`
+	Topology  string `json:"topology"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+type TrackMapTag struct {
+	Position       int64  `json:"position"`
+	Location       string `json:"location"`
+	Name           string `json:"name"`
+	DistanceToNext int64  `json:"distance_to_next"`
+}
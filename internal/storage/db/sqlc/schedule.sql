@@ -0,0 +1,89 @@
+-- name: ScheduleGetByID :one
+SELECT
+	*
+FROM
+	schedules
+WHERE
+	id = @id;
+
+-- name: ScheduleList :many
+SELECT
+	*
+FROM
+	schedules
+ORDER BY
+	id ASC
+LIMIT
+	@limit OFFSET @offset;
+
+-- name: ScheduleCount :one
+SELECT
+	COUNT(*)
+FROM
+	schedules;
+
+-- name: ScheduleListEnabled :many
+SELECT
+	*
+FROM
+	schedules
+WHERE
+	enabled = 1
+ORDER BY
+	id ASC;
+
+-- name: ScheduleCreate :one
+INSERT INTO
+	schedules (
+		name,
+		command_type,
+		inputs,
+		priority,
+		run_at,
+		cron_expr,
+		enabled,
+		next_run_at,
+		last_run_at,
+		last_command_id,
+		created_at,
+		updated_at
+	)
+VALUES
+	(
+		@name,
+		@command_type,
+		@inputs,
+		@priority,
+		@run_at,
+		@cron_expr,
+		@enabled,
+		@next_run_at,
+		@last_run_at,
+		@last_command_id,
+		@created_at,
+		@updated_at
+	) RETURNING *;
+
+-- name: ScheduleUpdate :one
+UPDATE
+	schedules
+SET
+	name = @name,
+	command_type = @command_type,
+	inputs = @inputs,
+	priority = @priority,
+	run_at = @run_at,
+	cron_expr = @cron_expr,
+	enabled = @enabled,
+	next_run_at = @next_run_at,
+	last_run_at = @last_run_at,
+	last_command_id = @last_command_id,
+	updated_at = @updated_at
+WHERE
+	id = @id RETURNING *;
+
+-- name: ScheduleDeleteByID :execrows
+DELETE FROM
+	schedules
+WHERE
+	id = @id;
@@ -0,0 +1,320 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: schedule.sql
+
+package sqlc
+
+import (
+	"context"
+)
+
+const scheduleCount = `-- name: ScheduleCount :one
+SELECT
+	COUNT(*)
+FROM
+	schedules
+`
+
+func (q *Queries) ScheduleCount(ctx context.Context, db DBTX) (int64, error) {
+	row := db.QueryRowContext(ctx, scheduleCount)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const scheduleCreate = `-- name: ScheduleCreate :one
+INSERT INTO
+	schedules (
+		name,
+		command_type,
+		inputs,
+		priority,
+		run_at,
+		cron_expr,
+		enabled,
+		next_run_at,
+		last_run_at,
+		last_command_id,
+		created_at,
+		updated_at
+	)
+VALUES
+	(
+		?1,
+		?2,
+		?3,
+		?4,
+		?5,
+		?6,
+		?7,
+		?8,
+		?9,
+		?10,
+		?11,
+		?12
+	) RETURNING id, name, command_type, inputs, priority, run_at, cron_expr, enabled, next_run_at, last_run_at, last_command_id, created_at, updated_at
+`
+
+type ScheduleCreateParams struct {
+	Name          string  `json:"name"`
+	CommandType   string  `json:"command_type"`
+	Inputs        string  `json:"inputs"`
+	Priority      int64   `json:"priority"`
+	RunAt         *string `json:"run_at"`
+	CronExpr      *string `json:"cron_expr"`
+	Enabled       int64   `json:"enabled"`
+	NextRunAt     *string `json:"next_run_at"`
+	LastRunAt     *string `json:"last_run_at"`
+	LastCommandID *int64  `json:"last_command_id"`
+	CreatedAt     string  `json:"created_at"`
+	UpdatedAt     string  `json:"updated_at"`
+}
+
+func (q *Queries) ScheduleCreate(ctx context.Context, db DBTX, arg ScheduleCreateParams) (Schedule, error) {
+	row := db.QueryRowContext(ctx, scheduleCreate,
+		arg.Name,
+		arg.CommandType,
+		arg.Inputs,
+		arg.Priority,
+		arg.RunAt,
+		arg.CronExpr,
+		arg.Enabled,
+		arg.NextRunAt,
+		arg.LastRunAt,
+		arg.LastCommandID,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	var i Schedule
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.CommandType,
+		&i.Inputs,
+		&i.Priority,
+		&i.RunAt,
+		&i.CronExpr,
+		&i.Enabled,
+		&i.NextRunAt,
+		&i.LastRunAt,
+		&i.LastCommandID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const scheduleDeleteByID = `-- name: ScheduleDeleteByID :execrows
+DELETE FROM
+	schedules
+WHERE
+	id = ?1
+`
+
+func (q *Queries) ScheduleDeleteByID(ctx context.Context, db DBTX, id int64) (int64, error) {
+	result, err := db.ExecContext(ctx, scheduleDeleteByID, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const scheduleGetByID = `-- name: ScheduleGetByID :one
+SELECT
+	id, name, command_type, inputs, priority, run_at, cron_expr, enabled, next_run_at, last_run_at, last_command_id, created_at, updated_at
+FROM
+	schedules
+WHERE
+	id = ?1
+`
+
+func (q *Queries) ScheduleGetByID(ctx context.Context, db DBTX, id int64) (Schedule, error) {
+	row := db.QueryRowContext(ctx, scheduleGetByID, id)
+	var i Schedule
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.CommandType,
+		&i.Inputs,
+		&i.Priority,
+		&i.RunAt,
+		&i.CronExpr,
+		&i.Enabled,
+		&i.NextRunAt,
+		&i.LastRunAt,
+		&i.LastCommandID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const scheduleList = `-- name: ScheduleList :many
+SELECT
+	id, name, command_type, inputs, priority, run_at, cron_expr, enabled, next_run_at, last_run_at, last_command_id, created_at, updated_at
+FROM
+	schedules
+ORDER BY
+	id ASC
+LIMIT
+	?2 OFFSET ?1
+`
+
+type ScheduleListParams struct {
+	Offset int64 `json:"offset"`
+	Limit  int64 `json:"limit"`
+}
+
+func (q *Queries) ScheduleList(ctx context.Context, db DBTX, arg ScheduleListParams) ([]Schedule, error) {
+	rows, err := db.QueryContext(ctx, scheduleList, arg.Offset, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Schedule{}
+	for rows.Next() {
+		var i Schedule
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.CommandType,
+			&i.Inputs,
+			&i.Priority,
+			&i.RunAt,
+			&i.CronExpr,
+			&i.Enabled,
+			&i.NextRunAt,
+			&i.LastRunAt,
+			&i.LastCommandID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const scheduleListEnabled = `-- name: ScheduleListEnabled :many
+SELECT
+	id, name, command_type, inputs, priority, run_at, cron_expr, enabled, next_run_at, last_run_at, last_command_id, created_at, updated_at
+FROM
+	schedules
+WHERE
+	enabled = 1
+ORDER BY
+	id ASC
+`
+
+func (q *Queries) ScheduleListEnabled(ctx context.Context, db DBTX) ([]Schedule, error) {
+	rows, err := db.QueryContext(ctx, scheduleListEnabled)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Schedule{}
+	for rows.Next() {
+		var i Schedule
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.CommandType,
+			&i.Inputs,
+			&i.Priority,
+			&i.RunAt,
+			&i.CronExpr,
+			&i.Enabled,
+			&i.NextRunAt,
+			&i.LastRunAt,
+			&i.LastCommandID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const scheduleUpdate = `-- name: ScheduleUpdate :one
+UPDATE
+	schedules
+SET
+	name = ?1,
+	command_type = ?2,
+	inputs = ?3,
+	priority = ?4,
+	run_at = ?5,
+	cron_expr = ?6,
+	enabled = ?7,
+	next_run_at = ?8,
+	last_run_at = ?9,
+	last_command_id = ?10,
+	updated_at = ?11
+WHERE
+	id = ?12 RETURNING id, name, command_type, inputs, priority, run_at, cron_expr, enabled, next_run_at, last_run_at, last_command_id, created_at, updated_at
+`
+
+type ScheduleUpdateParams struct {
+	Name          string  `json:"name"`
+	CommandType   string  `json:"command_type"`
+	Inputs        string  `json:"inputs"`
+	Priority      int64   `json:"priority"`
+	RunAt         *string `json:"run_at"`
+	CronExpr      *string `json:"cron_expr"`
+	Enabled       int64   `json:"enabled"`
+	NextRunAt     *string `json:"next_run_at"`
+	LastRunAt     *string `json:"last_run_at"`
+	LastCommandID *int64  `json:"last_command_id"`
+	UpdatedAt     string  `json:"updated_at"`
+	ID            int64   `json:"id"`
+}
+
+func (q *Queries) ScheduleUpdate(ctx context.Context, db DBTX, arg ScheduleUpdateParams) (Schedule, error) {
+	row := db.QueryRowContext(ctx, scheduleUpdate,
+		arg.Name,
+		arg.CommandType,
+		arg.Inputs,
+		arg.Priority,
+		arg.RunAt,
+		arg.CronExpr,
+		arg.Enabled,
+		arg.NextRunAt,
+		arg.LastRunAt,
+		arg.LastCommandID,
+		arg.UpdatedAt,
+		arg.ID,
+	)
+	var i Schedule
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.CommandType,
+		&i.Inputs,
+		&i.Priority,
+		&i.RunAt,
+		&i.CronExpr,
+		&i.Enabled,
+		&i.NextRunAt,
+		&i.LastRunAt,
+		&i.LastCommandID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,35 @@
+-- name: TrackMapGet :one
+SELECT
+	*
+FROM
+	track_map
+WHERE
+	id = 1;
+
+-- name: TrackMapUpdate :one
+UPDATE
+	track_map
+SET
+	topology = @topology,
+	updated_at = @updated_at
+WHERE
+	id = 1 RETURNING *;
+
+-- name: TrackMapListTags :many
+-- It returns the tags in track order.
+SELECT
+	*
+FROM
+	track_map_tags
+ORDER BY
+	position ASC;
+
+-- name: TrackMapDeleteTags :exec
+DELETE FROM
+	track_map_tags;
+
+-- name: TrackMapCreateTag :exec
+INSERT INTO
+	track_map_tags (position, location, name, distance_to_next)
+VALUES
+	(@position, @location, @name, @distance_to_next);
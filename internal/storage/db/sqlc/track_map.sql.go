@@ -0,0 +1,120 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: track_map.sql
+
+package sqlc
+
+import (
+	"context"
+)
+
+const trackMapCreateTag = `-- name: TrackMapCreateTag :exec
+INSERT INTO
+	track_map_tags (position, location, name, distance_to_next)
+VALUES
+	(?1, ?2, ?3, ?4)
+`
+
+type TrackMapCreateTagParams struct {
+	Position       int64  `json:"position"`
+	Location       string `json:"location"`
+	Name           string `json:"name"`
+	DistanceToNext int64  `json:"distance_to_next"`
+}
+
+func (q *Queries) TrackMapCreateTag(ctx context.Context, db DBTX, arg TrackMapCreateTagParams) error {
+	_, err := db.ExecContext(ctx, trackMapCreateTag,
+		arg.Position,
+		arg.Location,
+		arg.Name,
+		arg.DistanceToNext,
+	)
+	return err
+}
+
+const trackMapDeleteTags = `-- name: TrackMapDeleteTags :exec
+DELETE FROM
+	track_map_tags
+`
+
+func (q *Queries) TrackMapDeleteTags(ctx context.Context, db DBTX) error {
+	_, err := db.ExecContext(ctx, trackMapDeleteTags)
+	return err
+}
+
+const trackMapGet = `-- name: TrackMapGet :one
+SELECT
+	id, topology, updated_at
+FROM
+	track_map
+WHERE
+	id = 1
+`
+
+func (q *Queries) TrackMapGet(ctx context.Context, db DBTX) (TrackMap, error) {
+	row := db.QueryRowContext(ctx, trackMapGet)
+	var i TrackMap
+	err := row.Scan(&i.ID, &i.Topology, &i.UpdatedAt)
+	return i, err
+}
+
+const trackMapListTags = `-- name: TrackMapListTags :many
+SELECT
+	position, location, name, distance_to_next
+FROM
+	track_map_tags
+ORDER BY
+	position ASC
+`
+
+// It returns the tags in track order.
+func (q *Queries) TrackMapListTags(ctx context.Context, db DBTX) ([]TrackMapTag, error) {
+	rows, err := db.QueryContext(ctx, trackMapListTags)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []TrackMapTag{}
+	for rows.Next() {
+		var i TrackMapTag
+		if err := rows.Scan(
+			&i.Position,
+			&i.Location,
+			&i.Name,
+			&i.DistanceToNext,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const trackMapUpdate = `-- name: TrackMapUpdate :one
+UPDATE
+	track_map
+SET
+	topology = ?1,
+	updated_at = ?2
+WHERE
+	id = 1 RETURNING id, topology, updated_at
+`
+
+type TrackMapUpdateParams struct {
+	Topology  string `json:"topology"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+func (q *Queries) TrackMapUpdate(ctx context.Context, db DBTX, arg TrackMapUpdateParams) (TrackMap, error) {
+	row := db.QueryRowContext(ctx, trackMapUpdate, arg.Topology, arg.UpdatedAt)
+	var i TrackMap
+	err := row.Scan(&i.ID, &i.Topology, &i.UpdatedAt)
+	return i, err
+}
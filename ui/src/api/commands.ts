@@ -1,6 +1,6 @@
 import type { AxiosRequestConfig } from 'axios'
 import type { SortPrefix } from '@/lib/sort'
-import type { Command, CommandInputMap, CommandStatus, CommandType } from '@/types/command'
+import type { Command, CommandInputMap, CommandProgress, CommandStatus, CommandType } from '@/types/command'
 import type { Paging } from '@/types/paging'
 import http from '@/lib/http'
 
@@ -36,6 +36,9 @@ const commandsAPI = {
   getCurrentProcessingCommand: (axiosOpts?: AxiosRequestConfig): Promise<Command> => {
     return http.get('/commands/processing', axiosOpts)
   },
+  getCurrentProcessingCommandProgress: (axiosOpts?: AxiosRequestConfig): Promise<CommandProgress> => {
+    return http.get('/commands/processing/progress', axiosOpts)
+  },
   createCommand: <T extends CommandType>(params: CreateCommandParams<T>, axiosOpts?: AxiosRequestConfig): Promise<Command> => {
     return http.post('/commands', params, axiosOpts)
   },
@@ -9,7 +9,7 @@ export const createCommandSchema = z.discriminatedUnion('type', [
     type: z.literal('MOVE_TO'),
     inputs: z.object({
       location: z.string(),
-      direction: z.union([z.literal('FORWARD'), z.literal('BACKWARD')]),
+      direction: z.union([z.literal('FORWARD'), z.literal('BACKWARD'), z.literal('AUTO')]),
       motorSpeed: z.number().min(0).max(100),
     }),
   }),
@@ -24,7 +24,7 @@ export interface CommandConfig {
 const STORAGE_KEY = 'command-config'
 const DEFAULT_COMMAND_CONFIG: CommandConfig = {
   moveTo: {
-    direction: 'FORWARD',
+    direction: 'AUTO',
     motorSpeed: 80,
   },
   moveForward: {
@@ -9,7 +9,7 @@ export interface MoveBackwardInputs {
 }
 export interface MoveToInputs {
   location: string
-  direction: 'FORWARD' | 'BACKWARD'
+  direction?: 'FORWARD' | 'BACKWARD' | 'AUTO'
   motorSpeed: number
 }
 export interface CargoOpenInputs {
@@ -34,9 +34,28 @@ export interface WaitInputs {
   durationMs: number
 }
 export interface StopMovementOutputs {}
-export interface MoveForwardOutputs {}
-export interface MoveBackwardOutputs {}
-export interface MoveToOutputs {}
+export interface MotionOutputs {
+  startLocation: string
+  finalLocation: string
+  tagsPassed: PassedTag[]
+  travelDurationMs: number
+  averageMotorSpeed: number
+  obstaclePauses: ObstaclePause[]
+}
+export interface PassedTag {
+  location: string
+  passedAt: string
+}
+export interface MoveForwardOutputs extends MotionOutputs {}
+export interface MoveBackwardOutputs extends MotionOutputs {}
+export interface MoveToOutputs extends MotionOutputs {
+  corrections: number
+}
+export interface ObstaclePause {
+  distance: number
+  pausedAt: string
+  resumedAt: string | null
+}
 export interface CargoOpenOutputs {}
 export interface CargoCloseOutputs {}
 export interface CargoLiftOutputs {}
@@ -115,3 +134,12 @@ export interface Command<T extends CommandType = CommandType> {
   createdAt: string
   updatedAt: string
 }
+
+export interface CommandProgress {
+  commandId: number
+  commandType: CommandType
+  phase: string
+  percentage: number | null
+  message: string
+  updatedAt: string | null
+}
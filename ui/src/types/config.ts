@@ -32,12 +32,14 @@ export interface ESPConfig {
   serial: SerialConfig
   enableAck: boolean
   commandAckTimeout: number
+  commandAckRetries: number
 }
 
 export interface PICConfig {
   serial: SerialConfig
   enableAck: boolean
   commandAckTimeout: number
+  commandAckRetries: number
 }
 
 export type Parity = 'NONE' | 'EVEN' | 'ODD'
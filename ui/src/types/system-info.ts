@@ -4,4 +4,18 @@ export interface SystemInfo {
   memoryUsage: number
   totalMemory: number
   uptime: number
+  picLink: LinkStats
+  espLink: LinkStats
+}
+
+export interface LinkStats {
+  commandsSent: number
+  commandsAcked: number
+  commandsLost: number
+  resends: number
+  avgAckLatencyMs: number
+  maxAckLatencyMs: number
+  framesReceived: number
+  framesRejected: number
+  frameSequenceGaps: number
 }